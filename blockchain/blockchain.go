@@ -3,6 +3,7 @@ package blockchain
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
@@ -464,9 +465,16 @@ func (bc *Blockchain) GetDepositOPAddress() ethereum.Address {
 	return bc.MustGetOperator(depositOP).Address
 }
 
-// GetIntermediatorOPAddress return intermediator op address
-func (bc *Blockchain) GetIntermediatorOPAddress() ethereum.Address {
-	return bc.MustGetOperator(huobiblockchain.HuobiOP).Address
+// GetIntermediatorOPAddresses return intermediator op addresses of the huobi accounts by their
+// operator names.
+func (bc *Blockchain) GetIntermediatorOPAddresses() map[string]ethereum.Address {
+	result := make(map[string]ethereum.Address)
+	for name, address := range bc.OperatorAddresses() {
+		if strings.HasPrefix(name, huobiblockchain.HuobiOP) {
+			result[name] = address
+		}
+	}
+	return result
 }
 
 // GetWrapperAddress return wrapper address
@@ -27,9 +27,9 @@ func (bc *Blockchain) GeneratedSetCompactData(opts blockchain.TxOpts, buy [][14]
 // GeneratedGetRate get token rate from reserve
 func (bc *Blockchain) GeneratedGetRate(opts blockchain.CallOpts, token ethereum.Address, currentBlockNumber *big.Int, buy bool, qty *big.Int) (*big.Int, error) {
 	timeOut := 2 * time.Second
-	out := big.NewInt(0)
+	out := new(*big.Int)
 	err := bc.Call(timeOut, opts, bc.pricing, out, "getRate", token, currentBlockNumber, buy, qty)
-	return out, err
+	return *out, err
 }
 
 // GeneratedGetListedTokens return listed tokens on reserve
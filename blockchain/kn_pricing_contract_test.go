@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,16 +25,14 @@ func TestGeneratedGetListedTokens(t *testing.T) {
 	rpcClient, err := rpc.Dial(rpcEndpoint)
 	require.NoError(t, err)
 
-	ethClient := ethclient.NewClient(rpcClient)
-	commonEthClient := common.EthClient{
-		Client: ethClient,
-		URL:    rpcEndpoint,
-	}
-	contractCaller := baseblockchain.NewContractCaller([]*common.EthClient{&commonEthClient})
+	pool := baseblockchain.NewNodePool(
+		[]*baseblockchain.Node{baseblockchain.NewNode(rpcEndpoint, rpcClient)},
+		baseblockchain.DefaultMaxBlockLag,
+	)
+	contractCaller := baseblockchain.NewContractCaller(pool)
 
 	baseBlockchain := baseblockchain.NewBaseBlockchain(
-		rpcClient,      // rpc client
-		ethClient,      // eth client
+		pool,           // node pool
 		nil,            // map[string]*Operator
 		nil,            // broadcaster
 		contractCaller, // contract caller
@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+import "./VolumeImbalanceRecorder.sol";
+import "./Utils.sol";
+
+contract ConversionRates is VolumeImbalanceRecorder, Utils {
+
+    // bps - basic rate steps. one step is 1 / 10000 of the rate.
+    struct StepFunction {
+        int[] x; // quantity for each step. Quantity of each step includes previous steps.
+        int[] y; // rate change per quantity step  in bps.
+    }
+
+    struct TokenData {
+        bool listed;  // was added to reserve
+        bool enabled; // whether trade is enabled
+
+        // position in the compact data
+        uint compactDataArrayIndex;
+        uint compactDataFieldIndex;
+
+        // rate data. base and changes according to quantity and reserve balance.
+        // generally speaking. Sell rate is 1 / buy rate i.e. the buy in the other direction.
+        uint baseBuyRate;  // in PRECISION units. see KyberConstants
+        uint baseSellRate; // PRECISION units. without (sell / buy) spread it is 1 / baseBuyRate
+        StepFunction buyRateQtyStepFunction; // in bps. higher quantity - bigger the rate.
+        StepFunction sellRateQtyStepFunction;// in bps. higher the qua
+        StepFunction buyRateImbalanceStepFunction; // in BPS. higher reserve imbalance - bigger the rate.
+        StepFunction sellRateImbalanceStepFunction;
+    }
+
+    /*
+    this is the data for tokenRatesCompactData
+    but solidity compiler optimizer is sub-optimal, and cannot write this structure in a single storage write
+    so we represent it as bytes32 and do the byte tricks ourselves.
+    struct TokenRatesCompactData {
+        bytes14 buy;  // change buy rate of token from baseBuyRate in 10 bps
+        bytes14 sell; // change sell rate of token from baseSellRate in 10 bps
+
+        uint32 blockNumber;
+    } */
+    uint public validRateDurationInBlocks = 10; // rates are valid for this amount of blocks
+    ERC20[] internal listedTokens;
+    mapping(address=>TokenData) internal tokenData;
+    bytes32[] internal tokenRatesCompactData;
+    uint public numTokensInCurrentCompactData = 0;
+    address public reserveContract;
+    uint constant internal NUM_TOKENS_IN_COMPACT_DATA = 14;
+    uint constant internal BYTES_14_OFFSET = (2 ** (8 * NUM_TOKENS_IN_COMPACT_DATA));
+    uint constant internal MAX_STEPS_IN_FUNCTION = 10;
+    int  constant internal MAX_BPS_ADJUSTMENT = 10 ** 11; // 1B %
+    int  constant internal MIN_BPS_ADJUSTMENT = -100 * 100; // cannot go down by more than 100%
+
+    constructor(address _admin) VolumeImbalanceRecorder(_admin) { } // solhint-disable-line no-empty-blocks
+
+    function addToken(ERC20 token) public onlyAdmin {
+
+        require(!tokenData[address(token)].listed);
+        tokenData[address(token)].listed = true;
+        listedTokens.push(token);
+
+        if (numTokensInCurrentCompactData == 0) {
+            tokenRatesCompactData.push(); // add new structure
+        }
+
+        tokenData[address(token)].compactDataArrayIndex = tokenRatesCompactData.length - 1;
+        tokenData[address(token)].compactDataFieldIndex = numTokensInCurrentCompactData;
+
+        numTokensInCurrentCompactData = (numTokensInCurrentCompactData + 1) % NUM_TOKENS_IN_COMPACT_DATA;
+
+        setGarbageToVolumeRecorder(token);
+
+        setDecimals(token);
+    }
+
+    function setCompactData(bytes14[] memory buy, bytes14[] memory sell, uint blockNumber, uint[] memory indices) public onlyOperator {
+
+        require(buy.length == sell.length);
+        require(indices.length == buy.length);
+        require(blockNumber <= 0xFFFFFFFF);
+
+        uint bytes14Offset = BYTES_14_OFFSET;
+
+        for (uint i = 0; i < indices.length; i++) {
+            require(indices[i] < tokenRatesCompactData.length);
+            uint data = uint(uint112(buy[i])) | uint(uint112(sell[i])) * bytes14Offset | (blockNumber * (bytes14Offset * bytes14Offset));
+            tokenRatesCompactData[indices[i]] = bytes32(data);
+        }
+    }
+
+    function setBaseRate(
+        ERC20[] memory tokens,
+        uint[] memory baseBuy,
+        uint[] memory baseSell,
+        bytes14[] memory buy,
+        bytes14[] memory sell,
+        uint blockNumber,
+        uint[] memory indices
+    )
+        public
+        onlyOperator
+    {
+        require(tokens.length == baseBuy.length);
+        require(tokens.length == baseSell.length);
+        require(sell.length == buy.length);
+        require(sell.length == indices.length);
+
+        for (uint ind = 0; ind < tokens.length; ind++) {
+            require(tokenData[address(tokens[ind])].listed);
+            tokenData[address(tokens[ind])].baseBuyRate = baseBuy[ind];
+            tokenData[address(tokens[ind])].baseSellRate = baseSell[ind];
+        }
+
+        setCompactData(buy, sell, blockNumber, indices);
+    }
+
+    function setQtyStepFunction(
+        ERC20 token,
+        int[] memory xBuy,
+        int[] memory yBuy,
+        int[] memory xSell,
+        int[] memory ySell
+    )
+        public
+        onlyOperator
+    {
+        require(xBuy.length == yBuy.length);
+        require(xSell.length == ySell.length);
+        require(xBuy.length <= MAX_STEPS_IN_FUNCTION);
+        require(xSell.length <= MAX_STEPS_IN_FUNCTION);
+        require(tokenData[address(token)].listed);
+
+        tokenData[address(token)].buyRateQtyStepFunction = StepFunction(xBuy, yBuy);
+        tokenData[address(token)].sellRateQtyStepFunction = StepFunction(xSell, ySell);
+    }
+
+    function setImbalanceStepFunction(
+        ERC20 token,
+        int[] memory xBuy,
+        int[] memory yBuy,
+        int[] memory xSell,
+        int[] memory ySell
+    )
+        public
+        onlyOperator
+    {
+        require(xBuy.length == yBuy.length);
+        require(xSell.length == ySell.length);
+        require(xBuy.length <= MAX_STEPS_IN_FUNCTION);
+        require(xSell.length <= MAX_STEPS_IN_FUNCTION);
+        require(tokenData[address(token)].listed);
+
+        tokenData[address(token)].buyRateImbalanceStepFunction = StepFunction(xBuy, yBuy);
+        tokenData[address(token)].sellRateImbalanceStepFunction = StepFunction(xSell, ySell);
+    }
+
+    function setValidRateDurationInBlocks(uint duration) public onlyAdmin {
+        validRateDurationInBlocks = duration;
+    }
+
+    function enableTokenTrade(ERC20 token) public onlyAdmin {
+        require(tokenData[address(token)].listed);
+        require(tokenControlInfo[address(token)].minimalRecordResolution != 0);
+        tokenData[address(token)].enabled = true;
+    }
+
+    function disableTokenTrade(ERC20 token) public onlyAlerter {
+        require(tokenData[address(token)].listed);
+        tokenData[address(token)].enabled = false;
+    }
+
+    function setReserveAddress(address reserve) public onlyAdmin {
+        reserveContract = reserve;
+    }
+
+    function recordImbalance(
+        ERC20 token,
+        int buyAmount,
+        uint rateUpdateBlock,
+        uint currentBlock
+    )
+        public
+    {
+        require(msg.sender == reserveContract);
+
+        if (rateUpdateBlock == 0) rateUpdateBlock = getRateUpdateBlock(token);
+
+        return addImbalance(token, buyAmount, rateUpdateBlock, currentBlock);
+    }
+
+    /* solhint-disable function-max-lines */
+    function getRate(ERC20 token, uint currentBlockNumber, bool buy, uint qty) public view returns(uint) {
+        // check if trade is enabled
+        if (!tokenData[address(token)].enabled) return 0;
+        if (tokenControlInfo[address(token)].minimalRecordResolution == 0) return 0; // token control info not set
+
+        // get rate update block
+        bytes32 compactData = tokenRatesCompactData[tokenData[address(token)].compactDataArrayIndex];
+
+        uint updateRateBlock = getLast4Bytes(compactData);
+        if (currentBlockNumber >= updateRateBlock + validRateDurationInBlocks) return 0; // rate is expired
+        // check imbalance
+        int totalImbalance;
+        int blockImbalance;
+        (totalImbalance, blockImbalance) = getImbalance(token, updateRateBlock, currentBlockNumber);
+
+        // calculate actual rate
+        int imbalanceQty;
+        int extraBps;
+        int8 rateUpdate;
+        uint rate;
+
+        if (buy) {
+            // start with base rate
+            rate = tokenData[address(token)].baseBuyRate;
+
+            // add rate update
+            rateUpdate = getRateByteFromCompactData(compactData, token, true);
+            extraBps = int(rateUpdate) * 10;
+            rate = addBps(rate, extraBps);
+
+            // compute token qty
+            qty = getTokenQty(token, rate, qty);
+            imbalanceQty = int(qty);
+            totalImbalance += imbalanceQty;
+
+            // add qty overhead
+            extraBps = executeStepFunction(tokenData[address(token)].buyRateQtyStepFunction, int(qty));
+            rate = addBps(rate, extraBps);
+
+            // add imbalance overhead
+            extraBps = executeStepFunction(tokenData[address(token)].buyRateImbalanceStepFunction, totalImbalance);
+            rate = addBps(rate, extraBps);
+        } else {
+            // start with base rate
+            rate = tokenData[address(token)].baseSellRate;
+
+            // add rate update
+            rateUpdate = getRateByteFromCompactData(compactData, token, false);
+            extraBps = int(rateUpdate) * 10;
+            rate = addBps(rate, extraBps);
+
+            // compute token qty
+            imbalanceQty = -1 * int(qty);
+            totalImbalance += imbalanceQty;
+
+            // add qty overhead
+            extraBps = executeStepFunction(tokenData[address(token)].sellRateQtyStepFunction, int(qty));
+            rate = addBps(rate, extraBps);
+
+            // add imbalance overhead
+            extraBps = executeStepFunction(tokenData[address(token)].sellRateImbalanceStepFunction, totalImbalance);
+            rate = addBps(rate, extraBps);
+        }
+
+        if (abs(totalImbalance) >= getMaxTotalImbalance(token)) return 0;
+        if (abs(blockImbalance + imbalanceQty) >= getMaxPerBlockImbalance(token)) return 0;
+
+        return rate;
+    }
+    /* solhint-enable function-max-lines */
+
+    function getBasicRate(ERC20 token, bool buy) public view returns(uint) {
+        if (buy)
+            return tokenData[address(token)].baseBuyRate;
+        else
+            return tokenData[address(token)].baseSellRate;
+    }
+
+    function getCompactData(ERC20 token) public view returns(uint, uint, bytes1, bytes1) {
+        require(tokenData[address(token)].listed);
+
+        uint arrayIndex = tokenData[address(token)].compactDataArrayIndex;
+        uint fieldOffset = tokenData[address(token)].compactDataFieldIndex;
+
+        return (
+            arrayIndex,
+            fieldOffset,
+            bytes1(uint8(getRateByteFromCompactData(tokenRatesCompactData[arrayIndex], token, true))),
+            bytes1(uint8(getRateByteFromCompactData(tokenRatesCompactData[arrayIndex], token, false)))
+        );
+    }
+
+    function getTokenBasicData(ERC20 token) public view returns(bool, bool) {
+        return (tokenData[address(token)].listed, tokenData[address(token)].enabled);
+    }
+
+    /* solhint-disable code-complexity */
+    function getStepFunctionData(ERC20 token, uint command, uint param) public view returns(int) {
+        if (command == 0) return int(tokenData[address(token)].buyRateQtyStepFunction.x.length);
+        if (command == 1) return tokenData[address(token)].buyRateQtyStepFunction.x[param];
+        if (command == 2) return int(tokenData[address(token)].buyRateQtyStepFunction.y.length);
+        if (command == 3) return tokenData[address(token)].buyRateQtyStepFunction.y[param];
+
+        if (command == 4) return int(tokenData[address(token)].sellRateQtyStepFunction.x.length);
+        if (command == 5) return tokenData[address(token)].sellRateQtyStepFunction.x[param];
+        if (command == 6) return int(tokenData[address(token)].sellRateQtyStepFunction.y.length);
+        if (command == 7) return tokenData[address(token)].sellRateQtyStepFunction.y[param];
+
+        if (command == 8) return int(tokenData[address(token)].buyRateImbalanceStepFunction.x.length);
+        if (command == 9) return tokenData[address(token)].buyRateImbalanceStepFunction.x[param];
+        if (command == 10) return int(tokenData[address(token)].buyRateImbalanceStepFunction.y.length);
+        if (command == 11) return tokenData[address(token)].buyRateImbalanceStepFunction.y[param];
+
+        if (command == 12) return int(tokenData[address(token)].sellRateImbalanceStepFunction.x.length);
+        if (command == 13) return tokenData[address(token)].sellRateImbalanceStepFunction.x[param];
+        if (command == 14) return int(tokenData[address(token)].sellRateImbalanceStepFunction.y.length);
+        if (command == 15) return tokenData[address(token)].sellRateImbalanceStepFunction.y[param];
+
+        revert();
+    }
+    /* solhint-enable code-complexity */
+
+    function getRateUpdateBlock(ERC20 token) public view returns(uint) {
+        bytes32 compactData = tokenRatesCompactData[tokenData[address(token)].compactDataArrayIndex];
+        return getLast4Bytes(compactData);
+    }
+
+    function getListedTokens() public view returns(ERC20[] memory) {
+        return listedTokens;
+    }
+
+    function getTokenQty(ERC20 token, uint ethQty, uint rate) internal view returns(uint) {
+        uint dstDecimals = getDecimals(token);
+        uint srcDecimals = ETH_DECIMALS;
+
+        return calcDstQty(ethQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getLast4Bytes(bytes32 b) internal pure returns(uint) {
+        // cannot trust compiler with not turning bit operations into EXP opcode
+        return uint(b) / (BYTES_14_OFFSET * BYTES_14_OFFSET);
+    }
+
+    function getRateByteFromCompactData(bytes32 data, ERC20 token, bool buy) internal view returns(int8) {
+        uint fieldOffset = tokenData[address(token)].compactDataFieldIndex;
+        uint byteOffset;
+        if (buy)
+            byteOffset = 32 - NUM_TOKENS_IN_COMPACT_DATA + fieldOffset;
+        else
+            byteOffset = 4 + fieldOffset;
+
+        return int8(uint8(data[byteOffset]));
+    }
+
+    function executeStepFunction(StepFunction storage f, int x) internal view returns(int) {
+        uint len = f.y.length;
+        for (uint ind = 0; ind < len; ind++) {
+            if (x <= f.x[ind]) return f.y[ind];
+        }
+
+        return f.y[len-1];
+    }
+
+    function addBps(uint rate, int bps) internal pure returns(uint) {
+        require(rate <= MAX_RATE);
+        require(bps >= MIN_BPS_ADJUSTMENT);
+        require(bps <= MAX_BPS_ADJUSTMENT);
+
+        uint maxBps = 100 * 100;
+        return (rate * uint(int(maxBps) + bps)) / maxBps;
+    }
+
+    function abs(int x) internal pure returns(uint) {
+        if (x < 0)
+            return uint(-1 * x);
+        else
+            return uint(x);
+    }
+}
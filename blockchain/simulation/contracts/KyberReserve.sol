@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+import "./Utils.sol";
+import "./Withdrawable.sol";
+import "./ConversionRatesInterface.sol";
+import "./SanityRatesInterface.sol";
+
+/// @title Kyber Reserve contract
+contract KyberReserve is Withdrawable, Utils {
+
+    address public kyberNetwork;
+    bool public tradeEnabled;
+    ConversionRatesInterface public conversionRatesContract;
+    SanityRatesInterface public sanityRatesContract;
+    mapping(bytes32=>bool) public approvedWithdrawAddresses; // sha3(token,address)=>bool
+
+    constructor(address _kyberNetwork, ConversionRatesInterface _ratesContract, address _admin) {
+        require(_admin != address(0));
+        require(address(_ratesContract) != address(0));
+        require(_kyberNetwork != address(0));
+        kyberNetwork = _kyberNetwork;
+        conversionRatesContract = _ratesContract;
+        admin = _admin;
+        tradeEnabled = true;
+    }
+
+    event DepositToken(ERC20 token, uint amount);
+
+    fallback() external payable {
+        emit DepositToken(ETH_TOKEN_ADDRESS, msg.value);
+    }
+
+    event TradeExecute(
+        address indexed origin,
+        address src,
+        uint srcAmount,
+        address destToken,
+        uint destAmount,
+        address destAddress
+    );
+
+    function trade(
+        ERC20 srcToken,
+        uint srcAmount,
+        ERC20 destToken,
+        address payable destAddress,
+        uint conversionRate,
+        bool validate
+    )
+        public
+        payable
+        returns(bool)
+    {
+        require(tradeEnabled);
+        require(msg.sender == kyberNetwork);
+
+        require(doTrade(srcToken, srcAmount, destToken, destAddress, conversionRate, validate));
+
+        return true;
+    }
+
+    event TradeEnabled(bool enable);
+
+    function enableTrade() public onlyAdmin returns(bool) {
+        tradeEnabled = true;
+        emit TradeEnabled(true);
+
+        return true;
+    }
+
+    function disableTrade() public onlyAlerter returns(bool) {
+        tradeEnabled = false;
+        emit TradeEnabled(false);
+
+        return true;
+    }
+
+    event WithdrawAddressApproved(ERC20 token, address addr, bool approve);
+
+    function approveWithdrawAddress(ERC20 token, address addr, bool approve) public onlyAdmin {
+        approvedWithdrawAddresses[keccak256(abi.encodePacked(token, addr))] = approve;
+        emit WithdrawAddressApproved(token, addr, approve);
+
+        setDecimals(token);
+    }
+
+    event WithdrawFunds(ERC20 token, uint amount, address destination);
+
+    function withdraw(ERC20 token, uint amount, address payable destination) public onlyOperator returns(bool) {
+        require(approvedWithdrawAddresses[keccak256(abi.encodePacked(token, destination))]);
+
+        if (token == ETH_TOKEN_ADDRESS) {
+            destination.transfer(amount);
+        } else {
+            require(token.transfer(destination, amount));
+        }
+
+        emit WithdrawFunds(token, amount, destination);
+
+        return true;
+    }
+
+    event SetContractAddresses(address network, address rate, address sanity);
+
+    function setContracts(address _kyberNetwork, ConversionRatesInterface _conversionRates, SanityRatesInterface _sanityRates)
+        public
+        onlyAdmin
+    {
+        require(_kyberNetwork != address(0));
+        require(address(_conversionRates) != address(0));
+
+        kyberNetwork = _kyberNetwork;
+        conversionRatesContract = _conversionRates;
+        sanityRatesContract = _sanityRates;
+
+        emit SetContractAddresses(kyberNetwork, address(conversionRatesContract), address(sanityRatesContract));
+    }
+
+    ////////////////////////////////////////////////////////////////////////////
+    /// status functions ///////////////////////////////////////////////////////
+    ////////////////////////////////////////////////////////////////////////////
+    function getBalance(ERC20 token) public view returns(uint) {
+        if (token == ETH_TOKEN_ADDRESS)
+            return address(this).balance;
+        else
+            return token.balanceOf(address(this));
+    }
+
+    function getDestQty(ERC20 src, ERC20 dest, uint srcQty, uint rate) public view returns(uint) {
+        uint dstDecimals = getDecimals(dest);
+        uint srcDecimals = getDecimals(src);
+
+        return calcDstQty(srcQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getSrcQty(ERC20 src, ERC20 dest, uint dstQty, uint rate) public view returns(uint) {
+        uint dstDecimals = getDecimals(dest);
+        uint srcDecimals = getDecimals(src);
+
+        return calcSrcQty(dstQty, srcDecimals, dstDecimals, rate);
+    }
+
+    function getConversionRate(ERC20 src, ERC20 dest, uint srcQty, uint blockNumber) public view returns(uint) {
+        ERC20 token;
+        bool  buy;
+
+        if (!tradeEnabled) return 0;
+
+        if (ETH_TOKEN_ADDRESS == src) {
+            buy = true;
+            token = dest;
+        } else if (ETH_TOKEN_ADDRESS == dest) {
+            buy = false;
+            token = src;
+        } else {
+            return 0; // pair is not listed
+        }
+
+        uint rate = conversionRatesContract.getRate(token, blockNumber, buy, srcQty);
+        uint destQty = getDestQty(src, dest, srcQty, rate);
+
+        if (getBalance(dest) < destQty) return 0;
+
+        if (address(sanityRatesContract) != address(0)) {
+            uint sanityRate = sanityRatesContract.getSanityRate(src, dest);
+            if (rate > sanityRate) return 0;
+        }
+
+        return rate;
+    }
+
+    /// @dev do a trade
+    /// @param srcToken Src token
+    /// @param srcAmount Amount of src token
+    /// @param destToken Destination token
+    /// @param destAddress Destination address to send tokens to
+    /// @param validate If true, additional validations are applicable
+    /// @return true iff trade is successful
+    function doTrade(
+        ERC20 srcToken,
+        uint srcAmount,
+        ERC20 destToken,
+        address payable destAddress,
+        uint conversionRate,
+        bool validate
+    )
+        internal
+        returns(bool)
+    {
+        // can skip validation if done at kyber network level
+        if (validate) {
+            require(conversionRate > 0);
+            if (srcToken == ETH_TOKEN_ADDRESS)
+                require(msg.value == srcAmount);
+            else
+                require(msg.value == 0);
+        }
+
+        uint destAmount = getDestQty(srcToken, destToken, srcAmount, conversionRate);
+        // sanity check
+        require(destAmount > 0);
+
+        // add to imbalance
+        ERC20 token;
+        int buy;
+        if (srcToken == ETH_TOKEN_ADDRESS) {
+            buy = int(destAmount);
+            token = destToken;
+        } else {
+            buy = -1 * int(srcAmount);
+            token = srcToken;
+        }
+
+        conversionRatesContract.recordImbalance(
+            token,
+            buy,
+            0,
+            block.number
+        );
+
+        // collect src tokens
+        if (srcToken != ETH_TOKEN_ADDRESS) {
+            require(srcToken.transferFrom(msg.sender, address(this), srcAmount));
+        }
+
+        // send dest tokens
+        if (destToken == ETH_TOKEN_ADDRESS) {
+            destAddress.transfer(destAmount);
+        } else {
+            require(destToken.transfer(destAddress, destAmount));
+        }
+
+        emit TradeExecute(msg.sender, address(srcToken), srcAmount, address(destToken), destAmount, destAddress);
+
+        return true;
+    }
+}
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+
+interface SanityRatesInterface {
+    function getSanityRate(ERC20 src, ERC20 dest) external view returns(uint);
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+
+/// @title ERC20 token with the whole supply held by the deployer
+contract TestToken is ERC20 {
+
+    string public name;
+    string public symbol;
+    uint public decimals;
+    uint public totalSupply;
+
+    mapping(address => uint) internal balances;
+    mapping(address => mapping(address => uint)) internal allowed;
+
+    event Transfer(address indexed from, address indexed to, uint value);
+
+    constructor(string memory _name, string memory _symbol, uint _decimals, uint _supply) {
+        name = _name;
+        symbol = _symbol;
+        decimals = _decimals;
+        totalSupply = _supply;
+        balances[msg.sender] = _supply;
+    }
+
+    function balanceOf(address _owner) public view returns (uint) {
+        return balances[_owner];
+    }
+
+    function transfer(address _to, uint _value) public returns (bool) {
+        require(_to != address(0));
+        require(_value <= balances[msg.sender]);
+
+        balances[msg.sender] -= _value;
+        balances[_to] += _value;
+        emit Transfer(msg.sender, _to, _value);
+        return true;
+    }
+
+    function transferFrom(address _from, address _to, uint _value) public returns (bool) {
+        require(_to != address(0));
+        require(_value <= balances[_from]);
+        require(_value <= allowed[_from][msg.sender]);
+
+        balances[_from] -= _value;
+        balances[_to] += _value;
+        allowed[_from][msg.sender] -= _value;
+        emit Transfer(_from, _to, _value);
+        return true;
+    }
+
+    function approve(address _spender, uint _value) public returns (bool) {
+        allowed[msg.sender][_spender] = _value;
+        emit Approval(msg.sender, _spender, _value);
+        return true;
+    }
+
+    function allowance(address _owner, address _spender) public view returns (uint) {
+        return allowed[_owner][_spender];
+    }
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+import "./Withdrawable.sol";
+
+contract VolumeImbalanceRecorder is Withdrawable {
+
+    uint constant internal SLIDING_WINDOW_SIZE = 5;
+    uint constant internal POW_2_64 = 2 ** 64;
+
+    struct TokenControlInfo {
+        uint minimalRecordResolution; // can be roughly 1 cent
+        uint maxPerBlockImbalance; // in twei resolution
+        uint maxTotalImbalance; // max total imbalance (between rate updates)
+                            // before halting trade
+    }
+
+    mapping(address => TokenControlInfo) internal tokenControlInfo;
+
+    struct TokenImbalanceData {
+        int  lastBlockBuyUnitsImbalance;
+        uint lastBlock;
+
+        int  totalBuyUnitsImbalance;
+        uint lastRateUpdateBlock;
+    }
+
+    mapping(address => mapping(uint=>uint)) public tokenImbalanceData;
+
+    constructor(address _admin) {
+        require(_admin != address(0));
+        admin = _admin;
+    }
+
+    function setTokenControlInfo(
+        ERC20 token,
+        uint minimalRecordResolution,
+        uint maxPerBlockImbalance,
+        uint maxTotalImbalance
+    )
+        public
+        onlyAdmin
+    {
+        tokenControlInfo[address(token)] =
+            TokenControlInfo(
+                minimalRecordResolution,
+                maxPerBlockImbalance,
+                maxTotalImbalance
+            );
+    }
+
+    function getTokenControlInfo(ERC20 token) public view returns(uint, uint, uint) {
+        return (tokenControlInfo[address(token)].minimalRecordResolution,
+                tokenControlInfo[address(token)].maxPerBlockImbalance,
+                tokenControlInfo[address(token)].maxTotalImbalance);
+    }
+
+    function addImbalance(
+        ERC20 token,
+        int buyAmount,
+        uint rateUpdateBlock,
+        uint currentBlock
+    )
+        internal
+    {
+        uint currentBlockIndex = currentBlock % SLIDING_WINDOW_SIZE;
+        int recordedBuyAmount = int(buyAmount / int(tokenControlInfo[address(token)].minimalRecordResolution));
+
+        int prevImbalance = 0;
+
+        TokenImbalanceData memory currentBlockData =
+            decodeTokenImbalanceData(tokenImbalanceData[address(token)][currentBlockIndex]);
+
+        // first scenario - this is not the first tx in the current block
+        if (currentBlockData.lastBlock == currentBlock) {
+            if (uint(currentBlockData.lastRateUpdateBlock) == rateUpdateBlock) {
+                // just increase imbalance
+                currentBlockData.lastBlockBuyUnitsImbalance += recordedBuyAmount;
+                currentBlockData.totalBuyUnitsImbalance += recordedBuyAmount;
+            } else {
+                // imbalance was changed in the middle of the block
+                prevImbalance = getImbalanceInRange(token, rateUpdateBlock, currentBlock);
+                currentBlockData.totalBuyUnitsImbalance = int(prevImbalance) + recordedBuyAmount;
+                currentBlockData.lastBlockBuyUnitsImbalance += recordedBuyAmount;
+                currentBlockData.lastRateUpdateBlock = uint(rateUpdateBlock);
+            }
+        } else {
+            // first tx in the current block
+            int currentBlockImbalance;
+            (prevImbalance, currentBlockImbalance) = getImbalanceSinceRateUpdate(token, rateUpdateBlock, currentBlock);
+
+            currentBlockData.lastBlockBuyUnitsImbalance = recordedBuyAmount;
+            currentBlockData.lastBlock = uint(currentBlock);
+            currentBlockData.lastRateUpdateBlock = uint(rateUpdateBlock);
+            currentBlockData.totalBuyUnitsImbalance = int(prevImbalance) + recordedBuyAmount;
+        }
+
+        tokenImbalanceData[address(token)][currentBlockIndex] = encodeTokenImbalanceData(currentBlockData);
+    }
+
+    function setGarbageToVolumeRecorder(ERC20 token) internal {
+        for (uint i = 0; i < SLIDING_WINDOW_SIZE; i++) {
+            tokenImbalanceData[address(token)][i] = 0x1;
+        }
+    }
+
+    function getImbalanceInRange(ERC20 token, uint startBlock, uint endBlock) internal view returns(int buyImbalance) {
+        // check the imbalance in the sliding window
+        require(startBlock <= endBlock);
+
+        buyImbalance = 0;
+
+        for (uint windowInd = 0; windowInd < SLIDING_WINDOW_SIZE; windowInd++) {
+            TokenImbalanceData memory perBlockData = decodeTokenImbalanceData(tokenImbalanceData[address(token)][windowInd]);
+
+            if (perBlockData.lastBlock <= endBlock && perBlockData.lastBlock >= startBlock) {
+                buyImbalance += int(perBlockData.lastBlockBuyUnitsImbalance);
+            }
+        }
+    }
+
+    function getImbalanceSinceRateUpdate(ERC20 token, uint rateUpdateBlock, uint currentBlock)
+        internal view
+        returns(int buyImbalance, int currentBlockImbalance)
+    {
+        buyImbalance = 0;
+        currentBlockImbalance = 0;
+        uint latestBlock = 0;
+        int imbalanceInRange = 0;
+        uint startBlock = rateUpdateBlock;
+        uint endBlock = currentBlock;
+
+        for (uint windowInd = 0; windowInd < SLIDING_WINDOW_SIZE; windowInd++) {
+            TokenImbalanceData memory perBlockData = decodeTokenImbalanceData(tokenImbalanceData[address(token)][windowInd]);
+
+            if (perBlockData.lastBlock <= endBlock && perBlockData.lastBlock >= startBlock) {
+                imbalanceInRange += perBlockData.lastBlockBuyUnitsImbalance;
+            }
+
+            if (perBlockData.lastRateUpdateBlock != rateUpdateBlock) continue;
+            if (perBlockData.lastBlock < latestBlock) continue;
+
+            latestBlock = perBlockData.lastBlock;
+            buyImbalance = perBlockData.totalBuyUnitsImbalance;
+            if (uint(perBlockData.lastBlock) == currentBlock) {
+                currentBlockImbalance = perBlockData.lastBlockBuyUnitsImbalance;
+            }
+        }
+
+        if (buyImbalance == 0) {
+            buyImbalance = imbalanceInRange;
+        }
+    }
+
+    function getImbalance(ERC20 token, uint rateUpdateBlock, uint currentBlock)
+        internal view
+        returns(int totalImbalance, int currentBlockImbalance)
+    {
+
+        int resolution = int(tokenControlInfo[address(token)].minimalRecordResolution);
+
+        (totalImbalance, currentBlockImbalance) =
+            getImbalanceSinceRateUpdate(
+                token,
+                rateUpdateBlock,
+                currentBlock);
+
+        totalImbalance *= resolution;
+        currentBlockImbalance *= resolution;
+    }
+
+    function getMaxPerBlockImbalance(ERC20 token) internal view returns(uint) {
+        return tokenControlInfo[address(token)].maxPerBlockImbalance;
+    }
+
+    function getMaxTotalImbalance(ERC20 token) internal view returns(uint) {
+        return tokenControlInfo[address(token)].maxTotalImbalance;
+    }
+
+    function encodeTokenImbalanceData(TokenImbalanceData memory data) internal pure returns(uint) {
+        // check for overflows
+        require(data.lastBlockBuyUnitsImbalance < int(POW_2_64 / 2));
+        require(data.lastBlockBuyUnitsImbalance > int(-1 * int(POW_2_64) / 2));
+        require(data.lastBlock < POW_2_64);
+        require(data.totalBuyUnitsImbalance < int(POW_2_64 / 2));
+        require(data.totalBuyUnitsImbalance > int(-1 * int(POW_2_64) / 2));
+        require(data.lastRateUpdateBlock < POW_2_64);
+
+        // do encoding
+        uint result = uint(data.lastBlockBuyUnitsImbalance) & (POW_2_64 - 1);
+        result |= data.lastBlock * POW_2_64;
+        result |= (uint(data.totalBuyUnitsImbalance) & (POW_2_64 - 1)) * POW_2_64 * POW_2_64;
+        result |= data.lastRateUpdateBlock * POW_2_64 * POW_2_64 * POW_2_64;
+
+        return result;
+    }
+
+    function decodeTokenImbalanceData(uint input) internal pure returns(TokenImbalanceData memory) {
+        TokenImbalanceData memory data;
+
+        data.lastBlockBuyUnitsImbalance = int(int64(uint64(input & (POW_2_64 - 1))));
+        data.lastBlock = uint(uint64((input / POW_2_64) & (POW_2_64 - 1)));
+        data.totalBuyUnitsImbalance = int(int64(uint64((input / (POW_2_64 * POW_2_64)) & (POW_2_64 - 1))));
+        data.lastRateUpdateBlock = uint(uint64((input / (POW_2_64 * POW_2_64 * POW_2_64))));
+
+        return data;
+    }
+}
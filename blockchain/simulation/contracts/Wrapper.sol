@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./ERC20Interface.sol";
+import "./Utils.sol";
+import "./ConversionRates.sol";
+import "./KyberReserve.sol";
+import "./KyberNetworkInterface.sol";
+
+contract Wrapper is Utils {
+
+    function getBalances(address reserve, ERC20[] memory tokens) public view returns(uint[] memory) {
+        uint[] memory result = new uint[](tokens.length);
+        for (uint i = 0; i < tokens.length; i++) {
+            uint balance = 0;
+            if (tokens[i] == ETH_TOKEN_ADDRESS) {
+                balance = reserve.balance;
+            } else {
+                balance = tokens[i].balanceOf(reserve);
+            }
+
+            result[i] = balance;
+        }
+
+        return result;
+    }
+
+    function getByteFromBytes14(bytes14 x, uint byteInd) public pure returns(bytes1) {
+        require(byteInd <= 13);
+        return x[byteInd];
+    }
+
+    function getInt8FromByte(bytes14 x, uint byteInd) public pure returns(int8) {
+        require(byteInd <= 13);
+        return int8(uint8(x[byteInd]));
+    }
+
+    function getTokenRates(ConversionRates ratesContract, ERC20[] memory tokenList)
+        public view
+        returns(uint[] memory, uint[] memory, int8[] memory, int8[] memory, uint[] memory)
+    {
+        uint[] memory buyBases = new uint[](tokenList.length);
+        uint[] memory sellBases = new uint[](tokenList.length);
+        int8[] memory compactBuy = new int8[](tokenList.length);
+        int8[] memory compactSell = new int8[](tokenList.length);
+        uint[] memory updateBlock = new uint[](tokenList.length);
+
+        for (uint i = 0;  i < tokenList.length; i++) {
+            buyBases[i] = ratesContract.getBasicRate(tokenList[i], true);
+            sellBases[i] = ratesContract.getBasicRate(tokenList[i], false);
+
+            (, , bytes1 buy, bytes1 sell) = ratesContract.getCompactData(tokenList[i]);
+            compactBuy[i] = int8(uint8(buy));
+            compactSell[i] = int8(uint8(sell));
+
+            updateBlock[i] = ratesContract.getRateUpdateBlock(tokenList[i]);
+        }
+
+        return (buyBases, sellBases, compactBuy, compactSell, updateBlock);
+    }
+
+    function getTokenIndicies(ConversionRates ratesContract, ERC20[] memory tokenList)
+        public view
+        returns(uint[] memory, uint[] memory)
+    {
+        uint[] memory bulkIndices = new uint[](tokenList.length);
+        uint[] memory tokenIndexInBulk = new uint[](tokenList.length);
+
+        for (uint i = 0; i < tokenList.length; i++) {
+            (uint bulkIndex, uint index, , ) = ratesContract.getCompactData(tokenList[i]);
+
+            bulkIndices[i] = bulkIndex;
+            tokenIndexInBulk[i] = index;
+        }
+
+        return (bulkIndices,tokenIndexInBulk);
+    }
+
+    function getExpectedRates(KyberNetworkInterface network, ERC20[] memory srcs, ERC20[] memory dests, uint[] memory qty)
+        public view
+        returns(uint[] memory, uint[] memory)
+    {
+        require(srcs.length == dests.length);
+        require(srcs.length == qty.length);
+
+        uint[] memory rates = new uint[](srcs.length);
+        uint[] memory slippage = new uint[](srcs.length);
+        for (uint i = 0; i < srcs.length; i++) {
+            (rates[i],slippage[i]) = network.getExpectedRate(srcs[i],dests[i],qty[i]);
+        }
+
+        return (rates, slippage);
+    }
+
+    function getReserveRate(KyberReserve reserve, ERC20[] memory srcs, ERC20[] memory dests)
+        public view
+        returns(uint[] memory, uint[] memory)
+    {
+        require(srcs.length == dests.length);
+
+        uint[] memory rates = new uint[](srcs.length);
+        uint[] memory sanityRate = new uint[](srcs.length);
+
+        for (uint i = 0 ; i < srcs.length; i++) {
+            if (address(reserve.sanityRatesContract()) != address(0)) {
+                sanityRate[i] = reserve.sanityRatesContract().getSanityRate(srcs[i], dests[i]);
+            }
+            rates[i] = reserve.getConversionRate(srcs[i], dests[i], 0, block.number);
+        }
+
+        return (rates, sanityRate);
+    }
+}
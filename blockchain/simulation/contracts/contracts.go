@@ -0,0 +1,14 @@
+// Package contracts contains the Go bindings of ConversionRates, KyberReserve, Wrapper and an ERC20
+// TestToken for deploying them on a simulated chain. The contracts are the Kyber reserve contracts in
+// this directory ported to Solidity 0.8.
+//
+// The build directory holds their ABIs and bytecode compiled by solc 0.8.21 with optimizer runs 200
+// and evm version petersburg, the latest one the EVM of go-ethereum v1.9.2 runs. The ABIs have the
+// legacy constant and payable fields which abigen of go-ethereum v1.9.2 reads to generate calls of
+// view functions.
+package contracts
+
+//go:generate abigen --abi build/ConversionRates.abi --bin build/ConversionRates.bin --pkg contracts --type ConversionRates --out conversion_rates.go
+//go:generate abigen --abi build/KyberReserve.abi --bin build/KyberReserve.bin --pkg contracts --type KyberReserve --out kyber_reserve.go
+//go:generate abigen --abi build/Wrapper.abi --bin build/Wrapper.bin --pkg contracts --type Wrapper --out wrapper.go
+//go:generate abigen --abi build/TestToken.abi --bin build/TestToken.bin --pkg contracts --type TestToken --out test_token.go
@@ -0,0 +1,236 @@
+// Package simulation provides an in-process Ethereum chain with pricing, reserve and wrapper
+// contracts for testing the blockchain package and core without a node.
+package simulation
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/KyberNetwork/reserve-data/blockchain"
+	"github.com/KyberNetwork/reserve-data/blockchain/simulation/contracts"
+	"github.com/KyberNetwork/reserve-data/common"
+	commonblockchain "github.com/KyberNetwork/reserve-data/common/blockchain"
+	"github.com/KyberNetwork/reserve-data/common/blockchain/nonce"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+const (
+	gasLimit    = 10000000
+	adminTxGas  = 6000000
+	nodeName    = "simulated"
+	nonceWindow = 0
+)
+
+// token control info of listed tokens, imbalances are recorded in units of
+// tokenMinimalRecordResolution and rates are 0 when they exceed the max imbalances.
+var (
+	tokenMinimalRecordResolution = big.NewInt(1e14)
+	tokenMaxPerBlockImbalance    = new(big.Int).Mul(big.NewInt(1e6), big.NewInt(1e18))
+	tokenMaxTotalImbalance       = new(big.Int).Mul(big.NewInt(1e7), big.NewInt(1e18))
+)
+
+var (
+	// ETHAddress is the address used for ETH by reserve contracts.
+	ETHAddress = ethereum.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+	// accountFunds is the ETH balance of accounts in genesis block.
+	accountFunds = new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e18))
+	// ReserveFunds is the ETH balance of the reserve contract when it is deployed.
+	ReserveFunds  = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	adminGasPrice = big.NewInt(1e9)
+)
+
+// keySigner signs transactions with a private key in memory.
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s keySigner) GetAddress() ethereum.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s keySigner) Sign(tx *types.Transaction) (*types.Transaction, error) {
+	return types.SignTx(tx, types.HomesteadSigner{}, s.key)
+}
+
+// Harness is a simulated chain with ConversionRates, KyberReserve and Wrapper contracts deployed
+// as pricing, reserve and wrapper, and a Blockchain wired to them with pricing and deposit operators
+// registered. Transactions stay pending until Commit is called.
+type Harness struct {
+	*blockchain.Blockchain
+	Contracts common.ContractAddressConfiguration
+
+	backend   *backends.SimulatedBackend
+	rpcClient *rpc.Client
+	admin     *bind.TransactOpts
+	pricing   *contracts.ConversionRates
+	reserve   *contracts.KyberReserve
+}
+
+// NewHarness deploys the contracts to a new simulated chain and returns the harness using sr
+// for assets.
+func NewHarness(sr storage.SettingReader) (*Harness, error) {
+	var signers [3]keySigner
+	alloc := core.GenesisAlloc{}
+	for i := range signers {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		signers[i] = keySigner{key: key}
+		alloc[signers[i].GetAddress()] = core.GenesisAccount{Balance: accountFunds}
+	}
+	admin, pricingOperator, depositOperator := signers[0], signers[1], signers[2]
+
+	backend := backends.NewSimulatedBackend(alloc, gasLimit)
+	h := &Harness{backend: backend, admin: bind.NewKeyedTransactor(admin.key)}
+	h.admin.GasLimit = adminTxGas
+	h.admin.GasPrice = adminGasPrice
+	var (
+		adminAddress = admin.GetAddress()
+		tx           *types.Transaction
+		err          error
+	)
+	h.Contracts.Pricing, tx, h.pricing, err = contracts.DeployConversionRates(h.admin, backend, adminAddress)
+	if err = h.transact(tx, err); err != nil {
+		return nil, err
+	}
+	// there is no network contract on the simulated chain, admin is the network of the reserve.
+	h.Contracts.Reserve, tx, h.reserve, err = contracts.DeployKyberReserve(h.admin, backend, adminAddress,
+		h.Contracts.Pricing, adminAddress)
+	if err = h.transact(tx, err); err != nil {
+		return nil, err
+	}
+	h.Contracts.Wrapper, tx, _, err = contracts.DeployWrapper(h.admin, backend)
+	if err = h.transact(tx, err); err != nil {
+		return nil, err
+	}
+	if err = h.transact(h.pricing.SetReserveAddress(h.admin, h.Contracts.Reserve)); err != nil {
+		return nil, err
+	}
+	// admin operates pricing to set step functions of listed tokens.
+	for _, operator := range []ethereum.Address{adminAddress, pricingOperator.GetAddress()} {
+		if err = h.transact(h.pricing.AddOperator(h.admin, operator)); err != nil {
+			return nil, err
+		}
+	}
+	if err = h.transact(h.reserve.AddOperator(h.admin, depositOperator.GetAddress())); err != nil {
+		return nil, err
+	}
+	funding := *h.admin
+	funding.Value = ReserveFunds
+	if err = h.transact((&contracts.KyberReserveRaw{Contract: h.reserve}).Transfer(&funding)); err != nil {
+		return nil, err
+	}
+
+	if h.rpcClient, err = newNode(backend); err != nil {
+		return nil, err
+	}
+	pool := commonblockchain.NewNodePool(
+		[]*commonblockchain.Node{commonblockchain.NewNode(nodeName, h.rpcClient)},
+		commonblockchain.DefaultMaxBlockLag,
+	)
+	base := commonblockchain.NewBaseBlockchain(
+		pool,
+		map[string]*commonblockchain.Operator{},
+		commonblockchain.NewBroadcaster(pool),
+		commonblockchain.NewContractCaller(pool),
+	)
+	if h.Blockchain, err = blockchain.NewBlockchain(base, &h.Contracts, sr); err != nil {
+		return nil, err
+	}
+	h.RegisterPricingOperator(pricingOperator, nonce.NewTimeWindow(pricingOperator.GetAddress(), nonceWindow))
+	h.RegisterDepositOperator(depositOperator, nonce.NewTimeWindow(depositOperator.GetAddress(), nonceWindow))
+	if err = h.LoadAndSetTokenIndices(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// transact mines the transaction sent by admin and checks that it succeeded.
+func (h *Harness) transact(tx *types.Transaction, err error) error {
+	if err != nil {
+		return err
+	}
+	h.backend.Commit()
+	receipt, err := bind.WaitMined(context.Background(), h.backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
+
+// DeployToken deploys an ERC20 token with 18 decimals and supply held by the reserve, lists it on the
+// pricing contract with flat step functions, enables its trade and reloads token indices.
+func (h *Harness) DeployToken(supply *big.Int) (ethereum.Address, error) {
+	address, tx, token, err := contracts.DeployTestToken(h.admin, h.backend, "Test Token", "TST", big.NewInt(18), supply)
+	if err = h.transact(tx, err); err != nil {
+		return ethereum.Address{}, err
+	}
+	var (
+		flat  = []*big.Int{big.NewInt(0)}
+		setup = []func() (*types.Transaction, error){
+			func() (*types.Transaction, error) { return token.Transfer(h.admin, h.Contracts.Reserve, supply) },
+			func() (*types.Transaction, error) { return h.pricing.AddToken(h.admin, address) },
+			func() (*types.Transaction, error) {
+				return h.pricing.SetTokenControlInfo(h.admin, address,
+					tokenMinimalRecordResolution, tokenMaxPerBlockImbalance, tokenMaxTotalImbalance)
+			},
+			func() (*types.Transaction, error) {
+				return h.pricing.SetQtyStepFunction(h.admin, address, flat, flat, flat, flat)
+			},
+			func() (*types.Transaction, error) {
+				return h.pricing.SetImbalanceStepFunction(h.admin, address, flat, flat, flat, flat)
+			},
+			func() (*types.Transaction, error) { return h.pricing.EnableTokenTrade(h.admin, address) },
+		}
+	)
+	for _, send := range setup {
+		if err = h.transact(send()); err != nil {
+			return ethereum.Address{}, err
+		}
+	}
+	return address, h.LoadAndSetTokenIndices()
+}
+
+// ApproveWithdrawAddress allows the reserve to withdraw token, or ETHAddress for ETH, to address.
+func (h *Harness) ApproveWithdrawAddress(token, address ethereum.Address) error {
+	return h.transact(h.reserve.ApproveWithdrawAddress(h.admin, token, address, true))
+}
+
+// Commit mines pending transactions in a new block.
+func (h *Harness) Commit() {
+	h.backend.Commit()
+}
+
+// TokenBalance returns token balance of account at the latest block.
+func (h *Harness) TokenBalance(token, account ethereum.Address) (*big.Int, error) {
+	caller, err := contracts.NewTestTokenCaller(token, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return caller.BalanceOf(&bind.CallOpts{}, account)
+}
+
+// BalanceAt returns ETH balance of account at the latest block.
+func (h *Harness) BalanceAt(account ethereum.Address) (*big.Int, error) {
+	return h.backend.BalanceAt(context.Background(), account, nil)
+}
+
+// Close stops the simulated chain.
+func (h *Harness) Close() error {
+	h.rpcClient.Close()
+	return h.backend.Close()
+}
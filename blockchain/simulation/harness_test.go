@@ -0,0 +1,148 @@
+package simulation
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+type testSettingReader struct {
+	storage.SettingReader
+	assets []commonv3.Asset
+}
+
+func (s *testSettingReader) GetAssets() ([]commonv3.Asset, error) {
+	return s.assets, nil
+}
+
+func newTestHarness(t *testing.T) (*Harness, *testSettingReader) {
+	sr := &testSettingReader{}
+	h, err := NewHarness(sr)
+	require.NoError(t, err)
+	return h, sr
+}
+
+func TestHarnessSetRates(t *testing.T) {
+	h, sr := newTestHarness(t)
+	defer h.Close()
+	supply := new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e18))
+	knc, err := h.DeployToken(supply)
+	require.NoError(t, err)
+	sr.assets = []commonv3.Asset{
+		{ID: 2, Symbol: "KNC", Address: knc, SetRate: commonv3.ExchangeFeed},
+	}
+
+	block, err := h.CurrentBlock()
+	require.NoError(t, err)
+	buy := big.NewInt(1e18)
+	sell := big.NewInt(2e15)
+	tx, err := h.SetRates([]ethereum.Address{knc}, []*big.Int{buy}, []*big.Int{sell},
+		new(big.Int).SetUint64(block), nil, big.NewInt(1e9))
+	require.NoError(t, err)
+	status, _, err := h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusPending, status)
+
+	h.Commit()
+	status, minedBlock, err := h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusMined, status)
+	assert.Equal(t, block+1, minedBlock)
+
+	rates, err := h.FetchRates(0, minedBlock)
+	require.NoError(t, err)
+	require.Contains(t, rates.Data, uint64(2))
+	assert.Equal(t, buy, rates.Data[2].BaseBuy)
+	assert.Equal(t, sell, rates.Data[2].BaseSell)
+	assert.Equal(t, int8(0), rates.Data[2].CompactBuy)
+
+	// rates within 12.7% of base are set by compact data
+	newBuy := big.NewInt(1.01e18)
+	newSell := big.NewInt(1.98e15)
+	tx, err = h.SetRates([]ethereum.Address{knc}, []*big.Int{newBuy}, []*big.Int{newSell},
+		new(big.Int).SetUint64(minedBlock), nil, big.NewInt(1e9))
+	require.NoError(t, err)
+	h.Commit()
+	status, _, err = h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusMined, status)
+
+	rates, err = h.FetchRates(0, minedBlock+1)
+	require.NoError(t, err)
+	assert.Equal(t, buy, rates.Data[2].BaseBuy)
+	assert.Equal(t, int8(10), rates.Data[2].CompactBuy)
+	assert.Equal(t, int8(-10), rates.Data[2].CompactSell)
+	assert.Equal(t, minedBlock+1, rates.Data[2].Block)
+
+	price, err := h.GetPrice(knc, big.NewInt(0), "buy", big.NewInt(0), 0)
+	require.NoError(t, err)
+	assert.Equal(t, newBuy, price)
+	price, err = h.GetPrice(knc, big.NewInt(0), "sell", big.NewInt(0), 0)
+	require.NoError(t, err)
+	assert.Equal(t, newSell, price)
+}
+
+func TestHarnessDeposit(t *testing.T) {
+	h, sr := newTestHarness(t)
+	defer h.Close()
+	supply := new(big.Int).Mul(big.NewInt(1000000), big.NewInt(1e18))
+	knc, err := h.DeployToken(supply)
+	require.NoError(t, err)
+	sr.assets = []commonv3.Asset{
+		{ID: 1, Symbol: "ETH", Address: ETHAddress},
+		{ID: 2, Symbol: "KNC", Address: knc},
+	}
+
+	balances, err := h.FetchBalanceData(h.GetReserveAddress(), 0)
+	require.NoError(t, err)
+	ethBalance := balances[1]
+	assert.True(t, ethBalance.Valid)
+	assert.Equal(t, ReserveFunds, (*big.Int)(&ethBalance.Balance))
+	assert.True(t, balances[2].Valid)
+
+	exchange := ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+	amount := big.NewInt(5e18)
+	// the reserve withdraws only to approved addresses
+	_, err = h.Send(sr.assets[1], amount, exchange)
+	assert.Error(t, err)
+	require.NoError(t, h.ApproveWithdrawAddress(knc, exchange))
+	require.NoError(t, h.ApproveWithdrawAddress(ETHAddress, exchange))
+
+	tx, err := h.Send(sr.assets[1], amount, exchange)
+	require.NoError(t, err)
+	status, _, err := h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusPending, status)
+	h.Commit()
+	status, _, err = h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusMined, status)
+	balance, err := h.TokenBalance(knc, exchange)
+	require.NoError(t, err)
+	assert.Equal(t, amount, balance)
+
+	tx, err = h.Send(sr.assets[0], amount, exchange)
+	require.NoError(t, err)
+	h.Commit()
+	status, _, err = h.TxStatus(tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusMined, status)
+	balance, err = h.BalanceAt(exchange)
+	require.NoError(t, err)
+	assert.Equal(t, amount, balance)
+
+	// withdrawing more than balance of reserve fails on gas estimation
+	_, err = h.Send(sr.assets[1], new(big.Int).Add(supply, big.NewInt(1)), exchange)
+	assert.Error(t, err)
+
+	status, _, err = h.TxStatus(ethereum.HexToHash("0x01"))
+	require.NoError(t, err)
+	assert.Equal(t, common.MiningStatusLost, status)
+}
@@ -0,0 +1,187 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	ether "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// CallArgs is the call object of eth_call and eth_estimateGas, it is exported as JSON RPC server
+// only serves methods with exported argument types.
+type CallArgs struct {
+	From     ethereum.Address  `json:"from"`
+	To       *ethereum.Address `json:"to"`
+	Gas      hexutil.Uint64    `json:"gas"`
+	GasPrice *hexutil.Big      `json:"gasPrice"`
+	Value    *hexutil.Big      `json:"value"`
+	Data     hexutil.Bytes     `json:"data"`
+}
+
+func (args CallArgs) toCallMsg() ether.CallMsg {
+	return ether.CallMsg{
+		From:     args.From,
+		To:       args.To,
+		Gas:      uint64(args.Gas),
+		GasPrice: (*big.Int)(args.GasPrice),
+		Value:    (*big.Int)(args.Value),
+		Data:     args.Data,
+	}
+}
+
+// ethAPI serves the eth namespace of JSON RPC used by ethclient from a simulated backend, so
+// the blockchain package talks to the simulated chain the same way it talks to a node.
+type ethAPI struct {
+	backend *backends.SimulatedBackend
+	signer  types.Signer
+}
+
+// blockArg converts block number argument to the block number accepted by simulated backend,
+// which only serves the latest block.
+func (api *ethAPI) blockArg(number rpc.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.backend.Blockchain().CurrentBlock().NumberU64())
+}
+
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (api *ethAPI) Call(ctx context.Context, args CallArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	if number == rpc.PendingBlockNumber {
+		return api.backend.PendingCallContract(ctx, args.toCallMsg())
+	}
+	return api.backend.CallContract(ctx, args.toCallMsg(), api.blockArg(number))
+}
+
+func (api *ethAPI) EstimateGas(ctx context.Context, args CallArgs) (hexutil.Uint64, error) {
+	gas, err := api.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+func (api *ethAPI) GetCode(ctx context.Context, address ethereum.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	if number == rpc.PendingBlockNumber {
+		return api.backend.PendingCodeAt(ctx, address)
+	}
+	return api.backend.CodeAt(ctx, address, api.blockArg(number))
+}
+
+func (api *ethAPI) GetBalance(ctx context.Context, address ethereum.Address, number rpc.BlockNumber) (*hexutil.Big, error) {
+	balance, err := api.backend.BalanceAt(ctx, address, api.blockArg(number))
+	return (*hexutil.Big)(balance), err
+}
+
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address ethereum.Address, number rpc.BlockNumber) (hexutil.Uint64, error) {
+	var (
+		nonce uint64
+		err   error
+	)
+	if number == rpc.PendingBlockNumber {
+		nonce, err = api.backend.PendingNonceAt(ctx, address)
+	} else {
+		nonce, err = api.backend.NonceAt(ctx, address, api.blockArg(number))
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+// SendRawTransaction adds the transaction to the pending block, it is mined on the next commit.
+func (api *ethAPI) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (hash ethereum.Hash, err error) {
+	tx := new(types.Transaction)
+	if err = rlp.DecodeBytes(data, tx); err != nil {
+		return hash, err
+	}
+	// simulated backend panics on invalid transactions instead of returning an error
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	if err = api.backend.SendTransaction(ctx, tx); err != nil {
+		return hash, err
+	}
+	return tx.Hash(), nil
+}
+
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash ethereum.Hash) (*types.Receipt, error) {
+	receipt, err := api.backend.TransactionReceipt(ctx, hash)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if receipt.Logs == nil {
+		receipt.Logs = []*types.Log{}
+	}
+	return receipt, nil
+}
+
+// GetTransactionByHash returns the transaction with its sender and the block it is mined in,
+// block fields are omitted for pending transactions.
+func (api *ethAPI) GetTransactionByHash(ctx context.Context, hash ethereum.Hash) (map[string]interface{}, error) {
+	tx, pending, err := api.backend.TransactionByHash(ctx, hash)
+	if err == ether.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(api.signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	result["from"] = from
+	if !pending {
+		receipt, err := api.backend.TransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			result["blockHash"] = receipt.BlockHash
+			result["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+		}
+	}
+	return result, nil
+}
+
+func (api *ethAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := api.backend.FilterLogs(ctx, ether.FilterQuery(crit))
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
+
+// newNode serves JSON RPC of the simulated backend in process.
+func newNode(backend *backends.SimulatedBackend) (*rpc.Client, error) {
+	server := rpc.NewServer()
+	api := &ethAPI{
+		backend: backend,
+		signer:  types.NewEIP155Signer(backend.Blockchain().Config().ChainID),
+	}
+	if err := server.RegisterName("eth", api); err != nil {
+		return nil, err
+	}
+	return rpc.DialInProc(server), nil
+}
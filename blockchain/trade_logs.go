@@ -0,0 +1,64 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+
+	ether "github.com/ethereum/go-ethereum"
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+const tradeExecuteEvent = "TradeExecute"
+
+// tradeExecute is the non indexed fields of a TradeExecute log.
+type tradeExecute struct {
+	Src         ethereum.Address
+	SrcAmount   *big.Int
+	DestToken   ethereum.Address
+	DestAmount  *big.Int
+	DestAddress ethereum.Address
+}
+
+// GetTradeLogs returns TradeExecute logs of the reserve contract in [fromBlock, toBlock] from the
+// node, block timestamps are not set.
+func (bc *Blockchain) GetTradeLogs(node *blockchain.Node, fromBlock, toBlock uint64) ([]common.OnChainTrade, error) {
+	event := bc.reserve.ABI.Events[tradeExecuteEvent]
+	logs, err := bc.GetLogsFrom(node, ether.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []ethereum.Address{bc.reserve.Address},
+		Topics:    [][]ethereum.Hash{{event.ID()}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	trades := make([]common.OnChainTrade, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		if len(log.Topics) != 2 {
+			return nil, fmt.Errorf("invalid topics of trade log %s:%d", log.TxHash.Hex(), log.Index)
+		}
+		var data tradeExecute
+		if err := bc.reserve.ABI.Unpack(&data, tradeExecuteEvent, log.Data); err != nil {
+			return nil, fmt.Errorf("failed to unpack trade log %s:%d, err: %v", log.TxHash.Hex(), log.Index, err)
+		}
+		trades = append(trades, common.OnChainTrade{
+			BlockNumber: log.BlockNumber,
+			BlockHash:   log.BlockHash,
+			TxHash:      log.TxHash,
+			LogIndex:    log.Index,
+			Origin:      ethereum.BytesToAddress(log.Topics[1].Bytes()),
+			SrcToken:    data.Src,
+			SrcAmount:   data.SrcAmount,
+			DestToken:   data.DestToken,
+			DestAmount:  data.DestAmount,
+			DestAddress: data.DestAddress,
+		})
+	}
+	return trades, nil
+}
@@ -1,6 +1,7 @@
 package configuration
 
 import (
+	"context"
 	"time"
 
 	"github.com/urfave/cli"
@@ -10,11 +11,13 @@ import (
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/archive"
 	"github.com/KyberNetwork/reserve-data/common/blockchain"
+	"github.com/KyberNetwork/reserve-data/common/leader"
 	"github.com/KyberNetwork/reserve-data/core"
 	"github.com/KyberNetwork/reserve-data/data"
 	"github.com/KyberNetwork/reserve-data/data/datapruner"
 	"github.com/KyberNetwork/reserve-data/data/fetcher"
 	"github.com/KyberNetwork/reserve-data/data/fetcher/httprunner"
+	"github.com/KyberNetwork/reserve-data/data/indexer"
 	"github.com/KyberNetwork/reserve-data/data/storage"
 	"github.com/KyberNetwork/reserve-data/exchange/binance"
 	"github.com/KyberNetwork/reserve-data/exchange/coinbase"
@@ -34,22 +37,31 @@ type Config struct {
 
 	World                *world.TheWorld
 	FetcherRunner        fetcher.Runner
+	FetcherSchedule      common.FetcherScheduleConfig
 	DataControllerRunner datapruner.StorageControllerRunner
 	FetcherExchanges     []fetcher.Exchange
 	Exchanges            []common.Exchange
+	ExchangePool         *ExchangePool
 	BlockchainSigner     blockchain.Signer
 	DepositSigner        blockchain.Signer
 
 	EthereumEndpoint        string
 	BackupEthereumEndpoints []string
 	Blockchain              *blockchain.BaseBlockchain
+	NodePool                *blockchain.NodePool
 
 	SettingStorage    storagev3.Interface
 	ContractAddresses *common.ContractAddressConfiguration
+	ActivityLifetime  common.ActivityLifetime
+	Reconciliation    common.ReconciliationPolicy
+
+	OnChainIndexer      common.OnChainIndexerConfig
+	OnChainTradeStorage indexer.Storage
+	Elector             *leader.Elector
 }
 
-// AddCoreConfig add config for core
-func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi binance.Interface,
+// AddCoreConfig add config for core, calls of exchanges are cancelled once ctx is done
+func (c *Config) AddCoreConfig(ctx context.Context, cliCtx *cli.Context, rcf common.RawConfig, bi binance.Interface,
 	hi huobi.Interface, cb coinbase.Interface, settingStore storagev3.Interface) error {
 	l := zap.S()
 	db, err := NewDBFromContext(cliCtx)
@@ -66,6 +78,7 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 		return err
 	}
 
+	// without runner, fetcher jobs run on their own schedules
 	var fetcherRunner fetcher.Runner
 	var dataControllerRunner datapruner.StorageControllerRunner
 	if dpl == deployment.Simulation {
@@ -74,14 +87,6 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 			return err
 		}
 	} else {
-		fetcherRunner = fetcher.NewTickerRunner(
-			time.Duration(rcf.FetcherDelay.OrderBook),
-			time.Duration(rcf.FetcherDelay.AuthData),
-			time.Duration(rcf.FetcherDelay.RateFetching),
-			time.Duration(rcf.FetcherDelay.BlockFetching),
-			time.Duration(rcf.FetcherDelay.GlobalData),
-			time.Duration(rcf.FetcherDelay.TradeHistory),
-		)
 		dataControllerRunner = datapruner.NewStorageControllerTickerRunner(24 * time.Hour)
 	}
 
@@ -90,13 +95,21 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 	c.DataGlobalStorage = dataStorage
 	c.FetcherStorage = dataStorage
 	c.FetcherGlobalStorage = dataStorage
+	c.OnChainTradeStorage, err = indexer.NewPostgresStorage(db)
+	if err != nil {
+		l.Errorw("failed to create on-chain trade storage", "err", err)
+		return err
+	}
+	c.Elector = leader.NewElector(db, rcf.Leader)
 	c.FetcherRunner = fetcherRunner
+	c.FetcherSchedule = rcf.FetcherSchedule.WithDelay(rcf.FetcherDelay)
 	c.DataControllerRunner = dataControllerRunner
 	c.BlockchainSigner = blockchain.NewEthereumSigner(rcf.PricingKeystore, rcf.PricingPassphrase)
 	c.DepositSigner = blockchain.NewEthereumSigner(rcf.DepositKeystore, rcf.DepositPassphrase)
 
 	// create Exchange pool
 	exchangePool, err := NewExchangePool(
+		ctx,
 		cliCtx,
 		rcf,
 		c.Blockchain,
@@ -122,5 +135,6 @@ func (c *Config) AddCoreConfig(cliCtx *cli.Context, rcf common.RawConfig, bi bin
 		return err
 	}
 	c.Exchanges = coreExchanges
+	c.ExchangePool = exchangePool
 	return nil
 }
@@ -1,13 +1,20 @@
 package configuration
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/cmd/configuration/provider"
 )
 
 const (
-	secretConfigFileFlag = "secret-file"
-	configFileFlag       = "config"
+	secretConfigFileFlag     = "secret-file"
+	configFileFlag           = "config"
+	secretDirFlag            = "secret-dir"
+	configEnvPrefixFlag      = "config-env-prefix"
+	configReloadIntervalFlag = "config-reload-interval"
 )
 
 // NewSecretConfigCliFlag returns the cli flag to configure secret config file flag.
@@ -25,6 +32,23 @@ func NewSecretConfigCliFlag() []cli.Flag {
 			EnvVar: "CONFIG_FILE",
 			Value:  "config.json",
 		},
+		cli.StringFlag{
+			Name:   secretDirFlag,
+			Usage:  "directory of secret files named after config keys, like a mounted secret, empty to disable",
+			EnvVar: "SECRET_DIR",
+		},
+		cli.StringFlag{
+			Name:   configEnvPrefixFlag,
+			Usage:  "prefix of environment variables overriding config keys, e.g. RESERVE_BINANCE_KEY",
+			EnvVar: "CONFIG_ENV_PREFIX",
+			Value:  "RESERVE_",
+		},
+		cli.DurationFlag{
+			Name:   configReloadIntervalFlag,
+			Usage:  "how often config sources are checked for changes, 0 to disable reload",
+			EnvVar: "CONFIG_RELOAD_INTERVAL",
+			Value:  30 * time.Second,
+		},
 	}
 }
 
@@ -36,3 +60,20 @@ func NewConfigFilesFromContext(c *cli.Context) (string, string) {
 	l.Infow("using secret config file", "file", secretConfigFile)
 	return configFile, secretConfigFile
 }
+
+// NewConfigProviderFromContext returns the config provider of the config file, the secret file,
+// the secret directory and the environment variables, in order of precedence from the lowest, and
+// the interval to check them for changes.
+func NewConfigProviderFromContext(c *cli.Context) (*provider.Provider, time.Duration) {
+	configFile, secretConfigFile := NewConfigFilesFromContext(c)
+	sources := []provider.Source{
+		provider.NewFileSource(configFile),
+		provider.NewFileSource(secretConfigFile),
+	}
+	if dir := c.GlobalString(secretDirFlag); dir != "" {
+		zap.S().Infow("using secret directory", "dir", dir)
+		sources = append(sources, provider.NewDirSource(dir))
+	}
+	sources = append(sources, provider.NewEnvSource(c.GlobalString(configEnvPrefixFlag)))
+	return provider.New(ValidateRawConfig, sources...), c.GlobalDuration(configReloadIntervalFlag)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/KyberNetwork/reserve-data/common"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 )
 
 const (
@@ -16,17 +18,30 @@ const (
 func NewExchangeCliFlag() cli.Flag {
 	return cli.StringSliceFlag{
 		Name:   exchangesFlag,
-		Usage:  "Enable an exchange for fetching order books, rebalancing purpose. By default all exchanges are disabled.",
+		Usage:  "Limit the exchange accounts to the named ones. By default all accounts enabled in settings are used.",
 		EnvVar: "KYBER_EXCHANGES",
 	}
 }
 
-// NewExchangesFromContext returns configured exchanges from cli context.
+// RegisterExchanges registers names of the exchange accounts of the setting database, it must be
+// called before exchange names are parsed.
+func RegisterExchanges(sr storage.Interface) error {
+	exchanges, err := sr.GetExchanges()
+	if err != nil {
+		return fmt.Errorf("failed to get exchanges: %s", err)
+	}
+	for _, ex := range exchanges {
+		common.RegisterExchange(common.ExchangeID(ex.ID), ex.Name)
+	}
+	return nil
+}
+
+// NewExchangesFromContext returns the exchanges named in the exchanges flag.
 func NewExchangesFromContext(c *cli.Context) ([]common.ExchangeID, error) {
 	var exchanges []common.ExchangeID
 
 	for _, exchangeName := range c.GlobalStringSlice(exchangesFlag) {
-		exchange, ok := common.ValidExchangeNames[exchangeName]
+		exchange, ok := common.ExchangeIDByName(exchangeName)
 		if !ok {
 			return nil, fmt.Errorf("invalid exchange %v", exchangeName)
 		}
@@ -35,3 +50,38 @@ func NewExchangesFromContext(c *cli.Context) ([]common.ExchangeID, error) {
 
 	return exchanges, nil
 }
+
+// EnabledExchangeAccounts returns the exchange accounts which are enabled in the setting database.
+// If the exchanges flag is set, only the named accounts are returned and a named account which is
+// disabled is an error.
+func EnabledExchangeAccounts(c *cli.Context, sr storage.Interface) ([]v3.Exchange, error) {
+	names, err := NewExchangesFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := sr.GetExchanges()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchanges: %s", err)
+	}
+	byID := make(map[common.ExchangeID]v3.Exchange, len(accounts))
+	for _, account := range accounts {
+		byID[common.ExchangeID(account.ID)] = account
+	}
+	var enabled []v3.Exchange
+	if len(names) == 0 {
+		for _, account := range accounts {
+			if !account.Disable {
+				enabled = append(enabled, account)
+			}
+		}
+		return enabled, nil
+	}
+	for _, id := range names {
+		account, ok := byID[id]
+		if !ok || account.Disable {
+			return nil, fmt.Errorf("exchange %s is not an enabled account", id.String())
+		}
+		enabled = append(enabled, account)
+	}
+	return enabled, nil
+}
@@ -0,0 +1,85 @@
+package configuration
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+type exchangesStorage struct {
+	storage.Interface
+	exchanges []v3.Exchange
+}
+
+func (s exchangesStorage) GetExchanges() ([]v3.Exchange, error) {
+	return s.exchanges, nil
+}
+
+func newExchangesContext(names ...string) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	values := cli.StringSlice(names)
+	set.Var(&values, exchangesFlag, "")
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+func accountIDs(accounts []v3.Exchange) []uint64 {
+	var ids []uint64
+	for _, account := range accounts {
+		ids = append(ids, account.ID)
+	}
+	return ids
+}
+
+func TestEnabledExchangeAccounts(t *testing.T) {
+	sr := exchangesStorage{exchanges: []v3.Exchange{
+		{ID: uint64(common.Binance), Name: "binance"},
+		{ID: uint64(common.Huobi), Name: "huobi"},
+		{ID: 10, Name: "huobi_sub", Venue: v3.VenueHuobi},
+		{ID: 11, Name: "binance_old", Venue: v3.VenueBinance, Disable: true},
+	}}
+	require.NoError(t, RegisterExchanges(sr))
+
+	// all enabled accounts are used by default
+	accounts, err := EnabledExchangeAccounts(newExchangesContext(), sr)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{uint64(common.Binance), uint64(common.Huobi), 10}, accountIDs(accounts))
+
+	// the flag limits the accounts
+	accounts, err = EnabledExchangeAccounts(newExchangesContext("huobi", "huobi_sub"), sr)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{uint64(common.Huobi), 10}, accountIDs(accounts))
+
+	// disabled accounts can not be named
+	_, err = EnabledExchangeAccounts(newExchangesContext("binance_old"), sr)
+	assert.Error(t, err)
+}
+
+func TestHuobiIntermediator(t *testing.T) {
+	rcf := common.RawConfig{
+		IntermediatorKeystore:   "intermediator.json",
+		IntermediatorPassphrase: "pass",
+		ExchangeCredentials: map[string]common.ExchangeCredentials{
+			"huobi_sub": {IntermediatorKeystore: "sub_intermediator.json", IntermediatorPassphrase: "sub_pass"},
+			"huobi_api": {Key: "key", Secret: "secret"},
+		},
+	}
+	keystore, passphrase, err := huobiIntermediator(rcf, v3.Exchange{ID: uint64(common.Huobi), Name: "huobi", CredentialsRef: "huobi_api"})
+	require.NoError(t, err)
+	assert.Equal(t, "intermediator.json", keystore)
+	assert.Equal(t, "pass", passphrase)
+
+	keystore, passphrase, err = huobiIntermediator(rcf, v3.Exchange{ID: 10, Name: "huobi_sub", CredentialsRef: "huobi_sub"})
+	require.NoError(t, err)
+	assert.Equal(t, "sub_intermediator.json", keystore)
+	assert.Equal(t, "sub_pass", passphrase)
+
+	_, _, err = huobiIntermediator(rcf, v3.Exchange{ID: 12, Name: "huobi_other", CredentialsRef: "huobi_api"})
+	assert.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package configuration
 
 import (
+	"context"
+
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
 
@@ -134,6 +136,8 @@ func CreateDataCore(config *Config, dpl deployment.Deployment, bc *blockchain.Bl
 		dpl == deployment.Simulation,
 		config.ContractAddresses,
 	)
+	dataFetcher.SetActivityLifetime(config.ActivityLifetime)
+	dataFetcher.SetSchedule(config.FetcherSchedule)
 	for _, ex := range config.FetcherExchanges {
 		dataFetcher.AddExchange(ex)
 	}
@@ -151,13 +155,16 @@ func CreateDataCore(config *Config, dpl deployment.Deployment, bc *blockchain.Bl
 		config.Exchanges,
 		config.SettingStorage,
 	)
+	rData.SetOnChainTradeStorage(config.OnChainTradeStorage)
 
-	rCore := core.NewReserveCore(bc, config.ActivityStorage, config.ContractAddresses)
+	rCore := core.NewReserveCore(bc, config.ActivityStorage, config.SettingStorage, config.ContractAddresses)
+	rCore.SetReconciliationPolicy(config.Reconciliation)
 	return rData, rCore
 }
 
-// NewConfigurationFromContext returns the Configuration object from cli context.
-func NewConfigurationFromContext(c *cli.Context, rcf common.RawConfig, s *zap.SugaredLogger) (*Config, error) {
+// NewConfigurationFromContext returns the Configuration object from cli context. Calls of nodes and
+// exchanges in progress are cancelled once ctx is done.
+func NewConfigurationFromContext(ctx context.Context, c *cli.Context, rcf common.RawConfig, s *zap.SugaredLogger) (*Config, error) {
 
 	bi := binance.NewRealInterface(rcf.ExchangeEndpoints.Binance.URL)
 	hi := huobi.NewRealInterface(rcf.ExchangeEndpoints.Houbi.URL)
@@ -181,8 +188,12 @@ func NewConfigurationFromContext(c *cli.Context, rcf common.RawConfig, s *zap.Su
 	if err != nil {
 		return nil, err
 	}
+	if err = RegisterExchanges(sr); err != nil {
+		return nil, err
+	}
 
 	config, err := GetConfig(
+		ctx,
 		c,
 		ethereumNodeConf,
 		bi,
@@ -1,7 +1,10 @@
 package configuration
 
 import (
-	"github.com/ethereum/go-ethereum/ethclient"
+	"context"
+	"strings"
+	"time"
+
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
@@ -16,8 +19,17 @@ import (
 	"github.com/KyberNetwork/reserve-data/world"
 )
 
-// GetConfig return config for core
+// dialNode connects to the node, calls over HTTP in progress are cancelled once ctx is done.
+func dialNode(ctx context.Context, nodeURL string) (*rpc.Client, error) {
+	if strings.HasPrefix(nodeURL, "http://") || strings.HasPrefix(nodeURL, "https://") {
+		return rpc.DialHTTPWithClient(nodeURL, common.NewContextClient(ctx, 0))
+	}
+	return rpc.DialContext(ctx, nodeURL)
+}
+
+// GetConfig return config for core, calls of nodes and exchanges are cancelled once ctx is done
 func GetConfig(
+	ctx context.Context,
 	cliCtx *cli.Context,
 	nodeConf *EthereumNodeConfiguration,
 	bi binance.Interface,
@@ -30,39 +42,37 @@ func GetConfig(
 	l := zap.S()
 
 	//set client & endpoint
-	client, err := rpc.Dial(nodeConf.Main)
+	client, err := dialNode(ctx, nodeConf.Main)
 	if err != nil {
 		return nil, err
 	}
 
-	mainClient := ethclient.NewClient(client)
-	bkClients := map[string]*ethclient.Client{}
-
-	var callClients []*common.EthClient
-
-	// add main node to callclients
-	callClients = append(callClients, &common.EthClient{
-		Client: mainClient,
-		URL:    nodeConf.Main,
-	})
+	// main node is the first node of the pool
+	nodes := []*blockchain.Node{blockchain.NewNode(nodeConf.Main, client)}
 	for _, ep := range nodeConf.Backup {
-		var bkClient *ethclient.Client
-		bkClient, err = ethclient.Dial(ep)
+		var bkClient *rpc.Client
+		bkClient, err = dialNode(ctx, ep)
 		if err != nil {
 			l.Warnw("Cannot connect to rpc endpoint", "endpoint", ep, "err", err)
 		} else {
-			bkClients[ep] = bkClient
-			callClients = append(callClients, &common.EthClient{
-				Client: bkClient,
-				URL:    ep,
-			})
+			nodes = append(nodes, blockchain.NewNode(ep, bkClient))
 		}
 	}
+	maxBlockLag := rcf.Nodes.MaxBlockLag
+	if maxBlockLag == 0 {
+		maxBlockLag = blockchain.DefaultMaxBlockLag
+	}
+	checkInterval := time.Duration(rcf.Nodes.CheckInterval)
+	if checkInterval == 0 {
+		checkInterval = blockchain.DefaultNodeCheckInterval
+	}
+	pool := blockchain.NewNodePool(nodes, maxBlockLag)
+	go pool.Run(ctx, checkInterval)
 
 	bc := blockchain.NewBaseBlockchain(
-		client, mainClient, map[string]*blockchain.Operator{},
-		blockchain.NewBroadcaster(bkClients),
-		blockchain.NewContractCaller(callClients),
+		pool, map[string]*blockchain.Operator{},
+		blockchain.NewBroadcaster(pool),
+		blockchain.NewContractCaller(pool),
 	)
 
 	s3archive := archive.NewS3Archive(rcf.AWSConfig)
@@ -70,16 +80,20 @@ func GetConfig(
 
 	config := &Config{
 		Blockchain:              bc,
+		NodePool:                pool,
 		EthereumEndpoint:        nodeConf.Main,
 		BackupEthereumEndpoints: nodeConf.Backup,
 		Archive:                 s3archive,
 		World:                   theWorld,
 		ContractAddresses:       contractAddressConf,
 		SettingStorage:          settingStorage,
+		ActivityLifetime:        rcf.ActivityLifetime,
+		Reconciliation:          rcf.Reconciliation,
+		OnChainIndexer:          rcf.OnChainIndexer,
 	}
 
 	l.Infow("configured endpoint", "endpoint", config.EthereumEndpoint, "backup", config.BackupEthereumEndpoints)
-	if err = config.AddCoreConfig(cliCtx, rcf, bi, hi, cb, settingStorage); err != nil {
+	if err = config.AddCoreConfig(ctx, cliCtx, rcf, bi, hi, cb, settingStorage); err != nil {
 		return nil, err
 	}
 	return config, nil
@@ -1,9 +1,9 @@
 package configuration
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
@@ -20,13 +20,20 @@ import (
 	binanceStorage "github.com/KyberNetwork/reserve-data/exchange/binance/storage"
 	"github.com/KyberNetwork/reserve-data/exchange/coinbase"
 	"github.com/KyberNetwork/reserve-data/exchange/huobi"
+	huobihttp "github.com/KyberNetwork/reserve-data/exchange/huobi/http"
 	huobiStorage "github.com/KyberNetwork/reserve-data/exchange/huobi/storage"
+	"github.com/KyberNetwork/reserve-data/exchange/simulation"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 )
 
 type ExchangePool struct {
 	Exchanges map[common.ExchangeID]interface{}
 	l         *zap.SugaredLogger
+
+	accounts         map[common.ExchangeID]v3.Exchange
+	binanceEndpoints map[common.ExchangeID]*binance.Endpoint
+	huobiEndpoints   map[common.ExchangeID]*huobi.Endpoint
 }
 
 func updateTradingPairConf(
@@ -78,7 +85,10 @@ func updateTradingPairConf(
 	}
 }
 
-func updateDepositAddress(assetStorage storage.Interface, be exchange.BinanceInterface, he exchange.HuobiInterface) {
+func updateDepositAddress(
+	assetStorage storage.Interface,
+	binanceEndpoints map[common.ExchangeID]*binance.Endpoint,
+	huobiEndpoints map[common.ExchangeID]*huobi.Endpoint) {
 	l := zap.S()
 	assets, err := assetStorage.GetTransferableAssets()
 	if err != nil {
@@ -87,19 +97,15 @@ func updateDepositAddress(assetStorage storage.Interface, be exchange.BinanceInt
 	}
 	for _, asset := range assets {
 		for _, ae := range asset.Exchanges {
-			switch ae.ExchangeID {
-			case uint64(common.Binance), uint64(common.Binance2):
+			exchangeID := common.ExchangeID(ae.ExchangeID)
+			if be, ok := binanceEndpoints[exchangeID]; ok {
 				l.Warnw("updating deposit address for asset", "asset_id", asset.ID,
-					"exchange", common.Binance.String(), "symbol", ae.Symbol)
-				if be == nil {
-					l.Warnw("abort updating deposit address due binance exchange disabled")
-					continue
-				}
+					"exchange", exchangeID.String(), "symbol", ae.Symbol)
 				depositAddress, err := be.GetDepositAddress(ae.Symbol)
 				if err != nil {
 					l.Warnw("failed to get deposit address for asset",
 						"asset_id", asset.ID,
-						"exchange", common.Binance.String(), "symbol", ae.Symbol, "err", err.Error())
+						"exchange", exchangeID.String(), "symbol", ae.Symbol, "err", err.Error())
 					continue
 				}
 				err = assetStorage.UpdateDepositAddress(
@@ -108,41 +114,81 @@ func updateDepositAddress(assetStorage storage.Interface, be exchange.BinanceInt
 					ethereum.HexToAddress(depositAddress.Address))
 				if err != nil {
 					l.Warnw("assetStorage.UpdateDepositAddress", "err", err.Error())
-					continue
 				}
-			case uint64(common.Huobi):
-				l.Warnw("updating deposit address for asset",
+				continue
+			}
+			he, ok := huobiEndpoints[exchangeID]
+			if !ok {
+				continue
+			}
+			l.Warnw("updating deposit address for asset",
+				"asset_id", asset.ID,
+				"exchange", exchangeID.String(),
+				"symbol", ae.Symbol)
+			depositAddress, err := he.GetDepositAddress(ae.Symbol)
+			if err != nil {
+				l.Warnw("failed to get deposit address for asset",
 					"asset_id", asset.ID,
-					"exchange", common.Huobi.String(),
-					"symbol", ae.Symbol)
-				if he == nil {
-					l.Warnw("abort updating deposit address due huobi exchange disabled")
-					continue
-				}
-				depositAddress, err := he.GetDepositAddress(ae.Symbol)
+					"exchange", exchangeID.String(),
+					"symbol", ae.Symbol, "err", err)
+				continue
+			}
+			if len(depositAddress.Data) != 0 {
+				err = assetStorage.UpdateDepositAddress(
+					asset.ID,
+					ae.ExchangeID,
+					ethereum.HexToAddress(depositAddress.Data[0].Address))
 				if err != nil {
-					l.Warnw("failed to get deposit address for asset",
-						"asset_id", asset.ID,
-						"exchange", common.Huobi.String(),
-						"symbol", ae.Symbol, "err", err)
-					continue
-				}
-				if len(depositAddress.Data) != 0 {
-					err = assetStorage.UpdateDepositAddress(
-						asset.ID,
-						uint64(common.Huobi),
-						ethereum.HexToAddress(depositAddress.Data[0].Address))
-					if err != nil {
-						l.Warnw("assetStorage.UpdateDepositAddress", "err", err.Error())
-						continue
-					}
+					l.Warnw("assetStorage.UpdateDepositAddress", "err", err.Error())
 				}
 			}
 		}
 	}
 }
 
+// exchangeCredentials returns the API key and secret of the exchange account. Accounts with a
+// credentials reference use the entry of exchange_credentials, built-in accounts without one use
+// their own config keys.
+func exchangeCredentials(rcf common.RawConfig, account v3.Exchange) (string, string, error) {
+	if account.CredentialsRef != "" {
+		credentials, ok := rcf.ExchangeCredentials[account.CredentialsRef]
+		if !ok {
+			return "", "", fmt.Errorf("credentials %s of exchange %s are not configured", account.CredentialsRef, account.Name)
+		}
+		return credentials.Key, credentials.Secret, nil
+	}
+	switch common.ExchangeID(account.ID) {
+	case common.Binance:
+		return rcf.BinanceKey, rcf.BinanceSecret, nil
+	case common.Binance2:
+		return rcf.Binance2Key, rcf.Binance2Secret, nil
+	case common.Huobi:
+		return rcf.HoubiKey, rcf.HoubiSecret, nil
+	}
+	return "", "", fmt.Errorf("credentials_ref of exchange %s is required", account.Name)
+}
+
+// huobiIntermediator returns the keystore and passphrase of the intermediator account of the huobi
+// account. Accounts with a credentials reference use the intermediator of their entry of
+// exchange_credentials, the built-in account without one uses the intermediator config keys.
+func huobiIntermediator(rcf common.RawConfig, account v3.Exchange) (string, string, error) {
+	if account.CredentialsRef != "" {
+		credentials := rcf.ExchangeCredentials[account.CredentialsRef]
+		if credentials.IntermediatorKeystore != "" {
+			return credentials.IntermediatorKeystore, credentials.IntermediatorPassphrase, nil
+		}
+	}
+	if common.ExchangeID(account.ID) == common.Huobi {
+		return rcf.IntermediatorKeystore, rcf.IntermediatorPassphrase, nil
+	}
+	return "", "", fmt.Errorf("intermediator of exchange %s is not configured", account.Name)
+}
+
+// NewExchangePool creates the exchanges of the accounts enabled in the setting database, the
+// exchanges flag limits them to the named accounts. Their calls in progress are cancelled once ctx
+// is done. bi, hi and cb are used by accounts without an endpoint.
 func NewExchangePool(
+	ctx context.Context,
 	c *cli.Context,
 	rcf common.RawConfig,
 	blockchain *blockchaincommon.BaseBlockchain,
@@ -154,13 +200,16 @@ func NewExchangePool(
 ) (*ExchangePool, error) {
 	exchanges := map[common.ExchangeID]interface{}{}
 	var (
-		be      exchange.BinanceInterface
-		he      exchange.HuobiInterface
-		bin, hb common.Exchange
-		s       = zap.S()
+		s                = zap.S()
+		accounts         = map[common.ExchangeID]v3.Exchange{}
+		binanceEndpoints = map[common.ExchangeID]*binance.Endpoint{}
+		huobiEndpoints   = map[common.ExchangeID]*huobi.Endpoint{}
+		tradingExchanges = map[common.ExchangeID]common.Exchange{}
+		huobiPool        exchange.HuobiPool
+		intermediators   = map[string]common.ExchangeID{}
 	)
 
-	enabledExchanges, err := NewExchangesFromContext(c)
+	enabledAccounts, err := EnabledExchangeAccounts(c, assetStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -169,39 +218,75 @@ func NewExchangePool(
 	if err != nil {
 		return nil, fmt.Errorf("can not init postgres storage: (%s)", err.Error())
 	}
-	httpClient := &http.Client{Timeout: time.Second * 30}
-	for _, exparam := range enabledExchanges {
-		switch exparam {
-		case common.Binance, common.Binance2:
-			binanceSigner := binance.NewSigner(rcf.BinanceKey, rcf.BinanceSecret)
-			if exparam == common.Binance2 {
-				binanceSigner = binance.NewSigner(rcf.Binance2Key, rcf.Binance2Secret)
+	httpClient := common.NewContextClient(ctx, time.Second*30)
+	for _, account := range enabledAccounts {
+		exparam := common.ExchangeID(account.ID)
+		if simulationConf, ok := rcf.Simulation.Exchanges[exparam.String()]; ok && dpl == deployment.Simulation {
+			s.Infow("using simulated exchange", "exchange", exparam.String())
+			exchanges[exparam] = simulation.NewExchange(exparam, simulationConf, rcf.Simulation.Seed, assetStorage)
+			continue
+		}
+		accounts[exparam] = account
+		switch account.Venue {
+		case v3.VenueBinance:
+			key, secret, err := exchangeCredentials(rcf, account)
+			if err != nil {
+				return nil, err
+			}
+			interf := bi
+			if account.Endpoint != "" {
+				interf = binance.NewRealInterface(account.Endpoint)
 			}
-			be = binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient)
+			binanceEndpoint := binance.NewBinanceEndpoint(exparam, binance.NewSigner(key, secret), interf, dpl, httpClient)
+			binanceEndpoints[exparam] = binanceEndpoint
 			binancestorage, err := binanceStorage.NewPostgresStorage(db)
 			if err != nil {
 				return nil, fmt.Errorf("can not create Binance storage: (%s)", err.Error())
 			}
-			bin, err = exchange.NewBinance(
+			var options []exchange.BinanceOption
+			if exparam == common.Binance && rcf.Binance2Email != "" {
+				options = append(options, exchange.WithSubAccount(common.Binance2, rcf.Binance2Email))
+			}
+			bin, err := exchange.NewBinance(
 				exparam,
-				be,
+				binanceEndpoint,
 				binancestorage,
-				assetStorage)
+				assetStorage,
+				options...)
 			if err != nil {
-				return nil, fmt.Errorf("can not create exchange Binance: (%s)", err.Error())
+				return nil, fmt.Errorf("can not create exchange %s: (%s)", exparam.String(), err.Error())
 			}
 			exchanges[bin.ID()] = bin
-		case common.Huobi:
-			huobiSigner := huobi.NewSigner(rcf.HoubiKey, rcf.HoubiSecret)
-			he = huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient)
-			huobistorage, err := huobiStorage.NewPostgresStorage(db)
+			tradingExchanges[bin.ID()] = bin
+		case v3.VenueHuobi:
+			key, secret, err := exchangeCredentials(rcf, account)
 			if err != nil {
-				return nil, fmt.Errorf("can not create Binance storage: (%s)", err.Error())
+				return nil, err
+			}
+			interf := hi
+			if account.Endpoint != "" {
+				interf = huobi.NewRealInterface(account.Endpoint)
+			}
+			huobiEndpoint := huobi.NewHuobiEndpoint(exparam, huobi.NewSigner(key, secret), interf, httpClient)
+			huobiEndpoints[exparam] = huobiEndpoint
+			huobistorage, err := huobiStorage.NewPostgresStorage(db, exparam)
+			if err != nil {
+				return nil, fmt.Errorf("can not create Huobi storage: (%s)", err.Error())
 			}
-			intermediatorSigner := blockchaincommon.NewEthereumSigner(rcf.IntermediatorKeystore, rcf.IntermediatorPassphrase)
+			keystore, passphrase, err := huobiIntermediator(rcf, account)
+			if err != nil {
+				return nil, err
+			}
+			// the nonces of an intermediator are tracked per account
+			if other, ok := intermediators[keystore]; ok {
+				return nil, fmt.Errorf("exchange %s and %s use the same intermediator", other.String(), exparam.String())
+			}
+			intermediators[keystore] = exparam
+			intermediatorSigner := blockchaincommon.NewEthereumSigner(keystore, passphrase)
 			intermediatorNonce := nonce.NewTimeWindow(intermediatorSigner.GetAddress(), 10000)
-			hb, err = exchange.NewHuobi(
-				he,
+			hb, err := exchange.NewHuobi(
+				exparam,
+				huobiEndpoint,
 				blockchain,
 				intermediatorSigner,
 				intermediatorNonce,
@@ -209,29 +294,61 @@ func NewExchangePool(
 				assetStorage,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("can not create exchange Huobi: (%s)", err.Error())
+				return nil, fmt.Errorf("can not create exchange %s: (%s)", exparam.String(), err.Error())
 			}
 			exchanges[hb.ID()] = hb
-		case common.Coinbase:
-			ep := coinbase.NewCoinbaseEndpoint(cb, httpClient)
-			cbex := exchange.NewCoinbase(s, common.Coinbase, ep, assetStorage)
-			exchanges[common.Coinbase] = cbex
+			tradingExchanges[hb.ID()] = hb
+			huobiPool = append(huobiPool, hb)
+		case v3.VenueCoinbase:
+			interf := cb
+			if account.Endpoint != "" {
+				interf = coinbase.NewRealInterface(account.Endpoint)
+			}
+			ep := coinbase.NewCoinbaseEndpoint(interf, httpClient)
+			exchanges[exparam] = exchange.NewCoinbase(s, exparam, ep, assetStorage)
+		default:
+			return nil, fmt.Errorf("exchange %s has unsupported venue %q", exparam.String(), account.Venue)
 		}
 	}
 
-	go updateDepositAddress(assetStorage, be, he)
-	if bin != nil {
-		go updateTradingPairConf(assetStorage, bin, uint64(common.Binance))
+	if len(huobiPool) != 0 {
+		go huobihttp.NewHuobiHTTPServer(huobiPool).Run()
 	}
-	if hb != nil {
-		go updateTradingPairConf(assetStorage, hb, uint64(common.Huobi))
+	go updateDepositAddress(assetStorage, binanceEndpoints, huobiEndpoints)
+	for id, ex := range tradingExchanges {
+		go updateTradingPairConf(assetStorage, ex, uint64(id))
 	}
 	return &ExchangePool{
-		Exchanges: exchanges,
-		l:         s,
+		Exchanges:        exchanges,
+		l:                s,
+		accounts:         accounts,
+		binanceEndpoints: binanceEndpoints,
+		huobiEndpoints:   huobiEndpoints,
 	}, nil
 }
 
+// SetCredentials replaces API keys of the exchanges with the ones of the config.
+func (ep *ExchangePool) SetCredentials(rcf common.RawConfig) {
+	for id, endpoint := range ep.binanceEndpoints {
+		key, secret, err := exchangeCredentials(rcf, ep.accounts[id])
+		if err != nil {
+			ep.l.Errorw("failed to reload exchange API key", "exchange", id.String(), "err", err)
+			continue
+		}
+		endpoint.SetSigner(binance.NewSigner(key, secret))
+		ep.l.Infow("exchange API key is reloaded", "exchange", id.String())
+	}
+	for id, endpoint := range ep.huobiEndpoints {
+		key, secret, err := exchangeCredentials(rcf, ep.accounts[id])
+		if err != nil {
+			ep.l.Errorw("failed to reload exchange API key", "exchange", id.String(), "err", err)
+			continue
+		}
+		endpoint.SetSigner(huobi.NewSigner(key, secret))
+		ep.l.Infow("exchange API key is reloaded", "exchange", id.String())
+	}
+}
+
 func (ep *ExchangePool) FetcherExchanges() ([]fetcher.Exchange, error) {
 	var result []fetcher.Exchange
 	for _, ex := range ep.Exchanges {
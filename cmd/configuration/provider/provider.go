@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// ValidateFunc validates a config before it replaces the current one, old is empty on the first
+// load.
+type ValidateFunc func(old, new common.RawConfig) error
+
+// ReloadFunc applies a reloaded config.
+type ReloadFunc func(old, new common.RawConfig)
+
+type reloader struct {
+	keys []string
+	fn   ReloadFunc
+}
+
+// Provider loads the raw config from its sources in order, settings of a source override the ones
+// of previous sources. It reloads the config when a source changes, the new config is validated
+// before it replaces the current one and is passed to the reloaders of the changed keys.
+type Provider struct {
+	sources  []Source
+	validate ValidateFunc
+	l        *zap.SugaredLogger
+
+	mu        sync.Mutex
+	config    common.RawConfig
+	versions  []string
+	reloaders []reloader
+}
+
+// New creates a provider of the sources, validate can be nil.
+func New(validate ValidateFunc, sources ...Source) *Provider {
+	return &Provider{
+		sources:  sources,
+		validate: validate,
+		l:        zap.S(),
+	}
+}
+
+// OnReload registers fn to be called when one of the top level keys of the config is changed by
+// a reload, changes of keys without reloaders require a restart. fn is called while the provider is
+// locked, it must not call the provider.
+func (p *Provider) OnReload(keys []string, fn ReloadFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reloaders = append(p.reloaders, reloader{keys: keys, fn: fn})
+}
+
+func (p *Provider) currentVersions() ([]string, error) {
+	versions := make([]string, 0, len(p.sources))
+	for _, source := range p.sources {
+		version, err := source.Version()
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+func (p *Provider) load(old common.RawConfig) (common.RawConfig, error) {
+	var rcf common.RawConfig
+	for _, source := range p.sources {
+		if err := source.Load(&rcf); err != nil {
+			return common.RawConfig{}, err
+		}
+	}
+	if p.validate != nil {
+		if err := p.validate(old, rcf); err != nil {
+			return common.RawConfig{}, err
+		}
+	}
+	return rcf, nil
+}
+
+// Load loads and validates the config from the sources.
+func (p *Provider) Load() (common.RawConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	versions, err := p.currentVersions()
+	if err != nil {
+		return common.RawConfig{}, err
+	}
+	rcf, err := p.load(common.RawConfig{})
+	if err != nil {
+		return common.RawConfig{}, err
+	}
+	p.config, p.versions = rcf, versions
+	return rcf, nil
+}
+
+// Config returns the current config.
+func (p *Provider) Config() common.RawConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.config
+}
+
+// Reload reloads the config if a source is changed. Every reload, applied or rejected, is logged
+// with the changed sources and keys, values are not logged as they may be secrets. A rejected
+// change is not retried until a source changes again.
+func (p *Provider) Reload() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	versions, err := p.currentVersions()
+	if err != nil {
+		p.l.Warnw("failed to check config sources", "err", err)
+		return err
+	}
+	var sources []string
+	for i, source := range p.sources {
+		if versions[i] != p.versions[i] {
+			sources = append(sources, source.Name())
+		}
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+	p.versions = versions
+
+	old := p.config
+	rcf, err := p.load(old)
+	if err != nil {
+		p.l.Errorw("config reload is rejected", "sources", sources, "err", err)
+		return err
+	}
+	p.config = rcf
+
+	keys := changedKeys(old, rcf)
+	handled := make(map[string]bool)
+	for _, r := range p.reloaders {
+		for _, key := range r.keys {
+			handled[key] = true
+		}
+	}
+	var restart []string
+	for _, key := range keys {
+		if !handled[key] {
+			restart = append(restart, key)
+		}
+	}
+	p.l.Infow("config is reloaded", "sources", sources, "changed", keys, "restart_required", restart)
+	for _, r := range p.reloaders {
+		if intersects(r.keys, keys) {
+			r.fn(old, rcf)
+		}
+	}
+	return nil
+}
+
+// Watch reloads the config every interval until ctx is done.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = p.Reload()
+		}
+	}
+}
+
+// changedKeys returns the sorted json keys of the top level fields changed between the configs.
+func changedKeys(old, new common.RawConfig) []string {
+	var (
+		ov   = reflect.ValueOf(old)
+		nv   = reflect.ValueOf(new)
+		t    = ov.Type()
+		keys []string
+	)
+	for i := 0; i < t.NumField(); i++ {
+		if !reflect.DeepEqual(ov.Field(i).Interface(), nv.Field(i).Interface()) {
+			keys = append(keys, strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+func TestProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "provider")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	var (
+		configFile = filepath.Join(dir, "config.json")
+		secretDir  = filepath.Join(dir, "secrets")
+		write      = func(path, content string) {
+			require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		}
+		env = EnvSource{prefix: "RESERVE_", environ: func() []string {
+			return []string{"RESERVE_HUOBI_KEY=env-key", "RESERVE_UNKNOWN=1", "HUOBI_SECRET=ignored"}
+		}}
+	)
+	require.NoError(t, os.Mkdir(secretDir, 0700))
+	write(configFile, `{"nodes": {"main": "http://node1"}, "binance_key": "file-key", "http_api_addr": ":8000"}`)
+	write(filepath.Join(secretDir, "binance_key"), "dir-key\n")
+	write(filepath.Join(secretDir, "world_endpoints"), `{"gold_data": {"url": "http://gold"}}`)
+	write(filepath.Join(secretDir, ".hidden"), "x")
+
+	p := New(func(old, new common.RawConfig) error {
+		if new.Nodes.Main == "" {
+			return errors.New("main node is required")
+		}
+		return nil
+	}, NewFileSource(configFile), NewDirSource(secretDir), env)
+	rcf, err := p.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "dir-key", rcf.BinanceKey)
+	assert.Equal(t, "env-key", rcf.HoubiKey)
+	assert.Equal(t, "http://gold", rcf.WorldEndpoints.GoldData.URL)
+	assert.Equal(t, "http://node1", rcf.Nodes.Main)
+
+	var reloaded []string
+	p.OnReload([]string{"binance_key", "binance_secret"}, func(old, new common.RawConfig) {
+		reloaded = append(reloaded, old.BinanceKey+"->"+new.BinanceKey)
+	})
+	p.OnReload([]string{"nodes"}, func(old, new common.RawConfig) {
+		reloaded = append(reloaded, new.Nodes.Main)
+	})
+
+	// nothing is changed
+	require.NoError(t, p.Reload())
+	assert.Empty(t, reloaded)
+
+	write(filepath.Join(secretDir, "binance_key"), "rotated-key")
+	require.NoError(t, p.Reload())
+	assert.Equal(t, []string{"dir-key->rotated-key"}, reloaded)
+	assert.Equal(t, "rotated-key", p.Config().BinanceKey)
+
+	// invalid config is rejected and the current one is kept
+	write(configFile, `{"nodes": {"main": ""}, "binance_key": "file-key"}`)
+	assert.Error(t, p.Reload())
+	assert.Equal(t, "http://node1", p.Config().Nodes.Main)
+	// the rejected change is not retried
+	assert.NoError(t, p.Reload())
+
+	write(configFile, `{"nodes": {"main": "http://node2"}, "http_api_addr": ":9000"}`)
+	require.NoError(t, p.Reload())
+	assert.Equal(t, []string{"dir-key->rotated-key", "http://node2"}, reloaded)
+	assert.Equal(t, ":9000", p.Config().HTTPAPIAddr)
+}
+
+func TestChangedKeys(t *testing.T) {
+	old := common.RawConfig{BinanceKey: "a", Nodes: common.Nodes{Main: "http://node1"}}
+	new := old
+	new.BinanceKey = "b"
+	new.Nodes.Backup = []string{"http://node2"}
+	assert.Equal(t, []string{"binance_key", "nodes"}, changedKeys(old, new))
+	assert.Empty(t, changedKeys(old, old))
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// Source is a source of the raw config.
+type Source interface {
+	// Name identifies the source in logs, it must not contain secrets.
+	Name() string
+	// Version returns a fingerprint of the content of the source, the config is reloaded when it
+	// changes.
+	Version() (string, error)
+	// Load applies the settings of the source on top of rcf.
+	Load(rcf *common.RawConfig) error
+}
+
+func fingerprint(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// FileSource is a JSON config file, settings in the file override the ones of previous sources.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates a source of the JSON file.
+func NewFileSource(path string) FileSource {
+	return FileSource{path: path}
+}
+
+// Name returns the path of the file.
+func (s FileSource) Name() string {
+	return "file:" + s.path
+}
+
+// Version returns the hash of the file content.
+func (s FileSource) Version() (string, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+	return fingerprint(data), nil
+}
+
+// Load reads the file into rcf.
+func (s FileSource) Load(rcf *common.RawConfig) error {
+	data, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, rcf); err != nil {
+		return fmt.Errorf("failed to parse %s: %s", s.path, err)
+	}
+	return nil
+}
+
+// setField sets the top level field of rcf with the json key. String fields take the value as is,
+// other fields take it as JSON. It returns false if there is no field with the key.
+func setField(rcf *common.RawConfig, key, value string) (bool, error) {
+	v := reflect.ValueOf(rcf).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] != key {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.String {
+			field.SetString(value)
+			return true, nil
+		}
+		if err := json.Unmarshal([]byte(value), field.Addr().Interface()); err != nil {
+			return true, fmt.Errorf("invalid value of %s: %s", key, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// EnvSource is the environment variables named after keys of the config with a prefix, for
+// example RESERVE_BINANCE_KEY for binance_key with prefix RESERVE_.
+type EnvSource struct {
+	prefix  string
+	environ func() []string
+}
+
+// NewEnvSource creates a source of environment variables with the prefix.
+func NewEnvSource(prefix string) EnvSource {
+	return EnvSource{prefix: prefix, environ: os.Environ}
+}
+
+// Name returns the prefix of the variables.
+func (s EnvSource) Name() string {
+	return "env:" + s.prefix
+}
+
+// variables returns the variables with the prefix by lower case keys without the prefix.
+func (s EnvSource) variables() map[string]string {
+	variables := make(map[string]string)
+	for _, kv := range s.environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], s.prefix) {
+			continue
+		}
+		variables[strings.ToLower(strings.TrimPrefix(parts[0], s.prefix))] = parts[1]
+	}
+	return variables
+}
+
+// Version returns the hash of the variables with the prefix.
+func (s EnvSource) Version() (string, error) {
+	return versionOf(s.variables()), nil
+}
+
+// Load sets the fields of the variables, variables not matching a field are ignored.
+func (s EnvSource) Load(rcf *common.RawConfig) error {
+	for key, value := range s.variables() {
+		if _, err := setField(rcf, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DirSource is a directory of files named after keys of the config, like a mounted Kubernetes
+// secret. A file binance_key holds the value of binance_key, surrounding spaces are trimmed.
+type DirSource struct {
+	dir string
+}
+
+// NewDirSource creates a source of the directory.
+func NewDirSource(dir string) DirSource {
+	return DirSource{dir: dir}
+}
+
+// Name returns the path of the directory.
+func (s DirSource) Name() string {
+	return "dir:" + s.dir
+}
+
+// files reads the regular files of the directory, hidden entries like the ..data link of mounted
+// secrets are skipped.
+func (s DirSource) files() (map[string]string, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(s.dir, entry.Name())
+		// files of mounted secrets are symlinks, stat follows them
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = strings.TrimSpace(string(data))
+	}
+	return files, nil
+}
+
+// Version returns the hash of names and contents of the files.
+func (s DirSource) Version() (string, error) {
+	files, err := s.files()
+	if err != nil {
+		return "", err
+	}
+	return versionOf(files), nil
+}
+
+// Load sets the fields of the files, files not matching a field are ignored.
+func (s DirSource) Load(rcf *common.RawConfig) error {
+	files, err := s.files()
+	if err != nil {
+		return err
+	}
+	for key, value := range files {
+		if _, err := setField(rcf, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func versionOf(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(h, "%s=%q\n", key, values[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
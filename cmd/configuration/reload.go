@@ -0,0 +1,143 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/cmd/configuration/provider"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+// credentialKeys are the config keys of exchange API keys, they are reloaded without restart.
+var credentialKeys = []string{
+	"binance_key", "binance_secret",
+	"binance_2_key", "binance_2_secret",
+	"huobi_key", "huobi_secret",
+	"exchange_credentials",
+}
+
+func validateURL(name, value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid url of %s: %s", name, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid url of %s: scheme and host are required", name)
+	}
+	return nil
+}
+
+// ValidateRawConfig validates a loaded config before it replaces the current one. Node and world
+// endpoint urls must be valid, an exchange key must come with its secret and a reload must not
+// remove a key, which happens when a secret file is partially written.
+func ValidateRawConfig(old, new common.RawConfig) error {
+	if new.Nodes.Main == "" {
+		return fmt.Errorf("main node is required")
+	}
+	for _, node := range append([]string{new.Nodes.Main}, new.Nodes.Backup...) {
+		if err := validateURL("node", node); err != nil {
+			return err
+		}
+	}
+
+	endpoints := reflect.ValueOf(new.WorldEndpoints)
+	for i := 0; i < endpoints.NumField(); i++ {
+		site, ok := endpoints.Field(i).Interface().(common.SiteConfig)
+		if !ok || site.URL == "" {
+			continue
+		}
+		if err := validateURL(endpoints.Type().Field(i).Tag.Get("json"), site.URL); err != nil {
+			return err
+		}
+	}
+
+	for _, credential := range []struct {
+		name                string
+		oldKey, key, secret string
+	}{
+		{name: "binance", oldKey: old.BinanceKey, key: new.BinanceKey, secret: new.BinanceSecret},
+		{name: "binance_2", oldKey: old.Binance2Key, key: new.Binance2Key, secret: new.Binance2Secret},
+		{name: "huobi", oldKey: old.HoubiKey, key: new.HoubiKey, secret: new.HoubiSecret},
+	} {
+		if (credential.key == "") != (credential.secret == "") {
+			return fmt.Errorf("%s key and secret must be set together", credential.name)
+		}
+		if credential.oldKey != "" && credential.key == "" {
+			return fmt.Errorf("%s key can not be removed by a reload", credential.name)
+		}
+	}
+	for ref, credential := range new.ExchangeCredentials {
+		if credential.Key == "" || credential.Secret == "" {
+			return fmt.Errorf("key and secret of exchange credentials %s are required", ref)
+		}
+	}
+	for ref := range old.ExchangeCredentials {
+		if _, ok := new.ExchangeCredentials[ref]; !ok {
+			return fmt.Errorf("exchange credentials %s can not be removed by a reload", ref)
+		}
+	}
+	return nil
+}
+
+// RegisterReloaders applies reloads of exchange API keys, world endpoints and node urls of p.
+// New nodes are connected with ctx.
+func (c *Config) RegisterReloaders(ctx context.Context, p *provider.Provider) {
+	if c.ExchangePool != nil {
+		p.OnReload(credentialKeys, func(_, rcf common.RawConfig) {
+			c.ExchangePool.SetCredentials(rcf)
+		})
+	}
+	p.OnReload([]string{"world_endpoints"}, func(_, rcf common.RawConfig) {
+		c.World.SetEndpoints(rcf.WorldEndpoints)
+	})
+	p.OnReload([]string{"nodes"}, func(_, rcf common.RawConfig) {
+		if err := reloadNodes(ctx, c.NodePool, rcf.Nodes); err != nil {
+			zap.S().Errorw("failed to reload nodes, current nodes are kept", "err", err)
+		}
+	})
+}
+
+// reloadNodes replaces nodes of the pool with the configured ones, connections of nodes whose url
+// is not changed are reused.
+func reloadNodes(ctx context.Context, pool *blockchain.NodePool, conf common.Nodes) error {
+	l := zap.S()
+	current := make(map[string]*blockchain.Node)
+	for _, n := range pool.Nodes() {
+		current[n.URL()] = n
+	}
+	connect := func(nodeURL string) (*blockchain.Node, error) {
+		if n, ok := current[nodeURL]; ok {
+			return n, nil
+		}
+		client, err := dialNode(ctx, nodeURL)
+		if err != nil {
+			return nil, err
+		}
+		return blockchain.NewNode(nodeURL, client), nil
+	}
+
+	main, err := connect(conf.Main)
+	if err != nil {
+		return err
+	}
+	nodes := []*blockchain.Node{main}
+	for _, ep := range conf.Backup {
+		n, err := connect(ep)
+		if err != nil {
+			l.Warnw("Cannot connect to rpc endpoint", "endpoint", ep, "err", err)
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	for _, n := range pool.SetNodes(nodes) {
+		n.Close()
+	}
+	pool.Check()
+	l.Infow("nodes are reloaded", "main", main.Name(), "nodes", len(nodes))
+	return nil
+}
@@ -1,22 +1,33 @@
 package main
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"context"
 	"log"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/zap"
 
+	"github.com/KyberNetwork/reserve-data/blockchain"
 	"github.com/KyberNetwork/reserve-data/cmd/configuration"
 	"github.com/KyberNetwork/reserve-data/cmd/deployment"
+	"github.com/KyberNetwork/reserve-data/cmd/migration"
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/profiler"
+	"github.com/KyberNetwork/reserve-data/core"
+	"github.com/KyberNetwork/reserve-data/data"
+	"github.com/KyberNetwork/reserve-data/data/indexer"
 	"github.com/KyberNetwork/reserve-data/http"
 	"github.com/KyberNetwork/reserve-data/lib/app"
 )
 
+// shutdownTimeout is how long API requests in progress are waited for on shutdown.
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "Reserve Core"
@@ -26,6 +37,7 @@ func main() {
 
 	app.Flags = configuration.NewCliFlags()
 	app.Flags = append(app.Flags, profiler.NewCliFlags()...)
+	app.Commands = []cli.Command{migration.NewCommand()}
 
 	if err := app.Run(os.Args); err != nil {
 		log.Fatal(err)
@@ -48,17 +60,16 @@ func run(c *cli.Context) error {
 	}()
 	zap.ReplaceGlobals(l.Desugar())
 
-	configFile, secretConfigFile := configuration.NewConfigFilesFromContext(c)
-
-	rcf := common.RawConfig{}
-	if err := loadConfigFromFile(configFile, &rcf); err != nil {
-		return err
-	}
-	if err := loadConfigFromFile(secretConfigFile, &rcf); err != nil {
+	configProvider, reloadInterval := configuration.NewConfigProviderFromContext(c)
+	rcf, err := configProvider.Load()
+	if err != nil {
 		return err
 	}
 
-	conf, err := configuration.NewConfigurationFromContext(c, rcf, l)
+	// ctx is cancelled on shutdown to stop the fetcher jobs and calls of exchanges and nodes
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	conf, err := configuration.NewConfigurationFromContext(ctx, c, rcf, l)
 	if err != nil {
 		return err
 	}
@@ -72,18 +83,6 @@ func run(c *cli.Context) error {
 	dryRun := configuration.NewDryRunFromContext(c)
 
 	rData, rCore := configuration.CreateDataCore(conf, dpl, bc, l)
-	if !dryRun {
-		if dpl != deployment.Simulation {
-			if err = rData.RunStorageController(); err != nil {
-				l.Errorw("failed to run storage controller", "err", err)
-				return err
-			}
-		}
-		if err = rData.Run(); err != nil {
-			l.Errorw("failed to run data service", "err", err)
-			return err
-		}
-	}
 
 	for _, ex := range conf.Exchanges {
 		common.SupportedExchanges[ex.ID()] = ex
@@ -100,9 +99,36 @@ func run(c *cli.Context) error {
 	if profiler.IsEnableProfilerFromContext(c) {
 		server.EnableProfiler()
 	}
+	server.SetElector(conf.Elector)
 
 	if !dryRun {
-		server.Run()
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		go server.Run()
+		conf.RegisterReloaders(ctx, configProvider)
+		if reloadInterval > 0 {
+			go configProvider.Watch(ctx, reloadInterval)
+		}
+		// the election outlives ctx, so the lock is released after the jobs of the leader stopped
+		electionCtx, cancelElection := context.WithCancel(context.Background())
+		election := make(chan error, 1)
+		var leaderJobs sync.WaitGroup
+		go func() {
+			election <- conf.Elector.Run(electionCtx, func() error {
+				return runLeaderJobs(ctx, &leaderJobs, conf, rcf, dpl, bc, rData, rCore, l)
+			})
+		}()
+		select {
+		case sig := <-signals:
+			l.Infow("shutting down", "signal", sig.String())
+			shutdown(server, rData, cancel, &leaderJobs, l)
+			cancelElection()
+			err = <-election
+		case err = <-election:
+			l.Errorw("leader election is stopped, shutting down", "err", err)
+			shutdown(server, rData, cancel, &leaderJobs, l)
+			cancelElection()
+		}
 	} else {
 		l.Infow("Dry run finished. All configs are corrected")
 	}
@@ -110,10 +136,52 @@ func run(c *cli.Context) error {
 	return err
 }
 
-func loadConfigFromFile(path string, rcf *common.RawConfig) error {
-	data, err := ioutil.ReadFile(path)
-	if err != nil {
+// runLeaderJobs starts the jobs which must run on the leader only: the fetcher, the storage
+// controller and the jobs calling exchanges or the chain. The jobs stop when ctx is done, jobs
+// is done when all of them returned.
+func runLeaderJobs(ctx context.Context, jobs *sync.WaitGroup, conf *configuration.Config, rcf common.RawConfig, dpl deployment.Deployment,
+	bc *blockchain.Blockchain, rData *data.ReserveData, rCore *core.ReserveCore, l *zap.SugaredLogger) error {
+	if dpl != deployment.Simulation {
+		if err := rData.RunStorageController(ctx); err != nil {
+			l.Errorw("failed to run storage controller", "err", err)
+			return err
+		}
+	}
+	if err := rData.Run(ctx); err != nil {
+		l.Errorw("failed to run data service", "err", err)
 		return err
 	}
-	return json.Unmarshal(data, rcf)
+	run := func(job func()) {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			job()
+		}()
+	}
+	run(func() { core.NewStaleOrderCanceller(rCore, rData, rcf.StaleOrderPolicy).Run(ctx, conf.Exchanges) })
+	run(func() { rCore.RunReconciliation(ctx, conf.Exchanges) })
+	// executions started through the API are waited for before RunExecutions returns
+	run(func() { rCore.RunExecutions(ctx, conf.Exchanges) })
+	run(func() { indexer.NewIndexer(bc, conf.OnChainTradeStorage, conf.OnChainIndexer).Run(ctx) })
+	run(func() { rData.RunInventorySnapshots(ctx, rcf.Inventory) })
+	return nil
+}
+
+// shutdown stops accepting API requests, cancels calls of exchanges and nodes in progress then
+// waits for the fetcher, the storage controller and the other leader jobs to finish, so an auth
+// data snapshot being stored is not lost and the leader lock is released after the jobs stopped.
+func shutdown(server *http.Server, rData *data.ReserveData, cancel context.CancelFunc, leaderJobs *sync.WaitGroup,
+	l *zap.SugaredLogger) {
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		l.Warnw("failed to shutdown HTTP server gracefully", "err", err)
+	}
+	cancel()
+	if err := rData.Stop(); err != nil {
+		l.Warnw("failed to stop data service", "err", err)
+	}
+	rData.WaitStorageController()
+	leaderJobs.Wait()
+	l.Infow("shutdown completed")
 }
@@ -0,0 +1,142 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/cmd/configuration"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/data/storage"
+	binancestorage "github.com/KyberNetwork/reserve-data/exchange/binance/storage"
+	huobistorage "github.com/KyberNetwork/reserve-data/exchange/huobi/storage"
+	"github.com/KyberNetwork/reserve-data/lib/app"
+)
+
+const (
+	coreBoltFlag    = "core-bolt"
+	binanceBoltFlag = "binance-bolt"
+	huobiBoltFlag   = "huobi-bolt"
+	batchSizeFlag   = "batch-size"
+	samplesFlag     = "verify-samples"
+	verifyOnlyFlag  = "verify-only"
+
+	defaultDB      = "reserve_data"
+	defaultSamples = 100
+)
+
+// NewCommand returns the command migrating data from bolt files to postgres.
+func NewCommand() cli.Command {
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  coreBoltFlag,
+			Usage: "path of core data bolt file (prices, rates, activities, auth data, gold/btc/usd feeds)",
+		},
+		cli.StringFlag{
+			Name:  binanceBoltFlag,
+			Usage: "path of binance bolt file (trade history)",
+		},
+		cli.StringFlag{
+			Name:  huobiBoltFlag,
+			Usage: "path of huobi bolt file (trade history, intermediate txs)",
+		},
+		cli.IntFlag{
+			Name:  batchSizeFlag,
+			Usage: "number of records committed to postgres at once",
+			Value: defaultBatchSize,
+		},
+		cli.IntFlag{
+			Name:  samplesFlag,
+			Usage: "number of records of each bucket compared with postgres after migration",
+			Value: defaultSamples,
+		},
+		cli.BoolFlag{
+			Name:  verifyOnlyFlag,
+			Usage: "only compare bolt files with postgres",
+		},
+	}
+	flags = append(flags, configuration.NewPostgreSQLFlags(defaultDB)...)
+	flags = append(flags, app.NewSentryFlags()...)
+	return cli.Command{
+		Name:  "migrate-bolt",
+		Usage: "migrate data from bolt files to postgres, an interrupted migration resumes where it stopped",
+		Description: "Postgres tables must not be written by a running core during migration, " +
+			"as verification compares number of records.",
+		Flags:  flags,
+		Action: run,
+	}
+}
+
+func run(c *cli.Context) error {
+	l, flusher, err := app.NewSugaredLogger(c)
+	if err != nil {
+		return err
+	}
+	defer flusher()
+	zap.ReplaceGlobals(l.Desugar())
+
+	var sources []Source
+	if path := c.String(coreBoltFlag); path != "" {
+		sources = append(sources, CoreSource(path))
+	}
+	if path := c.String(binanceBoltFlag); path != "" {
+		sources = append(sources, BinanceSource(path))
+	}
+	if path := c.String(huobiBoltFlag); path != "" {
+		sources = append(sources, HuobiSource(path))
+	}
+	if len(sources) == 0 {
+		return errors.New("no bolt file to migrate")
+	}
+
+	db, err := configuration.NewDBFromContext(c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := db.Close(); cErr != nil {
+			l.Errorw("failed to close database", "err", cErr)
+		}
+	}()
+	// create schemas of the target tables
+	if _, err = storage.NewPostgresStorage(db); err != nil {
+		return err
+	}
+	if _, err = binancestorage.NewPostgresStorage(db); err != nil {
+		return err
+	}
+	if _, err = huobistorage.NewPostgresStorage(db, common.Huobi); err != nil {
+		return err
+	}
+	m, err := NewMigrator(db, c.Int(batchSizeFlag))
+	if err != nil {
+		return err
+	}
+
+	if !c.Bool(verifyOnlyFlag) {
+		for _, source := range sources {
+			l.Infow("migrating bolt file", "source", source.Name, "path", source.Path)
+			if err := m.Migrate(source); err != nil {
+				return err
+			}
+		}
+	}
+	var mismatches []Mismatch
+	for _, source := range sources {
+		found, err := m.Verify(source, c.Int(samplesFlag))
+		if err != nil {
+			return err
+		}
+		mismatches = append(mismatches, found...)
+	}
+	for _, mismatch := range mismatches {
+		l.Errorw("verification failed", "mismatch", mismatch.String())
+	}
+	if len(mismatches) != 0 {
+		return fmt.Errorf("verification found %d mismatches", len(mismatches))
+	}
+	l.Infow("verified migration")
+	return nil
+}
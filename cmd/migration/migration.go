@@ -0,0 +1,234 @@
+package migration
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common/postgres"
+)
+
+const (
+	checkpointSchema = `
+CREATE TABLE IF NOT EXISTS "bolt_migration"
+(
+	source   TEXT   NOT NULL,
+	bucket   TEXT   NOT NULL,
+	last_key BYTEA  NOT NULL,
+	migrated BIGINT NOT NULL,
+	PRIMARY KEY (source, bucket)
+);
+`
+	defaultBatchSize = 1000
+	// boltOpenTimeout is the time to wait for the lock of bolt file, which is held by a running core.
+	boltOpenTimeout = 5 * time.Second
+)
+
+// record is a key value pair of a bolt bucket, key of a record in nested bucket is prefixed by
+// the key of its parent bucket.
+type record struct {
+	key   []byte
+	value []byte
+}
+
+// table migrates a bolt bucket to postgres.
+type table struct {
+	bucket string
+	// nested is true if the bucket contains a bucket per pair instead of records.
+	nested bool
+	// insert writes the record to postgres.
+	insert func(tx *sqlx.Tx, r record) error
+	// count returns the number of records of the bucket in postgres.
+	count func(db *sqlx.DB) (int64, error)
+	// match returns whether the record is in postgres with the same data.
+	match func(db *sqlx.DB, r record) (bool, error)
+}
+
+// Source is a bolt file with the tables to migrate from it.
+type Source struct {
+	Name   string
+	Path   string
+	tables []table
+}
+
+// Migrator streams records from bolt files to postgres in batches. Every batch is committed
+// with the key of its last record, so an interrupted migration resumes after the last
+// committed batch.
+type Migrator struct {
+	db        *sqlx.DB
+	batchSize int
+	l         *zap.SugaredLogger
+}
+
+// NewMigrator creates a Migrator writing to db, schemas of the target tables must be created
+// before running it.
+func NewMigrator(db *sqlx.DB, batchSize int) (*Migrator, error) {
+	if _, err := db.Exec(checkpointSchema); err != nil {
+		return nil, fmt.Errorf("failed to intialize database schema err=%s", err.Error())
+	}
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	return &Migrator{db: db, batchSize: batchSize, l: zap.S()}, nil
+}
+
+func openBolt(path string) (*bolt.DB, error) {
+	return bolt.Open(path, 0600, &bolt.Options{ReadOnly: true, Timeout: boltOpenTimeout})
+}
+
+// Migrate copies all tables of source which have not been migrated.
+func (m *Migrator) Migrate(source Source) error {
+	bdb, err := openBolt(source.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := bdb.Close(); cErr != nil {
+			m.l.Errorw("failed to close bolt file", "path", source.Path, "err", cErr)
+		}
+	}()
+	for _, t := range source.tables {
+		if err := m.migrateTable(bdb, source.Name, t); err != nil {
+			return fmt.Errorf("failed to migrate bucket %s of %s: %v", t.bucket, source.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) checkpoint(source, bucket string) ([]byte, int64, error) {
+	var cp struct {
+		LastKey  []byte `db:"last_key"`
+		Migrated int64  `db:"migrated"`
+	}
+	err := m.db.Get(&cp, `SELECT last_key, migrated FROM "bolt_migration" WHERE source = $1 AND bucket = $2`,
+		source, bucket)
+	if err == sql.ErrNoRows {
+		return nil, 0, nil
+	}
+	return cp.LastKey, cp.Migrated, err
+}
+
+func (m *Migrator) migrateTable(bdb *bolt.DB, source string, t table) error {
+	after, migrated, err := m.checkpoint(source, t.bucket)
+	if err != nil {
+		return err
+	}
+	if after != nil {
+		m.l.Infow("resuming bucket migration", "source", source, "bucket", t.bucket, "migrated", migrated)
+	}
+	for {
+		records, err := readBatch(bdb, t.bucket, t.nested, after, m.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			m.l.Infow("migrated bucket", "source", source, "bucket", t.bucket, "migrated", migrated)
+			return nil
+		}
+		after = records[len(records)-1].key
+		migrated += int64(len(records))
+		if err := m.writeBatch(source, t, records, after, migrated); err != nil {
+			return err
+		}
+		m.l.Debugw("migrated batch", "source", source, "bucket", t.bucket, "migrated", migrated)
+	}
+}
+
+// writeBatch inserts the records and moves the checkpoint in one transaction.
+func (m *Migrator) writeBatch(source string, t table, records []record, lastKey []byte, migrated int64) error {
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+	for _, r := range records {
+		if err := t.insert(tx, r); err != nil {
+			return fmt.Errorf("failed to insert record %x: %v", r.key, err)
+		}
+	}
+	if _, err := tx.Exec(`INSERT INTO "bolt_migration" (source, bucket, last_key, migrated) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (source, bucket) DO UPDATE SET last_key = EXCLUDED.last_key, migrated = EXCLUDED.migrated`,
+		source, t.bucket, lastKey, migrated); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// readBatch reads at most n records of bucket after the given key, a nil key reads from the
+// first record. Records are copied as bolt data is only valid in its transaction.
+func readBatch(bdb *bolt.DB, bucket string, nested bool, after []byte, n int) ([]record, error) {
+	var records []record
+	collect := func(c *bolt.Cursor, prefix, after []byte) {
+		k, v := c.First()
+		if after != nil {
+			k, v = c.Seek(after)
+			if bytes.Equal(k, after) {
+				k, v = c.Next()
+			}
+		}
+		for ; k != nil && len(records) < n; k, v = c.Next() {
+			if v == nil {
+				// nested buckets are only read through their parent
+				continue
+			}
+			key := append(append([]byte{}, prefix...), k...)
+			records = append(records, record{key: key, value: append([]byte{}, v...)})
+		}
+	}
+	err := bdb.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		if !nested {
+			collect(b.Cursor(), nil, after)
+			return nil
+		}
+		c := b.Cursor()
+		var innerAfter []byte
+		k, v := c.First()
+		if after != nil {
+			if len(after) < pairKeyLen {
+				return fmt.Errorf("invalid key %x of nested bucket", after)
+			}
+			k, v = c.Seek(after[:pairKeyLen])
+			if bytes.Equal(k, after[:pairKeyLen]) {
+				innerAfter = after[pairKeyLen:]
+			}
+		}
+		for ; k != nil && len(records) < n; k, v = c.Next() {
+			if v != nil || len(k) != pairKeyLen {
+				continue
+			}
+			collect(b.Bucket(k).Cursor(), k, innerAfter)
+			innerAfter = nil
+		}
+		return nil
+	})
+	return records, err
+}
+
+// forEach calls fn with every record of bucket.
+func forEach(bdb *bolt.DB, bucket string, nested bool, fn func(r record) error) error {
+	var after []byte
+	for {
+		records, err := readBatch(bdb, bucket, nested, after, defaultBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		for _, r := range records {
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+		after = records[len(records)-1].key
+	}
+}
@@ -0,0 +1,201 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/boltutil"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/data/storage"
+	huobistorage "github.com/KyberNetwork/reserve-data/exchange/huobi/storage"
+)
+
+// newBoltFile creates a bolt file with records written by fn in the layout of the bolt storages.
+func newBoltFile(t *testing.T, dir, name string, fn func(tx *bolt.Tx) error) string {
+	path := filepath.Join(dir, name)
+	db, err := bolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(fn))
+	require.NoError(t, db.Close())
+	return path
+}
+
+func putJSON(t *testing.T, b *bolt.Bucket, key []byte, v interface{}) {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, b.Put(key, data))
+}
+
+func putTradeHistory(t *testing.T, tx *bolt.Tx, histories map[uint64][]common.TradeHistory) {
+	b, err := tx.CreateBucketIfNotExists([]byte("trade_history"))
+	require.NoError(t, err)
+	for pairID, pairHistories := range histories {
+		pb, err := b.CreateBucketIfNotExists(boltutil.Uint64ToBytes(pairID))
+		require.NoError(t, err)
+		for _, history := range pairHistories {
+			putJSON(t, pb, []byte(fmt.Sprintf("%d%s", history.Timestamp, history.ID)), history)
+		}
+	}
+}
+
+func TestReadBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migration")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := newBoltFile(t, dir, "binance.db", func(tx *bolt.Tx) error {
+		putTradeHistory(t, tx, map[uint64][]common.TradeHistory{
+			1: {{ID: "a", Timestamp: 1}, {ID: "b", Timestamp: 2}, {ID: "c", Timestamp: 3}},
+			2: {{ID: "d", Timestamp: 1}},
+			3: {{ID: "e", Timestamp: 1}, {ID: "f", Timestamp: 2}},
+		})
+		return nil
+	})
+	bdb, err := openBolt(path)
+	require.NoError(t, err)
+	defer bdb.Close()
+
+	var (
+		ids   []string
+		after []byte
+	)
+	for {
+		records, err := readBatch(bdb, "trade_history", true, after, 2)
+		require.NoError(t, err)
+		if len(records) == 0 {
+			break
+		}
+		assert.True(t, len(records) <= 2)
+		for _, r := range records {
+			var history common.TradeHistory
+			require.NoError(t, json.Unmarshal(r.value, &history))
+			ids = append(ids, history.ID)
+		}
+		after = records[len(records)-1].key
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, ids)
+
+	records, err := readBatch(bdb, "missing", false, nil, 2)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migration")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	deposit := common.NewActivityRecord(common.ActionDeposit, common.NewActivityID(1568358532000, "deposit"),
+		"huobi", common.ActivityParams{Asset: 1, Amount: 10}, common.ActivityResult{Tx: "0x1"},
+		"", common.MiningStatusSubmitted, "1568358532000")
+	withdraw := common.NewActivityRecord(common.ActionWithdraw, common.NewActivityID(1568358533000, "withdraw"),
+		"binance", common.ActivityParams{Asset: 1, Amount: 5}, common.ActivityResult{ID: "w"},
+		common.ExchangeStatusDone, common.MiningStatusMined, "1568358533000")
+	corePath := newBoltFile(t, dir, "core.db", func(tx *bolt.Tx) error {
+		buckets := map[string]map[uint64]interface{}{
+			"prices":     {1568358532000: common.AllPriceEntry{Block: 1}, 1568358533000: common.AllPriceEntry{Block: 2}},
+			"rates":      {1568358532000: common.AllRateEntry{BlockNumber: 1, Timestamp: "1568358532000"}},
+			"auth_data":  {1568358532000: common.AuthDataSnapshot{Block: 1}},
+			"gold_feeds": {1568358532000: common.GoldData{Timestamp: 1568358532000}},
+			"btc_feeds":  {1568358532000: common.BTCData{Timestamp: 1568358532000}},
+		}
+		for name, records := range buckets {
+			b, err := tx.CreateBucket([]byte(name))
+			require.NoError(t, err)
+			for timepoint, data := range records {
+				putJSON(t, b, boltutil.Uint64ToBytes(timepoint), data)
+			}
+		}
+		activities, err := tx.CreateBucket([]byte("activities"))
+		require.NoError(t, err)
+		pending, err := tx.CreateBucket([]byte("pending_activities"))
+		require.NoError(t, err)
+		for _, activity := range []common.ActivityRecord{deposit, withdraw} {
+			id := activity.ID.ToBytes()
+			putJSON(t, activities, id[:], activity)
+		}
+		id := deposit.ID.ToBytes()
+		putJSON(t, pending, id[:], deposit)
+		transitions, err := tx.CreateBucket([]byte("activity_transitions"))
+		require.NoError(t, err)
+		putJSON(t, transitions, append(id[:], boltutil.Uint64ToBytes(1)...), common.NewActivityCreation(deposit, common.ActivitySourceCore, 1568358532000))
+		snapshots, err := tx.CreateBucket([]byte("inventory_snapshots"))
+		require.NoError(t, err)
+		putJSON(t, snapshots, boltutil.Uint64ToBytes(1568358532000), common.InventoryReport{Timestamp: 1568358532000})
+		return nil
+	})
+	huobiPath := newBoltFile(t, dir, "huobi.db", func(tx *bolt.Tx) error {
+		putTradeHistory(t, tx, map[uint64][]common.TradeHistory{
+			1: {{ID: "a", Price: 0.1, Qty: 2, Type: "buy", Timestamp: 1}},
+		})
+		b, err := tx.CreateBucket([]byte("intermediate_tx"))
+		require.NoError(t, err)
+		id := deposit.ID.ToBytes()
+		putJSON(t, b, id[:], common.TXEntry{Hash: "0x2", Exchange: "huobi", AssetID: 1, Amount: 10})
+		b, err = tx.CreateBucket([]byte("pending_intermediate_tx"))
+		require.NoError(t, err)
+		key, err := json.Marshal(common.NewActivityID(1568358534000, "pending"))
+		require.NoError(t, err)
+		putJSON(t, b, key, common.TXEntry{Hash: "0x3", Exchange: "huobi", AssetID: 1, Amount: 1})
+		return nil
+	})
+
+	db, teardown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+	ps, err := storage.NewPostgresStorage(db)
+	require.NoError(t, err)
+	hs, err := huobistorage.NewPostgresStorage(db, common.Huobi)
+	require.NoError(t, err)
+	m, err := NewMigrator(db, 1)
+	require.NoError(t, err)
+
+	sources := []Source{CoreSource(corePath), HuobiSource(huobiPath)}
+	for _, source := range sources {
+		require.NoError(t, m.Migrate(source))
+	}
+	// migrating again resumes after the last record without duplicating records
+	for _, source := range sources {
+		require.NoError(t, m.Migrate(source))
+		mismatches, err := m.Verify(source, 10)
+		require.NoError(t, err)
+		assert.Empty(t, mismatches)
+	}
+
+	pendings, err := ps.GetPendingActivities()
+	require.NoError(t, err)
+	require.Len(t, pendings, 1)
+	assert.Equal(t, deposit.ID, pendings[0].ID)
+	v, err := ps.CurrentPriceVersion(1568358533000)
+	require.NoError(t, err)
+	prices, err := ps.GetAllPrices(v)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), prices.Block)
+	timeline, err := ps.GetActivityTimeline(deposit.ID)
+	require.NoError(t, err)
+	require.Len(t, timeline, 1)
+	assert.Equal(t, common.MiningStatusSubmitted, timeline[0].MiningStatus)
+	snapshots, err := ps.GetInventorySnapshots(1568358532000, 1568358532000)
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	tx, err := hs.GetIntermedatorTx(deposit.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0x2", tx.Hash)
+
+	// records written to postgres after migration are reported
+	require.NoError(t, ps.StorePrice(common.AllPriceEntry{Block: 3}, 1568358535000))
+	mismatches, err := m.Verify(CoreSource(corePath), 10)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 1)
+	assert.Equal(t, "prices", mismatches[0].Bucket)
+}
@@ -0,0 +1,391 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/boltutil"
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// pairKeyLen is the length of keys of pair buckets in trade history bucket.
+const pairKeyLen = 8
+
+// CoreSource returns the core data bolt file of data/storage.BoltStorage at path. Pending
+// activities are migrated after activities as they are a flag of activity in postgres, activity
+// transitions are looked up by their activities.
+func CoreSource(path string) Source {
+	return Source{
+		Name: "core",
+		Path: path,
+		tables: []table{
+			fetchDataTable("prices", "price"),
+			fetchDataTable("rates", "rate"),
+			fetchDataTable("auth_data", "auth_data"),
+			fetchDataTable("gold_feeds", "gold"),
+			fetchDataTable("btc_feeds", "btc"),
+			fetchDataTable("usd_feeds", "usd"),
+			activityTable(),
+			pendingActivityTable(),
+			activityTransitionTable(),
+			inventorySnapshotTable(),
+		},
+	}
+}
+
+// BinanceSource returns the bolt file of Binance storage at path.
+func BinanceSource(path string) Source {
+	return Source{
+		Name:   "binance",
+		Path:   path,
+		tables: []table{tradeHistoryTable("binance_trade_history")},
+	}
+}
+
+// HuobiSource returns the bolt file of Huobi storage at path. Intermediate txs are looked up by
+// their deposit activities, core data should be migrated first.
+func HuobiSource(path string) Source {
+	return Source{
+		Name: "huobi",
+		Path: path,
+		tables: []table{
+			tradeHistoryTable("huobi_trade_history"),
+			intermediateTxTable(),
+			pendingIntermediateTxTable(),
+		},
+	}
+}
+
+// sameJSON returns whether a and b are the same JSON value, numbers are compared as written.
+func sameJSON(a, b []byte) bool {
+	decode := func(data []byte) (interface{}, error) {
+		var v interface{}
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		err := d.Decode(&v)
+		return v, err
+	}
+	va, err := decode(a)
+	if err != nil {
+		return false
+	}
+	vb, err := decode(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func anySameJSON(rows [][]byte, data []byte) bool {
+	for _, row := range rows {
+		if sameJSON(row, data) {
+			return true
+		}
+	}
+	return false
+}
+
+func countQuery(query string, args ...interface{}) func(db *sqlx.DB) (int64, error) {
+	return func(db *sqlx.DB) (int64, error) {
+		var count int64
+		err := db.Get(&count, query, args...)
+		return count, err
+	}
+}
+
+// fetchDataTable migrates a bucket of fetched data keyed by timepoint to fetch_data table.
+func fetchDataTable(bucket, dataType string) table {
+	return table{
+		bucket: bucket,
+		insert: func(tx *sqlx.Tx, r record) error {
+			if !json.Valid(r.value) {
+				return fmt.Errorf("invalid json %s", r.value)
+			}
+			_, err := tx.Exec(`INSERT INTO "fetch_data" (created, data, type) VALUES ($1, $2, $3)`,
+				common.MillisToTime(boltutil.BytesToUint64(r.key)), r.value, dataType)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "fetch_data" WHERE type = $1`, dataType),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			var rows [][]byte
+			err := db.Select(&rows, `SELECT data FROM "fetch_data" WHERE type = $1 AND created = $2`,
+				dataType, common.MillisToTime(boltutil.BytesToUint64(r.key)))
+			return anySameJSON(rows, r.value), err
+		},
+	}
+}
+
+func decodeActivity(r record) (common.ActivityRecord, error) {
+	var activity common.ActivityRecord
+	err := json.Unmarshal(r.value, &activity)
+	return activity, err
+}
+
+// activityCreated returns the time an activity is recorded, timepoint of its id is used if
+// timestamp is missing.
+func activityCreated(activity common.ActivityRecord) time.Time {
+	millis, err := strconv.ParseUint(string(activity.Timestamp), 10, 64)
+	if err != nil {
+		millis = activity.ID.Timepoint
+	}
+	return common.MillisToTime(millis)
+}
+
+func matchActivity(db *sqlx.DB, r record, pending bool) (bool, error) {
+	activity, err := decodeActivity(r)
+	if err != nil {
+		return false, err
+	}
+	var rows []struct {
+		Data      []byte `db:"data"`
+		IsPending bool   `db:"is_pending"`
+	}
+	if err := db.Select(&rows, `SELECT data, is_pending FROM "activity" WHERE timepoint = $1 AND eid = $2`,
+		activity.ID.Timepoint, activity.ID.EID); err != nil {
+		return false, err
+	}
+	for _, row := range rows {
+		if (!pending || row.IsPending) && sameJSON(row.Data, r.value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// activityTable migrates activities, they are inserted as not pending.
+func activityTable() table {
+	return table{
+		bucket: "activities",
+		insert: func(tx *sqlx.Tx, r record) error {
+			activity, err := decodeActivity(r)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO "activity" (created, data, is_pending, timepoint, eid) VALUES ($1, $2, $3, $4, $5)`,
+				activityCreated(activity), r.value, false, activity.ID.Timepoint, activity.ID.EID)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "activity"`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			return matchActivity(db, r, false)
+		},
+	}
+}
+
+// pendingActivityTable marks migrated activities as pending with their data in pending bucket.
+func pendingActivityTable() table {
+	return table{
+		bucket: "pending_activities",
+		insert: func(tx *sqlx.Tx, r record) error {
+			activity, err := decodeActivity(r)
+			if err != nil {
+				return err
+			}
+			res, err := tx.Exec(`UPDATE "activity" SET is_pending = TRUE, data = $1 WHERE timepoint = $2 AND eid = $3`,
+				r.value, activity.ID.Timepoint, activity.ID.EID)
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil || n > 0 {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO "activity" (created, data, is_pending, timepoint, eid) VALUES ($1, $2, $3, $4, $5)`,
+				activityCreated(activity), r.value, true, activity.ID.Timepoint, activity.ID.EID)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "activity" WHERE is_pending IS TRUE`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			return matchActivity(db, r, true)
+		},
+	}
+}
+
+// tradeHistoryTable migrates trade history bucket which has a bucket per pair.
+func tradeHistoryTable(name string) table {
+	return table{
+		bucket: "trade_history",
+		nested: true,
+		insert: func(tx *sqlx.Tx, r record) error {
+			var history common.TradeHistory
+			if err := json.Unmarshal(r.value, &history); err != nil {
+				return err
+			}
+			_, err := tx.Exec(fmt.Sprintf(`INSERT INTO "%s" (pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`, name),
+				boltutil.BytesToUint64(r.key[:pairKeyLen]), history.ID, history.Price, history.Qty, history.Type, history.Timestamp,
+				history.OrderID, history.Fee, history.FeeAsset, history.Maker)
+			return err
+		},
+		count: countQuery(fmt.Sprintf(`SELECT COUNT(*) FROM "%s"`, name)),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			var history common.TradeHistory
+			if err := json.Unmarshal(r.value, &history); err != nil {
+				return false, err
+			}
+			var rows []common.TradeHistory
+			if err := db.Select(&rows, fmt.Sprintf(`SELECT trade_id AS "id", price, qty, type, time AS "timestamp",
+				order_id AS "orderid", fee, fee_asset AS "feeasset", maker
+				FROM "%s" WHERE pair_id = $1 AND trade_id = $2`, name),
+				boltutil.BytesToUint64(r.key[:pairKeyLen]), history.ID); err != nil {
+				return false, err
+			}
+			for _, row := range rows {
+				if row == history {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	}
+}
+
+// activityIDOf returns id of the activity with timepoint and action, any action if it is empty.
+// Bolt keys of activity id only keep its timepoint, the id is looked up from migrated activities.
+func activityIDOf(q sqlx.Queryer, timepoint uint64, action string) (common.ActivityID, error) {
+	id := common.ActivityID{Timepoint: timepoint}
+	var eids []string
+	if err := sqlx.Select(q, &eids, `SELECT eid FROM "activity" WHERE timepoint = $1 AND ($2 = '' OR data->>'Action' = $2)`,
+		id.Timepoint, action); err != nil {
+		return id, err
+	}
+	if len(eids) != 1 {
+		zap.S().Warnw("could not find the activity of record, it is migrated without eid",
+			"timepoint", id.Timepoint, "action", action, "activities", len(eids))
+		return id, nil
+	}
+	id.EID = eids[0]
+	return id, nil
+}
+
+// depositID returns id of the deposit activity of an intermediate tx. Key of intermediate tx
+// bucket only keeps timepoint of activity id.
+func depositID(q sqlx.Queryer, key []byte) (common.ActivityID, error) {
+	if len(key) < 8 {
+		return common.ActivityID{}, fmt.Errorf("invalid key %x of intermediate tx", key)
+	}
+	return activityIDOf(q, boltutil.BytesToUint64(key[:8]), common.ActionDeposit)
+}
+
+func matchTx(db *sqlx.DB, tableName string, id common.ActivityID, data []byte) (bool, error) {
+	var rows [][]byte
+	err := db.Select(&rows, fmt.Sprintf(`SELECT data FROM "%s" WHERE timepoint = $1 AND eid = $2`, tableName),
+		id.Timepoint, id.EID)
+	return anySameJSON(rows, data), err
+}
+
+// intermediateTxTable migrates intermediate txs keyed by bytes of their deposit activity id.
+func intermediateTxTable() table {
+	return table{
+		bucket: "intermediate_tx",
+		insert: func(tx *sqlx.Tx, r record) error {
+			id, err := depositID(tx, r.key)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO "huobi_intermediate_tx" (timepoint, eid, data) VALUES ($1, $2, $3)`,
+				id.Timepoint, id.EID, r.value)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "huobi_intermediate_tx"`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			id, err := depositID(db, r.key)
+			if err != nil {
+				return false, err
+			}
+			return matchTx(db, "huobi_intermediate_tx", id, r.value)
+		},
+	}
+}
+
+// pendingIntermediateTxTable migrates pending intermediate txs keyed by JSON of activity id.
+func pendingIntermediateTxTable() table {
+	decodeID := func(key []byte) (common.ActivityID, error) {
+		var id common.ActivityID
+		err := json.Unmarshal(key, &id)
+		return id, err
+	}
+	return table{
+		bucket: "pending_intermediate_tx",
+		insert: func(tx *sqlx.Tx, r record) error {
+			id, err := decodeID(r.key)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO "huobi_pending_intermediate_tx" (timepoint, eid, data) VALUES ($1, $2, $3)`,
+				id.Timepoint, id.EID, r.value)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "huobi_pending_intermediate_tx"`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			id, err := decodeID(r.key)
+			if err != nil {
+				return false, err
+			}
+			return matchTx(db, "huobi_pending_intermediate_tx", id, r.value)
+		},
+	}
+}
+
+// activityTransitionTable migrates status transitions keyed by bytes of their activity id followed
+// by a sequence number.
+func activityTransitionTable() table {
+	transitionID := func(q sqlx.Queryer, key []byte) (common.ActivityID, error) {
+		if len(key) < 8 {
+			return common.ActivityID{}, fmt.Errorf("invalid key %x of activity transition", key)
+		}
+		return activityIDOf(q, boltutil.BytesToUint64(key[:8]), "")
+	}
+	return table{
+		bucket: "activity_transitions",
+		insert: func(tx *sqlx.Tx, r record) error {
+			var transition common.ActivityTransition
+			if err := json.Unmarshal(r.value, &transition); err != nil {
+				return err
+			}
+			id, err := transitionID(tx, r.key)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT INTO "activity_transition" (timepoint, eid, created, data) VALUES ($1, $2, $3, $4)`,
+				id.Timepoint, id.EID, common.MillisToTime(transition.Timestamp), r.value)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "activity_transition"`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			id, err := transitionID(db, r.key)
+			if err != nil {
+				return false, err
+			}
+			return matchTx(db, "activity_transition", id, r.value)
+		},
+	}
+}
+
+// inventorySnapshotTable migrates inventory reports keyed by their timestamp.
+func inventorySnapshotTable() table {
+	return table{
+		bucket: "inventory_snapshots",
+		insert: func(tx *sqlx.Tx, r record) error {
+			if !json.Valid(r.value) {
+				return fmt.Errorf("invalid json %s", r.value)
+			}
+			_, err := tx.Exec(`INSERT INTO "inventory_snapshot" (timestamp, data) VALUES ($1, $2)`,
+				boltutil.BytesToUint64(r.key), r.value)
+			return err
+		},
+		count: countQuery(`SELECT COUNT(*) FROM "inventory_snapshot"`),
+		match: func(db *sqlx.DB, r record) (bool, error) {
+			var rows [][]byte
+			err := db.Select(&rows, `SELECT data FROM "inventory_snapshot" WHERE timestamp = $1`,
+				boltutil.BytesToUint64(r.key))
+			return anySameJSON(rows, r.value), err
+		},
+	}
+}
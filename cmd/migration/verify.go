@@ -0,0 +1,83 @@
+package migration
+
+import (
+	"fmt"
+)
+
+// Mismatch is a difference between a bolt bucket and postgres found by verification.
+type Mismatch struct {
+	Source string
+	Bucket string
+	Reason string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s/%s: %s", m.Source, m.Bucket, m.Reason)
+}
+
+// Verify compares number of records of every table of source with postgres, and checks that at
+// most samples records spread over each bucket are in postgres with the same data.
+func (m *Migrator) Verify(source Source, samples int) ([]Mismatch, error) {
+	bdb, err := openBolt(source.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cErr := bdb.Close(); cErr != nil {
+			m.l.Errorw("failed to close bolt file", "path", source.Path, "err", cErr)
+		}
+	}()
+
+	var mismatches []Mismatch
+	for _, t := range source.tables {
+		mismatch := func(format string, args ...interface{}) {
+			mismatches = append(mismatches, Mismatch{
+				Source: source.Name,
+				Bucket: t.bucket,
+				Reason: fmt.Sprintf(format, args...),
+			})
+		}
+		var boltCount int64
+		if err := forEach(bdb, t.bucket, t.nested, func(record) error {
+			boltCount++
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		pgCount, err := t.count(m.db)
+		if err != nil {
+			return nil, err
+		}
+		if pgCount != boltCount {
+			mismatch("bolt has %d records, postgres has %d", boltCount, pgCount)
+		}
+		if samples <= 0 || boltCount == 0 {
+			continue
+		}
+		step := boltCount / int64(samples)
+		if step == 0 {
+			step = 1
+		}
+		var i, checked int64
+		if err := forEach(bdb, t.bucket, t.nested, func(r record) error {
+			i++
+			if (i-1)%step != 0 {
+				return nil
+			}
+			checked++
+			ok, err := t.match(m.db, r)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				mismatch("record %x is missing or different in postgres", r.key)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		m.l.Infow("verified bucket", "source", source.Name, "bucket", t.bucket,
+			"bolt", boltCount, "postgres", pgCount, "samples", checked)
+	}
+	return mismatches, nil
+}
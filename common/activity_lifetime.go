@@ -0,0 +1,47 @@
+package common
+
+import (
+	"time"
+)
+
+// DefaultActivityLifetime is the lifetime of activities which action and exchange are not
+// configured.
+const DefaultActivityLifetime = 6 * time.Hour
+
+// ActivityLifetime is how long an activity can be pending before fetcher considers it failed.
+type ActivityLifetime struct {
+	// Default is the lifetime of activities which action and exchange are not configured,
+	// DefaultActivityLifetime is used if it is zero.
+	Default HumanDuration `json:"default"`
+	// Actions are the lifetimes by action, e.g withdraw.
+	Actions map[string]HumanDuration `json:"actions"`
+	// Exchanges are the lifetimes by exchange name, e.g binance.
+	Exchanges map[string]HumanDuration `json:"exchanges"`
+}
+
+// Of returns the lifetime of an activity of action on exchange, the longer one is used if both
+// action and exchange are configured so an activity is not failed too early.
+func (l ActivityLifetime) Of(action, exchange string) time.Duration {
+	actionLifetime, actionOK := l.Actions[action]
+	exchangeLifetime, exchangeOK := l.Exchanges[exchange]
+	switch {
+	case actionOK && exchangeOK:
+		if exchangeLifetime > actionLifetime {
+			return time.Duration(exchangeLifetime)
+		}
+		return time.Duration(actionLifetime)
+	case actionOK:
+		return time.Duration(actionLifetime)
+	case exchangeOK:
+		return time.Duration(exchangeLifetime)
+	case l.Default != 0:
+		return time.Duration(l.Default)
+	}
+	return DefaultActivityLifetime
+}
+
+// Expired returns true if an activity of action on exchange created at timepoint (millisecond)
+// is pending longer than its lifetime at now.
+func (l ActivityLifetime) Expired(action, exchange string, timepoint, now uint64) bool {
+	return now > timepoint && now-timepoint > uint64(l.Of(action, exchange)/time.Millisecond)
+}
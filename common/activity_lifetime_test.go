@@ -0,0 +1,28 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityLifetime(t *testing.T) {
+	var lifetime ActivityLifetime
+	assert.Equal(t, DefaultActivityLifetime, lifetime.Of(ActionWithdraw, "binance"))
+
+	lifetime = ActivityLifetime{
+		Default:   HumanDuration(time.Hour),
+		Actions:   map[string]HumanDuration{ActionDeposit: HumanDuration(12 * time.Hour)},
+		Exchanges: map[string]HumanDuration{"huobi": HumanDuration(8 * time.Hour)},
+	}
+	assert.Equal(t, time.Hour, lifetime.Of(ActionWithdraw, "binance"))
+	assert.Equal(t, 12*time.Hour, lifetime.Of(ActionDeposit, "binance"))
+	assert.Equal(t, 8*time.Hour, lifetime.Of(ActionWithdraw, "huobi"))
+	assert.Equal(t, 12*time.Hour, lifetime.Of(ActionDeposit, "huobi"))
+
+	hour := uint64(time.Hour / time.Millisecond)
+	assert.False(t, lifetime.Expired(ActionWithdraw, "binance", 0, hour))
+	assert.True(t, lifetime.Expired(ActionWithdraw, "binance", 0, hour+1))
+	assert.False(t, lifetime.Expired(ActionWithdraw, "binance", hour+1, 0), "future timepoint is not expired")
+}
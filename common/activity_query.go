@@ -0,0 +1,170 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// States of activities used to search them.
+const (
+	ActivityStatePending   = "pending"
+	ActivityStateDone      = "done"
+	ActivityStateFailed    = "failed"
+	ActivityStateCancelled = "cancelled"
+)
+
+// Sort orders of activity search.
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+const (
+	// DefaultActivityQueryLimit is the page size of activity search if it is not given.
+	DefaultActivityQueryLimit = 100
+	// MaxActivityQueryLimit is the largest page size of activity search.
+	MaxActivityQueryLimit = 1000
+	// MaxActivityExportRange is the longest range of creation time in millisecond of activity export.
+	MaxActivityExportRange = 31 * 24 * 60 * 60 * 1000
+)
+
+// State returns whether the activity is pending, done, failed or cancelled.
+func (ar ActivityRecord) State() string {
+	switch {
+	case ar.IsPending():
+		return ActivityStatePending
+	case ar.ExchangeStatus == ExchangeStatusFailed || ar.MiningStatus == MiningStatusFailed:
+		return ActivityStateFailed
+	case ar.ExchangeStatus == ExchangeStatusCancelled:
+		return ActivityStateCancelled
+	}
+	return ActivityStateDone
+}
+
+// ActivityQuery is the filters and page of activity search, empty filters match all activities.
+type ActivityQuery struct {
+	Actions []string `form:"action" json:"action,omitempty"`
+	// Exchange is the name of exchange the activity is made on.
+	Exchange string `form:"exchange" json:"exchange,omitempty"`
+	Asset    uint64 `form:"asset" json:"asset,omitempty"`
+	// AssetSymbols are the symbols of Asset by exchange name, trades of the asset are matched by
+	// their base or quote symbol. They are resolved from settings, not bound from requests.
+	AssetSymbols map[string]string `form:"-" json:"-"`
+	// State is one of pending, done, failed or cancelled.
+	State string `form:"state" json:"state,omitempty"`
+	Tx    string `form:"tx" json:"tx,omitempty"`
+	// OrderID is the id of order or withdrawal on exchange.
+	OrderID string `form:"order_id" json:"order_id,omitempty"`
+	// FromTime and ToTime are the range of creation time in millisecond, zero is unbounded.
+	FromTime uint64 `form:"fromTime" json:"fromTime,omitempty"`
+	ToTime   uint64 `form:"toTime" json:"toTime,omitempty"`
+
+	// Cursor is the id of the last activity of previous page.
+	Cursor string `form:"cursor" json:"cursor,omitempty"`
+	Limit  int    `form:"limit" json:"limit,omitempty"`
+	// Order sorts activities by id, the newest activities come first by default.
+	Order string `form:"order" json:"order,omitempty"`
+}
+
+// ActivityPage is a page of activity search.
+type ActivityPage struct {
+	Activities []ActivityRecord `json:"activities"`
+	// NextCursor is the cursor of the next page, it is empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Validate checks the query and sets default page size and order.
+func (q *ActivityQuery) Validate() error {
+	switch q.State {
+	case "", ActivityStatePending, ActivityStateDone, ActivityStateFailed, ActivityStateCancelled:
+	default:
+		return fmt.Errorf("invalid state %q", q.State)
+	}
+	switch q.Order {
+	case "":
+		q.Order = SortDesc
+	case SortAsc, SortDesc:
+	default:
+		return fmt.Errorf("invalid order %q", q.Order)
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultActivityQueryLimit
+	}
+	if q.Limit > MaxActivityQueryLimit {
+		return fmt.Errorf("limit must not be greater than %d", MaxActivityQueryLimit)
+	}
+	if q.ToTime != 0 && q.FromTime > q.ToTime {
+		return fmt.Errorf("fromTime must not be after toTime")
+	}
+	if _, err := q.CursorID(); err != nil {
+		return fmt.Errorf("invalid cursor %q: %v", q.Cursor, err)
+	}
+	return nil
+}
+
+// CursorID returns the activity id of cursor, nil is returned for the first page.
+func (q ActivityQuery) CursorID() (*ActivityID, error) {
+	if q.Cursor == "" {
+		return nil, nil
+	}
+	id, err := StringToActivityID(q.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// Match returns true if the activity matches all filters of the query, page is not checked.
+func (q ActivityQuery) Match(ar ActivityRecord) bool {
+	if len(q.Actions) != 0 && !containsString(q.Actions, ar.Action) {
+		return false
+	}
+	if q.Exchange != "" && ar.Destination != q.Exchange {
+		return false
+	}
+	if q.Asset != 0 && !q.matchAsset(ar) {
+		return false
+	}
+	if q.State != "" && ar.State() != q.State {
+		return false
+	}
+	if q.Tx != "" && ar.Result.Tx != q.Tx {
+		return false
+	}
+	if q.OrderID != "" && ar.Result.ID != q.OrderID {
+		return false
+	}
+	if q.FromTime != 0 || q.ToTime != 0 {
+		created, err := strconv.ParseUint(string(ar.Timestamp), 10, 64)
+		if err != nil || created < q.FromTime || (q.ToTime != 0 && created > q.ToTime) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q ActivityQuery) matchAsset(ar ActivityRecord) bool {
+	if ar.Params.Asset == q.Asset || containsUint64(ar.Params.Assets, q.Asset) {
+		return true
+	}
+	symbol, ok := q.AssetSymbols[ar.Destination]
+	return ok && (ar.Params.Base == symbol || ar.Params.Quote == symbol)
+}
+
+func containsString(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUint64(values []uint64, v uint64) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
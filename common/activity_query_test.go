@@ -0,0 +1,74 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityQuery(t *testing.T) {
+	query := ActivityQuery{}
+	require.NoError(t, query.Validate())
+	assert.Equal(t, SortDesc, query.Order)
+	assert.Equal(t, DefaultActivityQueryLimit, query.Limit)
+
+	for _, invalid := range []ActivityQuery{
+		{State: "lost"},
+		{Order: "random"},
+		{Limit: MaxActivityQueryLimit + 1},
+		{FromTime: 2, ToTime: 1},
+		{Cursor: "not an id"},
+	} {
+		assert.Error(t, invalid.Validate(), "%+v", invalid)
+	}
+
+	withdraw := ActivityRecord{
+		Action:         ActionWithdraw,
+		Destination:    "binance",
+		Params:         ActivityParams{Asset: 1},
+		Result:         ActivityResult{Tx: "0x1", ID: "w1"},
+		ExchangeStatus: ExchangeStatusDone,
+		MiningStatus:   MiningStatusMined,
+		Timestamp:      "1000",
+	}
+	setRates := ActivityRecord{
+		Action:       ActionSetRate,
+		Destination:  "blockchain",
+		Params:       ActivityParams{Assets: []uint64{1, 2}},
+		MiningStatus: MiningStatusFailed,
+		Timestamp:    "2000",
+	}
+	trade := ActivityRecord{
+		Action:         ActionTrade,
+		Destination:    "binance",
+		Params:         ActivityParams{Base: "KNC", Quote: "ETH"},
+		ExchangeStatus: ExchangeStatusDone,
+		Timestamp:      "3000",
+	}
+	assert.Equal(t, ActivityStateDone, withdraw.State())
+	assert.Equal(t, ActivityStateFailed, setRates.State())
+
+	for _, tc := range []struct {
+		query    ActivityQuery
+		withdraw bool
+		setRates bool
+		trade    bool
+	}{
+		{query: ActivityQuery{}, withdraw: true, setRates: true, trade: true},
+		{query: ActivityQuery{Actions: []string{ActionWithdraw, ActionDeposit}}, withdraw: true},
+		{query: ActivityQuery{Exchange: "blockchain"}, setRates: true},
+		{query: ActivityQuery{Asset: 1}, withdraw: true, setRates: true},
+		{query: ActivityQuery{Asset: 1, AssetSymbols: map[string]string{"binance": "ETH"}}, withdraw: true, setRates: true, trade: true},
+		{query: ActivityQuery{Asset: 2, AssetSymbols: map[string]string{"huobi": "KNC"}}, setRates: true},
+		{query: ActivityQuery{State: ActivityStateFailed}, setRates: true},
+		{query: ActivityQuery{Tx: "0x1"}, withdraw: true},
+		{query: ActivityQuery{OrderID: "w2"}},
+		{query: ActivityQuery{FromTime: 1500}, setRates: true, trade: true},
+		{query: ActivityQuery{ToTime: 1500}, withdraw: true},
+	} {
+		assert.Equal(t, tc.withdraw, tc.query.Match(withdraw), "%+v", tc.query)
+		assert.Equal(t, tc.setRates, tc.query.Match(setRates), "%+v", tc.query)
+		assert.Equal(t, tc.trade, tc.query.Match(trade), "%+v", tc.query)
+	}
+}
@@ -0,0 +1,304 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTransition is returned when status of an activity is changed in a way its action
+// does not allow.
+var ErrInvalidTransition = errors.New("invalid activity status transition")
+
+// ActivitySource is who changes status of an activity.
+type ActivitySource string
+
+const (
+	// ActivitySourceCore is for changes made by core when it creates or finishes an activity.
+	ActivitySourceCore ActivitySource = "core"
+	// ActivitySourceFetcher is for changes of statuses fetched from exchanges and blockchain.
+	ActivitySourceFetcher ActivitySource = "fetcher"
+	// ActivitySourceOperator is for changes made by an operator through API.
+	ActivitySourceOperator ActivitySource = "operator"
+)
+
+// ActivityUpdate describes who updates an activity and the statuses reported by exchange and
+// blockchain which the update is made from.
+type ActivityUpdate struct {
+	Source            ActivitySource
+	RawExchangeStatus string
+	RawMiningStatus   string
+	// Reason is why an operator changes the activity.
+	Reason string
+}
+
+// ActivityTransition is a change of statuses of an activity.
+type ActivityTransition struct {
+	Timestamp          uint64         `json:"timestamp"`
+	Source             ActivitySource `json:"source"`
+	FromExchangeStatus string         `json:"from_exchange_status"`
+	ExchangeStatus     string         `json:"exchange_status"`
+	FromMiningStatus   string         `json:"from_mining_status"`
+	MiningStatus       string         `json:"mining_status"`
+	RawExchangeStatus  string         `json:"raw_exchange_status,omitempty"`
+	RawMiningStatus    string         `json:"raw_mining_status,omitempty"`
+	Reason             string         `json:"reason,omitempty"`
+}
+
+// statusGraph maps a status to the statuses it can change to, a status without next statuses
+// is final.
+type statusGraph map[string][]string
+
+func (g statusGraph) allow(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, next := range g[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// activityStateMachine is the allowed changes of exchange and mining status of an action and
+// the final statuses of each step. An activity is not tracked on a step anymore once its status
+// of the step is final, a final status can still be corrected, like a lost deposit found later.
+// Statuses of a step the action does not have are not validated and the empty status is final.
+type activityStateMachine struct {
+	exchange      statusGraph
+	mining        statusGraph
+	exchangeFinal []string
+	miningFinal   []string
+}
+
+var (
+	// tradeStateMachine is for actions which are only tracked on exchange until they are done,
+	// failed or cancelled.
+	tradeStateMachine = activityStateMachine{
+		exchange: statusGraph{
+			"":                      {ExchangeStatusSubmitted, ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusCancelled},
+			ExchangeStatusSubmitted: {ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusCancelled},
+		},
+		exchangeFinal: []string{ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusCancelled},
+		miningFinal:   []string{""},
+	}
+	// reserveTxStateMachine is for actions which are transactions sent by the reserve, their
+	// exchange status is only set to failed when the transaction can not be sent.
+	reserveTxStateMachine = activityStateMachine{
+		exchange: statusGraph{
+			"": {ExchangeStatusFailed},
+		},
+		mining: statusGraph{
+			"":                    {MiningStatusSubmitted, MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+			MiningStatusSubmitted: {MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+			MiningStatusLost:      {MiningStatusMined, MiningStatusFailed},
+		},
+		exchangeFinal: []string{"", ExchangeStatusFailed},
+		miningFinal:   []string{MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+	}
+)
+
+// activityStateMachines are the state machines by action, statuses of other actions are not
+// validated.
+var activityStateMachines = map[string]activityStateMachine{
+	ActionTrade:     tradeStateMachine,
+	ActionExecution: tradeStateMachine,
+	ActionInternalTransfer: {
+		exchange: statusGraph{
+			"":                      {ExchangeStatusSubmitted, ExchangeStatusDone, ExchangeStatusFailed},
+			ExchangeStatusSubmitted: {ExchangeStatusDone, ExchangeStatusFailed},
+		},
+		exchangeFinal: []string{ExchangeStatusDone, ExchangeStatusFailed},
+		miningFinal:   []string{""},
+	},
+	ActionDeposit: {
+		exchange: statusGraph{
+			"":                    {ExchangeStatusPending, ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusLost},
+			ExchangeStatusPending: {ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusLost},
+			ExchangeStatusLost:    {ExchangeStatusDone, ExchangeStatusFailed},
+			// a deposit which is done on exchange but stuck for too long is failed by fetcher
+			ExchangeStatusDone: {ExchangeStatusFailed},
+		},
+		mining: statusGraph{
+			"":                    {MiningStatusSubmitted, MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+			MiningStatusSubmitted: {MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+			MiningStatusLost:      {MiningStatusMined, MiningStatusFailed},
+		},
+		exchangeFinal: []string{ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusLost},
+		miningFinal:   []string{MiningStatusMined, MiningStatusFailed, MiningStatusLost},
+	},
+	ActionWithdraw: {
+		exchange: statusGraph{
+			"":                      {ExchangeStatusSubmitted, ExchangeStatusDone, ExchangeStatusFailed},
+			ExchangeStatusSubmitted: {ExchangeStatusDone, ExchangeStatusFailed},
+			// a withdrawal which is done on exchange but stuck for too long is failed by fetcher
+			ExchangeStatusDone: {ExchangeStatusFailed},
+		},
+		mining: statusGraph{
+			"":                    {MiningStatusSubmitted, MiningStatusMined, MiningStatusFailed},
+			MiningStatusSubmitted: {MiningStatusMined, MiningStatusFailed},
+		},
+		exchangeFinal: []string{ExchangeStatusDone, ExchangeStatusFailed},
+		miningFinal:   []string{MiningStatusMined, MiningStatusFailed},
+	},
+	// a transfer is submitted until the withdrawal on source exchange is done, then pending until
+	// the deposit on destination exchange is done
+	ActionTransfer: {
+		exchange: statusGraph{
+			"":                      {ExchangeStatusSubmitted, ExchangeStatusPending, ExchangeStatusDone, ExchangeStatusFailed},
+			ExchangeStatusSubmitted: {ExchangeStatusPending, ExchangeStatusDone, ExchangeStatusFailed},
+			ExchangeStatusPending:   {ExchangeStatusDone, ExchangeStatusFailed},
+		},
+		exchangeFinal: []string{ExchangeStatusDone, ExchangeStatusFailed},
+		miningFinal:   []string{""},
+	},
+	ActionSetRate:         reserveTxStateMachine,
+	ActionReserveWithdraw: reserveTxStateMachine,
+}
+
+func isFinal(finals []string, status string) bool {
+	for _, final := range finals {
+		if final == status {
+			return true
+		}
+	}
+	return false
+}
+
+// failed returns true if a step of the activity is failed, a failed activity is not tracked on
+// any step.
+func (ar ActivityRecord) failed() bool {
+	return ar.ExchangeStatus == ExchangeStatusFailed || ar.MiningStatus == MiningStatusFailed
+}
+
+// IsExchangePending returns true if the exchange status of the activity is not final, activities
+// of actions without state machine are always pending.
+func (ar ActivityRecord) IsExchangePending() bool {
+	machine, ok := activityStateMachines[ar.Action]
+	if !ok {
+		return true
+	}
+	return !ar.failed() && !isFinal(machine.exchangeFinal, ar.ExchangeStatus)
+}
+
+// IsBlockchainPending returns true if the mining status of the activity is not final, activities
+// of actions without state machine are always pending.
+func (ar ActivityRecord) IsBlockchainPending() bool {
+	machine, ok := activityStateMachines[ar.Action]
+	if !ok {
+		return true
+	}
+	return !ar.failed() && !isFinal(machine.miningFinal, ar.MiningStatus)
+}
+
+// IsPending returns true if the activity is pending on exchange or blockchain.
+func (ar ActivityRecord) IsPending() bool {
+	return ar.IsExchangePending() || ar.IsBlockchainPending()
+}
+
+// NewActivityCreation returns the first transition of an activity from empty statuses.
+func NewActivityCreation(ar ActivityRecord, source ActivitySource, timestamp uint64) ActivityTransition {
+	return ActivityTransition{
+		Timestamp:      timestamp,
+		Source:         source,
+		ExchangeStatus: ar.ExchangeStatus,
+		MiningStatus:   ar.MiningStatus,
+	}
+}
+
+// Transition validates changing statuses of the activity to the ones of next and returns the
+// transition, nil is returned if statuses are not changed. Changes made by operators are not
+// validated as they resolve activities which statuses can not be fetched.
+func (ar ActivityRecord) Transition(next ActivityRecord, update ActivityUpdate, timestamp uint64) (*ActivityTransition, error) {
+	if ar.ExchangeStatus == next.ExchangeStatus && ar.MiningStatus == next.MiningStatus {
+		return nil, nil
+	}
+	if machine, ok := activityStateMachines[ar.Action]; ok && update.Source != ActivitySourceOperator {
+		if !machine.exchange.allow(ar.ExchangeStatus, next.ExchangeStatus) {
+			return nil, fmt.Errorf("%w: %s exchange status %q to %q", ErrInvalidTransition,
+				ar.Action, ar.ExchangeStatus, next.ExchangeStatus)
+		}
+		if !machine.mining.allow(ar.MiningStatus, next.MiningStatus) {
+			return nil, fmt.Errorf("%w: %s mining status %q to %q", ErrInvalidTransition,
+				ar.Action, ar.MiningStatus, next.MiningStatus)
+		}
+	}
+	return &ActivityTransition{
+		Timestamp:          timestamp,
+		Source:             update.Source,
+		FromExchangeStatus: ar.ExchangeStatus,
+		ExchangeStatus:     next.ExchangeStatus,
+		FromMiningStatus:   ar.MiningStatus,
+		MiningStatus:       next.MiningStatus,
+		RawExchangeStatus:  update.RawExchangeStatus,
+		RawMiningStatus:    update.RawMiningStatus,
+		Reason:             update.Reason,
+	}, nil
+}
+
+// ActivityOverride is a resolution of a stuck activity by an operator.
+type ActivityOverride struct {
+	// Status is the final status of the activity, one of done, failed or cancelled.
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+	// Amount corrects the amount of deposit, withdraw and transfer activities.
+	Amount *float64 `json:"amount,omitempty"`
+	// Filled corrects the filled amount of trade and execution activities.
+	Filled *float64 `json:"filled,omitempty"`
+}
+
+// Apply returns the activity resolved with statuses and amounts of the override.
+func (o ActivityOverride) Apply(ar ActivityRecord) (ActivityRecord, error) {
+	if o.Reason == "" {
+		return ar, errors.New("reason is required")
+	}
+	if !ar.IsPending() {
+		return ar, fmt.Errorf("activity %s is not pending", ar.ID)
+	}
+	var isTrade, isTransfer, onExchange, onChain bool
+	switch ar.Action {
+	case ActionTrade, ActionExecution:
+		isTrade, onExchange = true, true
+	case ActionDeposit, ActionWithdraw:
+		isTransfer, onExchange, onChain = true, true, true
+	case ActionTransfer:
+		isTransfer, onExchange = true, true
+	case ActionInternalTransfer:
+		onExchange = true
+	case ActionSetRate, ActionReserveWithdraw:
+		onChain = true
+	}
+	switch o.Status {
+	case ExchangeStatusDone:
+		if onExchange && ar.IsExchangePending() {
+			ar.ExchangeStatus = ExchangeStatusDone
+		}
+		if onChain && ar.IsBlockchainPending() {
+			ar.MiningStatus = MiningStatusMined
+		}
+	case ExchangeStatusFailed:
+		ar.ExchangeStatus = ExchangeStatusFailed
+	case ExchangeStatusCancelled:
+		if !isTrade {
+			return ar, fmt.Errorf("%s activity can not be cancelled", ar.Action)
+		}
+		ar.ExchangeStatus = ExchangeStatusCancelled
+	default:
+		return ar, fmt.Errorf("invalid status %q, expect %s, %s or %s", o.Status,
+			ExchangeStatusDone, ExchangeStatusFailed, ExchangeStatusCancelled)
+	}
+	if o.Amount != nil {
+		if !isTransfer {
+			return ar, fmt.Errorf("amount of %s activity can not be corrected", ar.Action)
+		}
+		ar.Params.Amount = *o.Amount
+	}
+	if o.Filled != nil {
+		if !isTrade {
+			return ar, fmt.Errorf("filled amount of %s activity can not be corrected", ar.Action)
+		}
+		ar.Result.Done = *o.Filled
+		ar.Result.Remaining = ar.Params.Amount - *o.Filled
+	}
+	return ar, nil
+}
@@ -0,0 +1,145 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityTransition(t *testing.T) {
+	withdraw := ActivityRecord{
+		Action:         ActionWithdraw,
+		ExchangeStatus: ExchangeStatusSubmitted,
+		MiningStatus:   "",
+	}
+	update := ActivityUpdate{Source: ActivitySourceFetcher, RawExchangeStatus: ExchangeStatusDone}
+
+	next := withdraw
+	transition, err := withdraw.Transition(next, update, 1)
+	require.NoError(t, err)
+	assert.Nil(t, transition, "unchanged statuses have no transition")
+
+	next.ExchangeStatus = ExchangeStatusDone
+	transition, err = withdraw.Transition(next, update, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &ActivityTransition{
+		Timestamp:          1,
+		Source:             ActivitySourceFetcher,
+		FromExchangeStatus: ExchangeStatusSubmitted,
+		ExchangeStatus:     ExchangeStatusDone,
+		RawExchangeStatus:  ExchangeStatusDone,
+	}, transition)
+
+	done := next
+	next.ExchangeStatus = ExchangeStatusSubmitted
+	_, err = done.Transition(next, update, 2)
+	assert.True(t, errors.Is(err, ErrInvalidTransition), "done withdrawal can not be submitted again")
+
+	next = done
+	next.MiningStatus = MiningStatusLost
+	_, err = done.Transition(next, update, 2)
+	assert.True(t, errors.Is(err, ErrInvalidTransition), "withdrawal tx is not tracked as lost")
+
+	trade := ActivityRecord{Action: ActionTrade, ExchangeStatus: ExchangeStatusCancelled}
+	next = trade
+	next.ExchangeStatus = ExchangeStatusDone
+	_, err = trade.Transition(next, update, 3)
+	assert.True(t, errors.Is(err, ErrInvalidTransition), "cancelled trade is final")
+
+	transfer := ActivityRecord{Action: ActionInternalTransfer, ExchangeStatus: ExchangeStatusDone}
+	next = transfer
+	next.ExchangeStatus = ExchangeStatusSubmitted
+	_, err = transfer.Transition(next, update, 3)
+	assert.True(t, errors.Is(err, ErrInvalidTransition), "done internal transfer is final")
+
+	unknown := ActivityRecord{Action: "unknown", ExchangeStatus: ExchangeStatusDone}
+	next = unknown
+	next.ExchangeStatus = ExchangeStatusSubmitted
+	_, err = unknown.Transition(next, update, 3)
+	assert.NoError(t, err, "actions without state machine are not validated")
+}
+
+func TestActivityPending(t *testing.T) {
+	tests := []struct {
+		activity                            ActivityRecord
+		pending, exchangePending, bcPending bool
+	}{
+		{
+			activity: ActivityRecord{Action: ActionWithdraw, ExchangeStatus: ExchangeStatusDone, MiningStatus: MiningStatusSubmitted},
+			pending:  true, bcPending: true,
+		},
+		{
+			activity: ActivityRecord{Action: ActionWithdraw, ExchangeStatus: ExchangeStatusSubmitted, MiningStatus: MiningStatusFailed},
+		},
+		{
+			activity: ActivityRecord{Action: ActionDeposit, ExchangeStatus: ExchangeStatusPending, MiningStatus: MiningStatusMined},
+			pending:  true, exchangePending: true,
+		},
+		{
+			activity: ActivityRecord{Action: ActionDeposit, ExchangeStatus: ExchangeStatusLost, MiningStatus: MiningStatusMined},
+		},
+		{
+			activity: ActivityRecord{Action: ActionTransfer, ExchangeStatus: ExchangeStatusPending},
+			pending:  true, exchangePending: true,
+		},
+		{
+			activity: ActivityRecord{Action: ActionExecution, ExchangeStatus: ExchangeStatusCancelled},
+		},
+		{
+			activity: ActivityRecord{Action: ActionSetRate, MiningStatus: MiningStatusSubmitted},
+			pending:  true, bcPending: true,
+		},
+		{
+			activity: ActivityRecord{Action: ActionSetRate, ExchangeStatus: ExchangeStatusFailed},
+		},
+		{
+			activity: ActivityRecord{Action: "unknown", ExchangeStatus: ExchangeStatusDone},
+			pending:  true, exchangePending: true, bcPending: true,
+		},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.pending, tc.activity.IsPending(), "%+v", tc.activity)
+		assert.Equal(t, tc.exchangePending, tc.activity.IsExchangePending(), "%+v", tc.activity)
+		assert.Equal(t, tc.bcPending, tc.activity.IsBlockchainPending(), "%+v", tc.activity)
+	}
+}
+
+func TestActivityOverride(t *testing.T) {
+	deposit := ActivityRecord{
+		Action:         ActionDeposit,
+		ExchangeStatus: ExchangeStatusPending,
+		MiningStatus:   MiningStatusMined,
+		Params:         ActivityParams{Amount: 10},
+	}
+	amount := 9.5
+	_, err := ActivityOverride{Status: ExchangeStatusDone}.Apply(deposit)
+	assert.Error(t, err, "reason is required")
+
+	done, err := ActivityOverride{Status: ExchangeStatusDone, Reason: "credited", Amount: &amount}.Apply(deposit)
+	require.NoError(t, err)
+	assert.Equal(t, ExchangeStatusDone, done.ExchangeStatus)
+	assert.Equal(t, MiningStatusMined, done.MiningStatus)
+	assert.Equal(t, amount, done.Params.Amount)
+	assert.False(t, done.IsPending())
+
+	_, err = ActivityOverride{Status: ExchangeStatusFailed, Reason: "again"}.Apply(done)
+	assert.Error(t, err, "finished activity can not be overridden")
+	_, err = ActivityOverride{Status: ExchangeStatusCancelled, Reason: "stuck"}.Apply(deposit)
+	assert.Error(t, err, "deposit can not be cancelled")
+
+	trade := ActivityRecord{Action: ActionTrade, ExchangeStatus: ExchangeStatusSubmitted, Params: ActivityParams{Amount: 10}}
+	filled := 4.0
+	cancelled, err := ActivityOverride{Status: ExchangeStatusCancelled, Reason: "stuck", Filled: &filled}.Apply(trade)
+	require.NoError(t, err)
+	assert.Equal(t, ExchangeStatusCancelled, cancelled.ExchangeStatus)
+	assert.Equal(t, 4.0, cancelled.Result.Done)
+	assert.Equal(t, 6.0, cancelled.Result.Remaining)
+	_, err = ActivityOverride{Status: ExchangeStatusFailed, Reason: "stuck", Amount: &amount}.Apply(trade)
+	assert.Error(t, err, "amount of trade can not be corrected")
+
+	transition, err := trade.Transition(cancelled, ActivityUpdate{Source: ActivitySourceOperator, Reason: "stuck"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "stuck", transition.Reason)
+}
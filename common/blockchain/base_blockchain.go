@@ -14,8 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethereum "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/ethereum/go-ethereum/rpc"
 	"go.uber.org/zap"
 
 	"github.com/KyberNetwork/reserve-data/common"
@@ -35,8 +33,7 @@ const (
 // It has eth usd rate lookup function.
 
 type BaseBlockchain struct {
-	client         *ethclient.Client
-	rpcClient      *rpc.Client
+	pool           *NodePool
 	operators      map[string]*Operator
 	broadcaster    *Broadcaster
 	contractCaller *ContractCaller
@@ -61,9 +58,15 @@ func (b *BaseBlockchain) MustRegisterOperator(name string, op *Operator) {
 }
 
 func (b *BaseBlockchain) RecommendedGasPriceFromNode() (*big.Int, error) {
-	timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
-	defer cancel()
-	return b.client.SuggestGasPrice(timeout)
+	var gasPrice *big.Int
+	err := b.pool.Best().call(func(n *Node) error {
+		timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
+		defer cancel()
+		var err error
+		gasPrice, err = n.client.SuggestGasPrice(timeout)
+		return err
+	})
+	return gasPrice, err
 }
 
 // MustGetOperator returns the operator if avail, panic if the operator can't be found
@@ -75,20 +78,31 @@ func (b *BaseBlockchain) MustGetOperator(name string) *Operator {
 	return op
 }
 
+// GetMinedNonce returns the mined nonce of the operator from the sticky node.
 func (b *BaseBlockchain) GetMinedNonce(operator string) (uint64, error) {
-	nonce, err := b.MustGetOperator(operator).NonceCorpus.MinedNonce(b.client)
+	var nonce *big.Int
+	err := b.pool.Sticky().call(func(n *Node) error {
+		var err error
+		nonce, err = b.MustGetOperator(operator).NonceCorpus.MinedNonce(n.client)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
 	return nonce.Uint64(), err
 }
 
+// GetNextNonce returns the next nonce of the operator from the sticky node.
 func (b *BaseBlockchain) GetNextNonce(operator string) (*big.Int, error) {
 	var nonce *big.Int
-	n := b.MustGetOperator(operator).NonceCorpus
+	corpus := b.MustGetOperator(operator).NonceCorpus
 	var err error
 	for i := 0; i < 3; i++ {
-		nonce, err = n.GetNextNonce(b.client)
+		err = b.pool.Sticky().call(func(n *Node) error {
+			var err error
+			nonce, err = corpus.GetNextNonce(n.client)
+			return err
+		})
 		if err == nil {
 			return nonce, nil
 		}
@@ -138,11 +152,15 @@ func (b *BaseBlockchain) Call(timeOut time.Duration, opts CallOpts, contract *Co
 	if err == nil && len(output) == 0 {
 		ctx := context.Background()
 		// Make sure we have a contract to operate on, and bail out otherwise.
-		if opts.Block == nil || opts.Block.Cmp(ethereum.Big0) == 0 {
-			code, err = b.client.CodeAt(ctx, contract.Address, nil)
-		} else {
-			code, err = b.client.CodeAt(ctx, contract.Address, opts.Block)
-		}
+		err = b.pool.Best().call(func(n *Node) error {
+			var err error
+			if opts.Block == nil || opts.Block.Cmp(ethereum.Big0) == 0 {
+				code, err = n.client.CodeAt(ctx, contract.Address, nil)
+			} else {
+				code, err = n.client.CodeAt(ctx, contract.Address, opts.Block)
+			}
+			return err
+		})
 		if err != nil {
 			return err
 		} else if len(code) == 0 {
@@ -180,9 +198,16 @@ func (b *BaseBlockchain) transactTx(context context.Context, opts TxOpts, contra
 	}
 	gasLimit := opts.GasLimit
 	if gasLimit == 0 {
+		// pending state is read from the node nonce is read from
+		node := b.pool.Sticky()
 		// Gas estimation cannot succeed without code for method invocations
 		if contract.Hash().Big().Cmp(ethereum.Big0) == 0 {
-			if code, pErr := b.client.PendingCodeAt(ensureContext(context), contract); pErr != nil {
+			var code []byte
+			if pErr := node.call(func(n *Node) error {
+				var err error
+				code, err = n.client.PendingCodeAt(ensureContext(context), contract)
+				return err
+			}); pErr != nil {
 				return nil, pErr
 			} else if len(code) == 0 {
 				return nil, bind.ErrNoCode
@@ -190,7 +215,11 @@ func (b *BaseBlockchain) transactTx(context context.Context, opts TxOpts, contra
 		}
 		// If the contract surely has code (or code is not needed), estimate the transaction
 		msg := ether.CallMsg{From: opts.Operator.Address, To: &contract, Value: value, Data: input}
-		gasLimit, err = b.client.EstimateGas(ensureContext(context), msg)
+		err = node.call(func(n *Node) error {
+			var err error
+			gasLimit, err = n.client.EstimateGas(ensureContext(context), msg)
+			return err
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas needed: %v", err)
 		}
@@ -240,14 +269,28 @@ func (b *BaseBlockchain) GetTxOpts(op string, nonce *big.Int, gasPrice *big.Int,
 }
 
 func (b *BaseBlockchain) GetLogs(param ether.FilterQuery) ([]types.Log, error) {
+	return b.GetLogsFrom(b.pool.Best(), param)
+}
+
+// GetLogsFrom returns logs matching the filter from the node.
+func (b *BaseBlockchain) GetLogsFrom(node *Node, param ether.FilterQuery) ([]types.Log, error) {
 	var result []types.Log
-	err := b.rpcClient.Call(&result, "eth_getLogs", toFilterArg(param))
+	err := node.call(func(n *Node) error {
+		return n.rpcClient.Call(&result, "eth_getLogs", toFilterArg(param))
+	})
 	return result, err
 }
 
 func (b *BaseBlockchain) CurrentBlock() (uint64, error) {
+	return b.CurrentBlockFrom(b.pool.Best())
+}
+
+// CurrentBlockFrom returns the head block number of the node.
+func (b *BaseBlockchain) CurrentBlockFrom(node *Node) (uint64, error) {
 	var blockno string
-	err := b.rpcClient.Call(&blockno, "eth_blockNumber")
+	err := node.call(func(n *Node) error {
+		return n.rpcClient.Call(&blockno, "eth_blockNumber")
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -255,6 +298,30 @@ func (b *BaseBlockchain) CurrentBlock() (uint64, error) {
 	return result, err
 }
 
+// StickyNode returns the sticky node of the pool, queries which must see the same chain are
+// sent to it.
+func (b *BaseBlockchain) StickyNode() *Node {
+	return b.pool.Sticky()
+}
+
+// HeaderByNumber returns header of the block with the number from the node.
+func (b *BaseBlockchain) HeaderByNumber(node *Node, number uint64) (*types.Header, error) {
+	var header *types.Header
+	err := node.call(func(n *Node) error {
+		ctx, cancel := context.WithTimeout(context.Background(), nodeCheckTimeout)
+		defer cancel()
+		var err error
+		header, err = n.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		return err
+	})
+	return header, err
+}
+
+// NodeStates returns health of Ethereum nodes the blockchain uses.
+func (b *BaseBlockchain) NodeStates() []common.NodeState {
+	return b.pool.States()
+}
+
 func (b *BaseBlockchain) PackERC20Data(method string, params ...interface{}) ([]byte, error) {
 	return b.erc20abi.Pack(method, params...)
 }
@@ -280,9 +347,14 @@ func (b *BaseBlockchain) BuildSendERC20Tx(opts TxOpts, amount *big.Int, to ether
 		return nil, err
 	}
 	msg := ether.CallMsg{From: opts.Operator.Address, To: &tokenAddress, Value: value, Data: data}
-	timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
-	defer cancel()
-	gasLimit, err := b.client.EstimateGas(timeout, msg)
+	var gasLimit uint64
+	err = b.pool.Sticky().call(func(n *Node) error {
+		timeout, cancel := context.WithTimeout(context.Background(), 7*time.Second)
+		defer cancel()
+		var err error
+		gasLimit, err = n.client.EstimateGas(timeout, msg)
+		return err
+	})
 	if err != nil {
 		b.l.Warnw("Cannot estimate gas limit", "err", err)
 		return nil, err
@@ -311,9 +383,12 @@ func (b *BaseBlockchain) BuildSendETHTx(opts TxOpts, to ethereum.Address) (*type
 	return rawTx, nil
 }
 
+// TransactionByHash returns the transaction from the sticky node.
 func (b *BaseBlockchain) TransactionByHash(ctx context.Context, hash ethereum.Hash) (tx *RPCTransaction, isPending bool, err error) {
 	var json *RPCTransaction
-	err = b.rpcClient.CallContext(ctx, &json, "eth_getTransactionByHash", hash)
+	err = b.pool.Sticky().call(func(n *Node) error {
+		return n.rpcClient.CallContext(ctx, &json, "eth_getTransactionByHash", hash)
+	})
 	if err != nil {
 		return nil, false, err
 	}
@@ -346,7 +421,11 @@ func (b *BaseBlockchain) TxStatus(hash ethereum.Hash) (string, uint64, error) {
 		return common.MiningStatusPending, 0, nil
 	}
 	var receipt *types.Receipt
-	receipt, err = b.client.TransactionReceipt(option, hash)
+	err = b.pool.Sticky().call(func(n *Node) error {
+		var err error
+		receipt, err = n.client.TransactionReceipt(option, hash)
+		return err
+	})
 	if err != nil {
 		if err == ether.NotFound {
 			return common.MiningStatusLost, 0, nil
@@ -376,8 +455,7 @@ func (b *BaseBlockchain) TxStatus(hash ethereum.Hash) (string, uint64, error) {
 }
 
 func NewBaseBlockchain(
-	rpcClient *rpc.Client,
-	client *ethclient.Client,
+	pool *NodePool,
 	operators map[string]*Operator,
 	broadcaster *Broadcaster,
 	contractcaller *ContractCaller) *BaseBlockchain {
@@ -388,8 +466,7 @@ func NewBaseBlockchain(
 	}
 
 	return &BaseBlockchain{
-		client:         client,
-		rpcClient:      rpcClient,
+		pool:           pool,
 		operators:      operators,
 		broadcaster:    broadcaster,
 		erc20abi:       packabi,
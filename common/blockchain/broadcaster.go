@@ -5,9 +5,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // Broadcaster takes a signed tx and try to broadcast it to all
@@ -15,12 +13,14 @@ import (
 // failures and a bool indicating that the tx is broadcasted to
 // at least 1 node
 type Broadcaster struct {
-	clients map[string]*ethclient.Client
+	pool *NodePool
 }
 
-func (b Broadcaster) sendTx(client ethereum.TransactionSender, tx *types.Transaction) error {
+// sendTx sends the tx to the node, errors are not recorded in node health as most of them are
+// rejections of the tx, e.g it is already known by the node.
+func (b Broadcaster) sendTx(node *Node, tx *types.Transaction) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-	err := client.SendTransaction(ctx, tx)
+	err := node.client.SendTransaction(ctx, tx)
 	cancel()
 	return err
 }
@@ -28,14 +28,15 @@ func (b Broadcaster) sendTx(client ethereum.TransactionSender, tx *types.Transac
 func (b Broadcaster) Broadcast(tx *types.Transaction) (map[string]error, bool) {
 	failures := sync.Map{}
 	wg := sync.WaitGroup{}
-	for id, client := range b.clients {
+	nodes := b.pool.Nodes()
+	for _, node := range nodes {
 		wg.Add(1)
-		go func(cid string, c *ethclient.Client) {
+		go func(n *Node) {
 			defer wg.Done()
-			if err := b.sendTx(c, tx); err != nil {
-				failures.Store(cid, err)
+			if err := b.sendTx(n, tx); err != nil {
+				failures.Store(n.Name(), err)
 			}
-		}(id, client)
+		}(node)
 	}
 	wg.Wait()
 	errorDetail := map[string]error{}
@@ -43,11 +44,11 @@ func (b Broadcaster) Broadcast(tx *types.Transaction) (map[string]error, bool) {
 		errorDetail[key.(string)] = value.(error) // as failures access scope is this func, we sure it's a string->error map
 		return true
 	})
-	return errorDetail, len(errorDetail) != len(b.clients) && len(b.clients) > 0
+	return errorDetail, len(errorDetail) != len(nodes) && len(nodes) > 0
 }
 
-func NewBroadcaster(clients map[string]*ethclient.Client) *Broadcaster {
+func NewBroadcaster(pool *NodePool) *Broadcaster {
 	return &Broadcaster{
-		clients: clients,
+		pool: pool,
 	}
 }
@@ -8,32 +8,34 @@ import (
 
 	ether "github.com/ethereum/go-ethereum"
 	"go.uber.org/zap"
-
-	"github.com/KyberNetwork/reserve-data/common"
 )
 
 type ContractCaller struct {
-	clients []*common.EthClient
-	l       *zap.SugaredLogger
+	pool *NodePool
+	l    *zap.SugaredLogger
 }
 
-func NewContractCaller(clients []*common.EthClient) *ContractCaller {
+func NewContractCaller(pool *NodePool) *ContractCaller {
 	return &ContractCaller{
-		clients: clients,
-		l:       zap.S(),
+		pool: pool,
+		l:    zap.S(),
 	}
 }
 
+// CallContract calls the contract on the healthiest node, the next healthiest ones are tried
+// if it fails.
 func (c ContractCaller) CallContract(msg ether.CallMsg, blockNo *big.Int, timeOut time.Duration) ([]byte, error) {
-	for _, client := range c.clients {
-
-		output, err := func() ([]byte, error) {
+	for _, node := range c.pool.Ordered() {
+		var output []byte
+		err := node.call(func(n *Node) error {
 			ctx, cancel := context.WithTimeout(context.Background(), timeOut)
 			defer cancel()
-			return client.CallContract(ctx, msg, blockNo)
-		}()
+			var err error
+			output, err = n.client.CallContract(ctx, msg, blockNo)
+			return err
+		})
 		if err != nil {
-			c.l.Infof("FALLBACK: Ether client %s done, getting err %v, trying next one...", client.URL, err)
+			c.l.Infof("FALLBACK: Ether client %s done, getting err %v, trying next one...", node.Name(), err)
 			continue
 		}
 		return output, nil
@@ -0,0 +1,358 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ether "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	// DefaultMaxBlockLag is the number of blocks a node can be behind the pool head and still be healthy.
+	DefaultMaxBlockLag = 5
+	// DefaultNodeCheckInterval is how often heads of nodes are checked.
+	DefaultNodeCheckInterval = 15 * time.Second
+
+	nodeCheckTimeout = 5 * time.Second
+	// statsWeight is the weight of the latest request in moving latency and error rate.
+	statsWeight = 0.1
+	// maxNodeErrorRate is the error rate above which a node is unhealthy.
+	maxNodeErrorRate = 0.5
+)
+
+// Node is an Ethereum node of the pool with its health statistics.
+type Node struct {
+	url       string
+	name      string
+	rpcClient *rpc.Client
+	client    *ethclient.Client
+
+	mu          sync.RWMutex
+	head        uint64
+	latency     time.Duration
+	errorRate   float64
+	requests    uint64
+	lastError   string
+	lastCheck   uint64
+	checkFailed bool
+}
+
+// secretPathSegment matches path segments which look like API keys, e.g project id of Infura.
+var secretPathSegment = regexp.MustCompile(`^[A-Za-z0-9_-]{16,}$`)
+
+// redact returns a short hash of the secret, so urls with different secrets have different names.
+func redact(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return "redacted-" + hex.EncodeToString(sum[:4])
+}
+
+// nodeName returns the node url with its secrets redacted: user info, query values and path
+// segments which look like API keys. The whole url is redacted if it can not be parsed.
+func nodeName(nodeURL string) string {
+	u, err := url.Parse(nodeURL)
+	if err != nil || u.Host == "" {
+		return redact(nodeURL)
+	}
+	if u.User != nil {
+		u.User = url.User(redact(u.User.String()))
+	}
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		if secretPathSegment.MatchString(segment) {
+			segments[i] = redact(segment)
+		}
+	}
+	u.Path, u.RawPath = strings.Join(segments, "/"), ""
+	query := u.Query()
+	for _, values := range query {
+		for i := range values {
+			values[i] = redact(values[i])
+		}
+	}
+	u.RawQuery, u.Fragment = query.Encode(), ""
+	return u.String()
+}
+
+// NewNode creates a node of the rpc client connected to the url.
+func NewNode(nodeURL string, rpcClient *rpc.Client) *Node {
+	return &Node{
+		url:       nodeURL,
+		name:      nodeName(nodeURL),
+		rpcClient: rpcClient,
+		client:    ethclient.NewClient(rpcClient),
+	}
+}
+
+// Name returns the node url with its secrets redacted.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// URL returns the url the node is connected to.
+func (n *Node) URL() string {
+	return n.url
+}
+
+// Close closes the connection to the node.
+func (n *Node) Close() {
+	n.rpcClient.Close()
+}
+
+// record updates moving latency and error rate with a request, not found errors are results
+// of successful requests.
+func (n *Node) record(latency time.Duration, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var failed float64
+	if err != nil && err != ether.NotFound {
+		failed = 1
+		n.lastError = err.Error()
+	}
+	n.requests++
+	if n.requests == 1 {
+		n.latency, n.errorRate = latency, failed
+		return
+	}
+	n.latency = time.Duration(statsWeight*float64(latency) + (1-statsWeight)*float64(n.latency))
+	n.errorRate = statsWeight*failed + (1-statsWeight)*n.errorRate
+}
+
+// call runs a request on the node and records its latency and result.
+func (n *Node) call(fn func(n *Node) error) error {
+	start := time.Now()
+	err := fn(n)
+	n.record(time.Since(start), err)
+	return err
+}
+
+// checkHead fetches the latest block number of the node.
+func (n *Node) checkHead() {
+	var head uint64
+	err := n.call(func(n *Node) error {
+		ctx, cancel := context.WithTimeout(context.Background(), nodeCheckTimeout)
+		defer cancel()
+		var blockno string
+		if err := n.rpcClient.CallContext(ctx, &blockno, "eth_blockNumber"); err != nil {
+			return err
+		}
+		var err error
+		head, err = strconv.ParseUint(blockno, 0, 64)
+		return err
+	})
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.lastCheck = common.NowInMillis()
+	n.checkFailed = err != nil
+	if err == nil {
+		n.head = head
+	}
+}
+
+// NodePool routes requests to the healthiest Ethereum nodes. A node is healthy if its last head
+// check succeeded, its error rate is acceptable and it lags behind the highest head of the pool
+// by no more than the max block lag.
+type NodePool struct {
+	nodesMu     sync.RWMutex
+	nodes       []*Node
+	maxBlockLag uint64
+
+	mu     sync.Mutex
+	sticky *Node
+	l      *zap.SugaredLogger
+}
+
+// NewNodePool creates a pool of the nodes, the pool must have at least one node and the first
+// one is preferred for nonce and receipt queries.
+func NewNodePool(nodes []*Node, maxBlockLag uint64) *NodePool {
+	if len(nodes) == 0 {
+		panic("node pool must have at least one node")
+	}
+	return &NodePool{
+		nodes:       nodes,
+		maxBlockLag: maxBlockLag,
+		sticky:      nodes[0],
+		l:           zap.S(),
+	}
+}
+
+// SetNodes replaces nodes of the pool, the first one becomes the sticky node. It returns the nodes
+// no longer in the pool, they should be closed by the caller.
+func (p *NodePool) SetNodes(nodes []*Node) []*Node {
+	if len(nodes) == 0 {
+		panic("node pool must have at least one node")
+	}
+	p.nodesMu.Lock()
+	old := p.nodes
+	p.nodes = nodes
+	p.nodesMu.Unlock()
+
+	p.mu.Lock()
+	p.sticky = nodes[0]
+	p.mu.Unlock()
+
+	kept := make(map[*Node]bool, len(nodes))
+	for _, n := range nodes {
+		kept[n] = true
+	}
+	var removed []*Node
+	for _, n := range old {
+		if !kept[n] {
+			removed = append(removed, n)
+		}
+	}
+	return removed
+}
+
+// Check fetches heads of all nodes concurrently.
+func (p *NodePool) Check() {
+	var wg sync.WaitGroup
+	for _, n := range p.Nodes() {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			n.checkHead()
+		}(n)
+	}
+	wg.Wait()
+}
+
+// Run checks heads of nodes periodically until ctx is done.
+func (p *NodePool) Run(ctx context.Context, interval time.Duration) {
+	p.Check()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Check()
+		}
+	}
+}
+
+func (p *NodePool) bestHead() uint64 {
+	var best uint64
+	for _, n := range p.Nodes() {
+		n.mu.RLock()
+		if !n.checkFailed && n.head > best {
+			best = n.head
+		}
+		n.mu.RUnlock()
+	}
+	return best
+}
+
+func (p *NodePool) healthy(n *Node, bestHead uint64) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return !n.checkFailed && n.errorRate <= maxNodeErrorRate && n.head+p.maxBlockLag >= bestHead
+}
+
+// Ordered returns healthy nodes from the lowest error rate and latency followed by unhealthy
+// ones as the last resort.
+func (p *NodePool) Ordered() []*Node {
+	type scored struct {
+		node      *Node
+		healthy   bool
+		errorRate float64
+		latency   time.Duration
+	}
+	var (
+		best = p.bestHead()
+		list = p.Nodes()
+	)
+	nodes := make([]scored, 0, len(list))
+	for _, n := range list {
+		healthy := p.healthy(n, best)
+		n.mu.RLock()
+		nodes = append(nodes, scored{node: n, healthy: healthy, errorRate: n.errorRate, latency: n.latency})
+		n.mu.RUnlock()
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].healthy != nodes[j].healthy {
+			return nodes[i].healthy
+		}
+		if nodes[i].errorRate != nodes[j].errorRate {
+			return nodes[i].errorRate < nodes[j].errorRate
+		}
+		return nodes[i].latency < nodes[j].latency
+	})
+	result := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, n.node)
+	}
+	return result
+}
+
+// Best returns the healthiest node.
+func (p *NodePool) Best() *Node {
+	return p.Ordered()[0]
+}
+
+// Sticky returns the node nonces, receipts and pending state are queried from. The same node
+// is returned while it is healthy so the queries see a consistent chain.
+func (p *NodePool) Sticky() *Node {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.healthy(p.sticky, p.bestHead()) {
+		return p.sticky
+	}
+	best := p.Best()
+	if best != p.sticky {
+		p.l.Warnw("switching sticky node", "from", p.sticky.Name(), "to", best.Name())
+		p.sticky = best
+	}
+	return p.sticky
+}
+
+// Nodes returns all nodes of the pool.
+func (p *NodePool) Nodes() []*Node {
+	p.nodesMu.RLock()
+	defer p.nodesMu.RUnlock()
+	return p.nodes
+}
+
+// States returns health of nodes of the pool.
+func (p *NodePool) States() []common.NodeState {
+	best := p.bestHead()
+	p.mu.Lock()
+	sticky := p.sticky
+	p.mu.Unlock()
+	list := p.Nodes()
+	states := make([]common.NodeState, 0, len(list))
+	for _, n := range list {
+		healthy := p.healthy(n, best)
+		n.mu.RLock()
+		state := common.NodeState{
+			Name:          n.name,
+			Head:          n.head,
+			LatencyMillis: int64(n.latency / time.Millisecond),
+			ErrorRate:     n.errorRate,
+			Requests:      n.requests,
+			LastError:     n.lastError,
+			LastCheck:     n.lastCheck,
+			Healthy:       healthy,
+			Sticky:        n == sticky,
+		}
+		if best > n.head {
+			state.Lag = best - n.head
+		}
+		n.mu.RUnlock()
+		states = append(states, state)
+	}
+	return states
+}
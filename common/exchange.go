@@ -1,35 +1,80 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"strconv"
+	"sync"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
 
 	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
-// ExchangeID is the name of exchanges of which core will use to rebalance.
-//go:generate stringer -type=ExchangeID -linecomment
+// ExchangeID is the id of an exchange account of which core will use to rebalance.
 type ExchangeID int
 
+// Built-in exchange accounts, other accounts are defined in the setting database and registered
+// at startup.
 const (
 	//Binance is the enumerated key for binance
-	Binance ExchangeID = iota + 1 //binance
+	Binance ExchangeID = iota + 1
 	//Huobi is the enumerated key for huobi
-	Huobi //huobi
+	Huobi
 	// Binance2 is second binance exchange
-	Binance2 // binance_2
+	Binance2
 	// Coinbase
-	Coinbase // coinbase
+	Coinbase
 )
 
-// ValidExchangeNames returns all valid exchange names.
-var ValidExchangeNames = map[string]ExchangeID{
-	Binance.String():  Binance,
-	Huobi.String():    Huobi,
-	Binance2.String(): Binance2,
-	Coinbase.String(): Coinbase,
+var (
+	exchangeNamesMu sync.RWMutex
+	exchangeNames   = map[ExchangeID]string{
+		Binance:  "binance",
+		Huobi:    "huobi",
+		Binance2: "binance_2",
+		Coinbase: "coinbase",
+	}
+)
+
+// RegisterExchange registers the name of an exchange account.
+func RegisterExchange(id ExchangeID, name string) {
+	exchangeNamesMu.Lock()
+	defer exchangeNamesMu.Unlock()
+	exchangeNames[id] = name
+}
+
+// ExchangeIDByName returns the id of the registered exchange account with the name.
+func ExchangeIDByName(name string) (ExchangeID, bool) {
+	exchangeNamesMu.RLock()
+	defer exchangeNamesMu.RUnlock()
+	for id, exchangeName := range exchangeNames {
+		if exchangeName == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// ValidExchangeNames returns ids of all registered exchange accounts by name.
+func ValidExchangeNames() map[string]ExchangeID {
+	exchangeNamesMu.RLock()
+	defer exchangeNamesMu.RUnlock()
+	result := make(map[string]ExchangeID, len(exchangeNames))
+	for id, name := range exchangeNames {
+		result[name] = id
+	}
+	return result
+}
+
+func (i ExchangeID) String() string {
+	exchangeNamesMu.RLock()
+	defer exchangeNamesMu.RUnlock()
+	if name, ok := exchangeNames[i]; ok {
+		return name
+	}
+	return "ExchangeID(" + strconv.FormatInt(int64(i), 10) + ")"
 }
 
 // Exchange represents a centralized exchange like Binance, Huobi...
@@ -49,6 +94,17 @@ type Exchange interface {
 	LiveExchange
 }
 
+// InternalTransferExchange is implemented by exchanges which can move funds instantly between
+// accounts on the same exchange, e.g Binance master and sub accounts.
+type InternalTransferExchange interface {
+	// SupportInternalTransfer returns true if funds can be transferred between the accounts.
+	SupportInternalTransfer(from, to ExchangeID) bool
+	// InternalTransfer moves amount of asset between the accounts and returns id of the transfer.
+	InternalTransfer(from, to ExchangeID, asset common.Asset, amount *big.Int) (string, error)
+	// InternalTransferStatus returns status of the transfer.
+	InternalTransferStatus(ctx context.Context, id string, timepoint uint64) (string, error)
+}
+
 // LiveExchange interface
 // TODO: choose a better name as this interface for activity which does not affect
 //
@@ -66,7 +122,7 @@ func GetExchange(name string) (Exchange, error) {
 	var (
 		ex Exchange
 	)
-	exchangeID, exist := ValidExchangeNames[name]
+	exchangeID, exist := ExchangeIDByName(name)
 	if !exist {
 		return ex, fmt.Errorf("exchange %s does not exist", name)
 	}
@@ -0,0 +1,61 @@
+package common
+
+const (
+	// ExecutionAlgorithmTWAP splits parent order into equal slices placed evenly over its duration.
+	ExecutionAlgorithmTWAP = "twap"
+	// ExecutionAlgorithmIceberg shows only a slice of parent order on the order book at a time.
+	ExecutionAlgorithmIceberg = "iceberg"
+
+	ExecutionStatusRunning   = "running"
+	ExecutionStatusDone      = "done"
+	ExecutionStatusCancelled = "cancelled"
+	ExecutionStatusFailed    = "failed"
+)
+
+// ParentOrder is an order worked by execution algorithm as multiple child orders.
+type ParentOrder struct {
+	Exchange   ExchangeID `json:"exchange"`
+	PairID     uint64     `json:"pair_id"`
+	Base       string     `json:"base"`
+	Quote      string     `json:"quote"`
+	Side       string     `json:"side"`
+	Amount     float64    `json:"amount"`
+	LimitPrice float64    `json:"limit_price"`
+	Algorithm  string     `json:"algorithm"`
+	// Duration is the time TWAP order is worked over, for iceberg order it is the time an
+	// unfilled slice is kept on the order book before being replaced.
+	Duration HumanDuration `json:"duration"`
+	// SliceSize is the amount of each child order.
+	SliceSize float64 `json:"slice_size"`
+}
+
+// Statuses of orders returned by QueryOrder of exchanges, orders are closed in all statuses but
+// OrderStatusOpen.
+const (
+	OrderStatusOpen      = "open"
+	OrderStatusFilled    = "filled"
+	OrderStatusCancelled = "cancelled"
+	OrderStatusExpired   = "expired"
+	OrderStatusRejected  = "rejected"
+)
+
+// ChildOrder is an order placed on exchange by execution algorithm.
+type ChildOrder struct {
+	ActivityID ActivityID `json:"activity_id"`
+	Amount     float64    `json:"amount"`
+	Filled     float64    `json:"filled"`
+	Open       bool       `json:"open"`
+	Time       uint64     `json:"time"`
+}
+
+// Execution is the progress of a parent order.
+type Execution struct {
+	ID ActivityID `json:"id"`
+	ParentOrder
+	Status   string       `json:"status"`
+	Filled   float64      `json:"filled"`
+	Error    string       `json:"error,omitempty"`
+	Children []ChildOrder `json:"children"`
+	Created  uint64       `json:"created"`
+	Updated  uint64       `json:"updated"`
+}
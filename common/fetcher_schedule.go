@@ -0,0 +1,113 @@
+package common
+
+import "errors"
+
+// Names of fetcher jobs, they are the keys of fetcher_delay config.
+const (
+	FetcherJobOrderBook    = "order_book"
+	FetcherJobAuthData     = "auth_data"
+	FetcherJobRate         = "rate_fetching"
+	FetcherJobBlock        = "block_fetching"
+	FetcherJobGlobalData   = "global_data"
+	FetcherJobTradeHistory = "trade_history"
+)
+
+// FetcherJobConfig is the schedule of a fetcher job.
+type FetcherJobConfig struct {
+	// Interval is the time between runs, zero stops scheduling the job.
+	Interval HumanDuration `json:"interval"`
+	// Timeout is how long a run can take before its result is discarded, zero means no timeout.
+	Timeout HumanDuration `json:"timeout"`
+	// Jitter is the max random delay added to the interval, so jobs of exchanges do not run at
+	// the same time.
+	Jitter HumanDuration `json:"jitter"`
+	// MaxConcurrency is the max number of runs in progress, ticks are skipped when it is reached.
+	// Zero is one run at a time.
+	MaxConcurrency int `json:"max_concurrency"`
+}
+
+// Validate returns an error if a setting of the schedule is negative.
+func (c FetcherJobConfig) Validate() error {
+	if c.Interval < 0 || c.Timeout < 0 || c.Jitter < 0 || c.MaxConcurrency < 0 {
+		return errors.New("settings of fetcher job must not be negative")
+	}
+	return nil
+}
+
+// override returns the config with non zero settings of other applied.
+func (c FetcherJobConfig) override(other FetcherJobConfig) FetcherJobConfig {
+	if other.Interval != 0 {
+		c.Interval = other.Interval
+	}
+	if other.Timeout != 0 {
+		c.Timeout = other.Timeout
+	}
+	if other.Jitter != 0 {
+		c.Jitter = other.Jitter
+	}
+	if other.MaxConcurrency != 0 {
+		c.MaxConcurrency = other.MaxConcurrency
+	}
+	return c
+}
+
+// FetcherScheduleConfig is the schedules of fetcher jobs, keyed by job name. Job names are the
+// keys of fetcher_delay, intervals default to the delays.
+type FetcherScheduleConfig struct {
+	Jobs map[string]FetcherJobConfig `json:"jobs"`
+	// Exchanges overrides schedules of jobs by exchange name, for example a longer timeout of
+	// auth data on a slow exchange.
+	Exchanges map[string]map[string]FetcherJobConfig `json:"exchanges"`
+}
+
+// WithDelay returns the schedule with intervals of jobs not configured set to the fetcher delay.
+func (c FetcherScheduleConfig) WithDelay(delay FetcherDelay) FetcherScheduleConfig {
+	jobs := make(map[string]FetcherJobConfig, len(c.Jobs))
+	for job, config := range c.Jobs {
+		jobs[job] = config
+	}
+	for job, interval := range map[string]HumanDuration{
+		FetcherJobOrderBook:    delay.OrderBook,
+		FetcherJobAuthData:     delay.AuthData,
+		FetcherJobRate:         delay.RateFetching,
+		FetcherJobBlock:        delay.BlockFetching,
+		FetcherJobGlobalData:   delay.GlobalData,
+		FetcherJobTradeHistory: delay.TradeHistory,
+	} {
+		config := jobs[job]
+		if config.Interval == 0 {
+			config.Interval = interval
+		}
+		jobs[job] = config
+	}
+	return FetcherScheduleConfig{Jobs: jobs, Exchanges: c.Exchanges}
+}
+
+// JobConfig returns the schedule of the job on the exchange, exchange is empty for jobs which are
+// not bound to an exchange.
+func (c FetcherScheduleConfig) JobConfig(job, exchange string) FetcherJobConfig {
+	config := c.Jobs[job]
+	if exchange != "" {
+		config = config.override(c.Exchanges[exchange][job])
+	}
+	return config
+}
+
+// FetcherJobStatus is the schedule and run statistics of a fetcher job.
+type FetcherJobStatus struct {
+	Job      string           `json:"job"`
+	Exchange string           `json:"exchange,omitempty"`
+	Config   FetcherJobConfig `json:"config"`
+	Running  int              `json:"running"`
+	Runs     uint64           `json:"runs"`
+	// Skipped is the number of ticks skipped as max concurrency runs were in progress.
+	Skipped uint64 `json:"skipped"`
+	// Overruns is the number of runs taking longer than the interval.
+	Overruns  uint64 `json:"overruns"`
+	Timeouts  uint64 `json:"timeouts"`
+	Failures  uint64 `json:"failures"`
+	LastError string `json:"last_error,omitempty"`
+	// LastStart is the start time of the last run in milliseconds.
+	LastStart    uint64        `json:"last_start"`
+	LastDuration HumanDuration `json:"last_duration"`
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetcherScheduleConfig(t *testing.T) {
+	schedule := FetcherScheduleConfig{
+		Jobs: map[string]FetcherJobConfig{
+			FetcherJobAuthData: {Timeout: HumanDuration(10 * time.Second), MaxConcurrency: 2},
+		},
+		Exchanges: map[string]map[string]FetcherJobConfig{
+			"huobi": {FetcherJobAuthData: {Interval: HumanDuration(time.Minute), Timeout: HumanDuration(20 * time.Second)}},
+		},
+	}.WithDelay(FetcherDelay{AuthData: HumanDuration(5 * time.Second), OrderBook: HumanDuration(7 * time.Second)})
+
+	assert.Equal(t, FetcherJobConfig{
+		Interval:       HumanDuration(5 * time.Second),
+		Timeout:        HumanDuration(10 * time.Second),
+		MaxConcurrency: 2,
+	}, schedule.JobConfig(FetcherJobAuthData, "binance"))
+	assert.Equal(t, FetcherJobConfig{
+		Interval:       HumanDuration(time.Minute),
+		Timeout:        HumanDuration(20 * time.Second),
+		MaxConcurrency: 2,
+	}, schedule.JobConfig(FetcherJobAuthData, "huobi"))
+	assert.Equal(t, FetcherJobConfig{Interval: HumanDuration(7 * time.Second)}, schedule.JobConfig(FetcherJobOrderBook, ""))
+
+	assert.NoError(t, schedule.JobConfig(FetcherJobAuthData, "huobi").Validate())
+	assert.Error(t, FetcherJobConfig{Jitter: -1}.Validate())
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// contextTransport cancels requests in progress when its context is done.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+// cancelBody cancels the context of its request when the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func (t contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.ctx.Err(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// NewContextClient returns a HTTP client with the given timeout whose requests, including the ones
+// in progress, are cancelled once ctx is done. It is used to stop calls to exchanges and nodes on
+// shutdown.
+func NewContextClient(ctx context.Context, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: contextTransport{ctx: ctx, base: http.DefaultTransport},
+	}
+}
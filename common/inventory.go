@@ -0,0 +1,64 @@
+package common
+
+// InventoryConfig is the configuration of inventory snapshots.
+type InventoryConfig struct {
+	// SnapshotInterval is how often inventory reports are stored, zero disables snapshots.
+	SnapshotInterval HumanDuration `json:"snapshot_interval"`
+}
+
+// InventoryExchange is the balance of an asset on an exchange.
+type InventoryExchange struct {
+	ExchangeID uint64  `json:"exchange_id"`
+	Exchange   string  `json:"exchange"`
+	Available  float64 `json:"available"`
+	Locked     float64 `json:"locked"`
+	// Ratio is the share of the total inventory of the asset on the exchange.
+	Ratio       float64 `json:"ratio"`
+	TargetRatio float64 `json:"target_ratio"`
+	// RatioDeviation is ratio minus target ratio, zero if the exchange has no target ratio.
+	RatioDeviation float64 `json:"ratio_deviation"`
+}
+
+// InventoryAsset is the inventory of an asset on the reserve, exchanges and in pending transfers.
+type InventoryAsset struct {
+	AssetID uint64 `json:"asset_id"`
+	Symbol  string `json:"symbol"`
+	// Valid is false if a balance of the asset could not be fetched.
+	Valid     bool                `json:"valid"`
+	Reserve   float64             `json:"reserve"`
+	Exchanges []InventoryExchange `json:"exchanges"`
+	// PendingDeposit, PendingWithdraw and PendingTransfer are amounts in pending deposits to,
+	// withdrawals from and transfers between exchanges. Deposits are counted once their tx is mined.
+	PendingDeposit  float64 `json:"pending_deposit"`
+	PendingWithdraw float64 `json:"pending_withdraw"`
+	PendingTransfer float64 `json:"pending_transfer"`
+	Total           float64 `json:"total"`
+	ETHRate         float64 `json:"eth_rate"`
+	Value           Value   `json:"value"`
+	// Valued is false if the asset has no price in ETH, values are zero then.
+	Valued        bool    `json:"valued"`
+	TargetTotal   float64 `json:"target_total"`
+	TargetReserve float64 `json:"target_reserve"`
+	// TotalDeviation and ReserveDeviation are the ratios total and reserve balance are above
+	// (positive) or below (negative) their targets, zero if the asset has no target.
+	TotalDeviation   float64 `json:"total_deviation"`
+	ReserveDeviation float64 `json:"reserve_deviation"`
+	// Exposure is the value of total above or below its target.
+	Exposure Value `json:"exposure"`
+}
+
+// InventoryReport is the valued inventory of all assets at the time of an auth data snapshot.
+type InventoryReport struct {
+	Timestamp uint64           `json:"timestamp"`
+	USDRate   float64          `json:"usd_rate"`
+	Assets    []InventoryAsset `json:"assets"`
+	Total     struct {
+		Value       Value `json:"value"`
+		TargetValue Value `json:"target_value"`
+		// NetExposure is the sum of exposures, GrossExposure is the sum of their absolute values.
+		NetExposure   Value `json:"net_exposure"`
+		GrossExposure Value `json:"gross_exposure"`
+	} `json:"total"`
+	// Unvalued is symbols of assets without price in ETH, they are excluded from totals.
+	Unvalued []string `json:"unvalued"`
+}
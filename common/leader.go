@@ -0,0 +1,26 @@
+package common
+
+// LeaderConfig is the config of leader election between core instances sharing a database, only
+// the leader runs the fetcher, the storage controller and calls changing exchanges or the chain.
+type LeaderConfig struct {
+	// Enabled turns leader election on, without it the instance is always the leader.
+	Enabled bool `json:"enabled"`
+	// ID identifies the instance, it defaults to the hostname and process id.
+	ID string `json:"id"`
+	// LockKey is the key of the Postgres advisory lock held by the leader, instances of a reserve
+	// must use the same key.
+	LockKey uint32 `json:"lock_key"`
+	// Interval is how often followers try to take the lock and the leader checks it still holds it.
+	Interval HumanDuration `json:"interval"`
+}
+
+// LeaderStatus is the leadership of a core instance.
+type LeaderStatus struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+	Leader  bool   `json:"leader"`
+	// LeaderID is the id of the current leader, it is empty when there is no leader.
+	LeaderID string `json:"leader_id"`
+	// Since is the time the instance became the leader in milliseconds.
+	Since uint64 `json:"since,omitempty"`
+}
@@ -0,0 +1,258 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	// defaultLockKey is "KYBR" in ASCII.
+	defaultLockKey  uint32 = 0x4b594252
+	defaultInterval        = 5 * time.Second
+	// releaseTimeout is how long releasing the lock can take on shutdown.
+	releaseTimeout = 5 * time.Second
+)
+
+// ErrLeadershipLost is returned by Run when the leader no longer holds the lock, the jobs of the
+// leader must be stopped as another instance may take over.
+var ErrLeadershipLost = errors.New("leadership is lost")
+
+// Elector elects the leader of core instances sharing a Postgres database. The leader holds a
+// session level advisory lock on a dedicated connection, Postgres releases the lock once the
+// connection is lost so a follower takes over.
+type Elector struct {
+	db     *sqlx.DB
+	config common.LeaderConfig
+	l      *zap.SugaredLogger
+
+	mu     sync.RWMutex
+	leader bool
+	since  uint64
+	// conn is the connection holding the lock while the instance is the leader.
+	conn *sql.Conn
+}
+
+// NewElector creates an elector, unset settings of config are defaulted.
+func NewElector(db *sqlx.DB, config common.LeaderConfig) *Elector {
+	if config.ID == "" {
+		config.ID = defaultID()
+	}
+	if config.LockKey == 0 {
+		config.LockKey = defaultLockKey
+	}
+	if config.Interval <= 0 {
+		config.Interval = common.HumanDuration(defaultInterval)
+	}
+	return &Elector{
+		db:     db,
+		config: config,
+		l:      zap.S(),
+	}
+}
+
+func defaultID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// IsLeader returns true if the instance is the leader.
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+func (e *Elector) setLeader(leader bool, conn *sql.Conn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.leader = leader
+	e.conn = conn
+	e.since = 0
+	if leader {
+		e.since = common.NowInMillis()
+	}
+}
+
+// Run campaigns for the leadership until ctx is done, onElected is called once the instance
+// becomes the leader and its error is returned. Run returns ErrLeadershipLost if the lock is lost,
+// otherwise the lock is released once ctx is done. Without election enabled the instance is elected
+// immediately.
+func (e *Elector) Run(ctx context.Context, onElected func() error) error {
+	if !e.config.Enabled {
+		e.setLeader(true, nil)
+		if err := onElected(); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return nil
+	}
+
+	conn := e.campaign(ctx)
+	if conn == nil {
+		return nil
+	}
+	defer e.release(conn)
+	e.setLeader(true, conn)
+	e.l.Infow("instance is elected as the leader", "id", e.config.ID)
+	if err := onElected(); err != nil {
+		return err
+	}
+	return e.hold(ctx, conn)
+}
+
+// campaign tries to take the lock every interval until it succeeds, it returns the connection
+// holding the lock or nil if ctx is done.
+func (e *Elector) campaign(ctx context.Context) *sql.Conn {
+	ticker := time.NewTicker(time.Duration(e.config.Interval))
+	defer ticker.Stop()
+	for {
+		conn, err := e.tryLock(ctx)
+		switch {
+		case err != nil:
+			e.l.Warnw("failed to take leader lock", "id", e.config.ID, "err", err)
+		case conn != nil:
+			return conn
+		default:
+			e.l.Debugw("leader lock is held by another instance", "id", e.config.ID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryLock takes the lock on a new connection, the connection is named after the instance so the
+// leader can be found by followers. It returns nil if the lock is held by another instance.
+func (e *Elector) tryLock(ctx context.Context) (*sql.Conn, error) {
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked bool
+	if _, err = conn.ExecContext(ctx, `SELECT set_config('application_name', $1, FALSE)`, e.config.ID); err == nil {
+		err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, int64(e.config.LockKey)).Scan(&locked)
+	}
+	if err != nil || !locked {
+		if cErr := conn.Close(); cErr != nil {
+			e.l.Warnw("failed to close connection", "err", cErr)
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// hold checks the connection still holds the lock every interval until ctx is done.
+func (e *Elector) hold(ctx context.Context, conn *sql.Conn) error {
+	ticker := time.NewTicker(time.Duration(e.config.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		held, err := e.lockHeld(ctx, conn)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil || !held {
+			e.setLeader(false, nil)
+			e.l.Errorw("leader lock is lost", "id", e.config.ID, "err", err)
+			return ErrLeadershipLost
+		}
+	}
+}
+
+// lockHeld returns true if the connection holds the lock.
+func (e *Elector) lockHeld(ctx context.Context, conn *sql.Conn) (bool, error) {
+	var held bool
+	err := conn.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1
+FROM pg_locks
+WHERE locktype = 'advisory'
+  AND classid = 0
+  AND objid = $1
+  AND objsubid = 1
+  AND pid = pg_backend_pid()
+  AND granted)`, int64(e.config.LockKey)).Scan(&held)
+	return held, err
+}
+
+// CheckLeader returns true if the instance is the leader and, with election enabled, its
+// connection still holds the lock. IsLeader only notices a lost lock at the next interval, so
+// requests changing state are checked with CheckLeader before they run.
+func (e *Elector) CheckLeader(ctx context.Context) (bool, error) {
+	e.mu.RLock()
+	leader, conn := e.leader, e.conn
+	e.mu.RUnlock()
+	if !e.config.Enabled || !leader {
+		return leader, nil
+	}
+	held, err := e.lockHeld(ctx, conn)
+	if err != nil {
+		return false, err
+	}
+	if !held {
+		e.setLeader(false, nil)
+		e.l.Errorw("leader lock is lost", "id", e.config.ID)
+	}
+	return held, nil
+}
+
+// release releases the lock and closes its connection.
+func (e *Elector) release(conn *sql.Conn) {
+	e.setLeader(false, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, int64(e.config.LockKey)); err != nil {
+		e.l.Warnw("failed to release leader lock", "id", e.config.ID, "err", err)
+	}
+	if err := conn.Close(); err != nil {
+		e.l.Warnw("failed to close connection", "err", err)
+	}
+	e.l.Infow("leader lock is released", "id", e.config.ID)
+}
+
+// Status returns the leadership of the instance and the id of the current leader.
+func (e *Elector) Status(ctx context.Context) (common.LeaderStatus, error) {
+	e.mu.RLock()
+	status := common.LeaderStatus{
+		ID:      e.config.ID,
+		Enabled: e.config.Enabled,
+		Leader:  e.leader,
+		Since:   e.since,
+	}
+	e.mu.RUnlock()
+	if !e.config.Enabled || status.Leader {
+		if status.Leader {
+			status.LeaderID = status.ID
+		}
+		return status, nil
+	}
+	err := e.db.GetContext(ctx, &status.LeaderID, `SELECT a.application_name
+FROM pg_locks l
+       JOIN pg_stat_activity a ON a.pid = l.pid
+WHERE l.locktype = 'advisory'
+  AND l.classid = 0
+  AND l.objid = $1
+  AND l.objsubid = 1
+  AND l.granted`, int64(e.config.LockKey))
+	if err != nil && err != sql.ErrNoRows {
+		return common.LeaderStatus{}, err
+	}
+	return status, nil
+}
@@ -0,0 +1,108 @@
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+)
+
+func TestElectorDisabled(t *testing.T) {
+	elector := NewElector(nil, common.LeaderConfig{ID: "core-1"})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- elector.Run(ctx, func() error { return nil })
+	}()
+	require.Eventually(t, elector.IsLeader, time.Second, time.Millisecond)
+
+	status, err := elector.Status(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.Enabled)
+	assert.Equal(t, "core-1", status.LeaderID)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestElectorTakeover(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+
+	var (
+		config = common.LeaderConfig{Enabled: true, LockKey: 42, Interval: common.HumanDuration(10 * time.Millisecond)}
+		run    = func(id string) (*Elector, context.CancelFunc, chan error) {
+			config.ID = id
+			elector := NewElector(db, config)
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() {
+				done <- elector.Run(ctx, func() error { return nil })
+			}()
+			return elector, cancel, done
+		}
+	)
+
+	first, cancelFirst, firstDone := run("core-1")
+	require.Eventually(t, first.IsLeader, time.Second, time.Millisecond)
+	second, cancelSecond, secondDone := run("core-2")
+	defer cancelSecond()
+
+	// the second instance stays a follower while the first holds the lock
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, second.IsLeader())
+	status, err := second.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "core-2", status.ID)
+	assert.False(t, status.Leader)
+	assert.Equal(t, "core-1", status.LeaderID)
+
+	cancelFirst()
+	require.NoError(t, <-firstDone)
+	assert.False(t, first.IsLeader())
+	require.Eventually(t, second.IsLeader, time.Second, time.Millisecond)
+	status, err = first.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "core-2", status.LeaderID)
+
+	cancelSecond()
+	require.NoError(t, <-secondDone)
+}
+
+func TestElectorCheckLeaderLockLost(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+
+	// the lock is checked on every request, not only every interval
+	elector := NewElector(db, common.LeaderConfig{Enabled: true, ID: "core-1", LockKey: 43, Interval: common.HumanDuration(time.Hour)})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = elector.Run(ctx, func() error { return nil })
+	}()
+	require.Eventually(t, elector.IsLeader, time.Second, time.Millisecond)
+	leader, err := elector.CheckLeader(context.Background())
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	// the lock is released behind the back of the elector
+	elector.mu.RLock()
+	conn := elector.conn
+	elector.mu.RUnlock()
+	_, err = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, int64(43))
+	require.NoError(t, err)
+
+	leader, err = elector.CheckLeader(context.Background())
+	require.NoError(t, err)
+	assert.False(t, leader)
+	assert.False(t, elector.IsLeader())
+}
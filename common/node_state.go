@@ -0,0 +1,22 @@
+package common
+
+// NodeState is the health of an Ethereum node in the node pool.
+type NodeState struct {
+	// Name is the scheme and host of the node URL, paths often contain API keys.
+	Name string `json:"name"`
+	// Head is the latest block number of the node.
+	Head uint64 `json:"head"`
+	// Lag is the number of blocks the node is behind the highest head of the pool.
+	Lag uint64 `json:"lag"`
+	// LatencyMillis is the moving average of request latency.
+	LatencyMillis int64 `json:"latency_ms"`
+	// ErrorRate is the moving average ratio of failed requests.
+	ErrorRate float64 `json:"error_rate"`
+	Requests  uint64  `json:"requests"`
+	LastError string  `json:"last_error,omitempty"`
+	// LastCheck is the time of the last head check in millisecond.
+	LastCheck uint64 `json:"last_check"`
+	Healthy   bool   `json:"healthy"`
+	// Sticky is true for the node nonces and receipts are queried from.
+	Sticky bool `json:"sticky"`
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+)
+
+// OnChainIndexerConfig is the configuration of the indexer of reserve contract trade logs.
+type OnChainIndexerConfig struct {
+	// StartBlock is the first block logs are indexed from, zero disables the indexer.
+	StartBlock uint64 `json:"start_block"`
+	// Interval is how often new blocks are indexed.
+	Interval HumanDuration `json:"interval"`
+	// Confirmations is the number of blocks behind the head logs are indexed to.
+	Confirmations uint64 `json:"confirmations"`
+	// BatchSize is the max number of blocks logs are requested for at once.
+	BatchSize uint64 `json:"batch_size"`
+}
+
+// OnChainTrade is a TradeExecute log of the reserve contract.
+type OnChainTrade struct {
+	BlockNumber uint64           `json:"block_number"`
+	BlockHash   ethereum.Hash    `json:"block_hash"`
+	TxHash      ethereum.Hash    `json:"tx_hash"`
+	LogIndex    uint             `json:"log_index"`
+	Timestamp   uint64           `json:"timestamp"`
+	Origin      ethereum.Address `json:"origin"`
+	SrcToken    ethereum.Address `json:"src_token"`
+	SrcAmount   *big.Int         `json:"src_amount"`
+	DestToken   ethereum.Address `json:"dest_token"`
+	DestAmount  *big.Int         `json:"dest_amount"`
+	DestAddress ethereum.Address `json:"dest_address"`
+}
+
+// OnChainAssetStats is volume, fill rates and inventory flow of trades of an asset with the
+// reserve. Buy and sell are from the side of users, rates are token per ETH for buys and ETH per
+// token for sells as set to the pricing contract.
+type OnChainAssetStats struct {
+	AssetID uint64 `json:"asset_id"`
+	Asset   string `json:"asset"`
+	Trades  int    `json:"trades"`
+	// BuyVolume and SellVolume are amounts of the asset users bought from and sold to the reserve.
+	BuyVolume    float64 `json:"buy_volume"`
+	SellVolume   float64 `json:"sell_volume"`
+	BuyETHVolume float64 `json:"buy_eth_volume"`
+	// SellETHVolume is the amount of ETH users received for the sold asset.
+	SellETHVolume float64 `json:"sell_eth_volume"`
+	// NetFlow is the change of the reserve inventory of the asset, negative if users bought more
+	// than they sold.
+	NetFlow     float64 `json:"net_flow"`
+	AvgBuyRate  float64 `json:"avg_buy_rate"`
+	AvgSellRate float64 `json:"avg_sell_rate"`
+	// AvgSetBuyRate and AvgSetSellRate are volume weighted rates set to the pricing contract
+	// when trades were made.
+	AvgSetBuyRate  float64 `json:"avg_set_buy_rate"`
+	AvgSetSellRate float64 `json:"avg_set_sell_rate"`
+	// BuyRateDeviation and SellRateDeviation are the ratio users received more (positive) or
+	// less (negative) than at set rates, e.g -0.002 for 0.2% less.
+	BuyRateDeviation  float64 `json:"buy_rate_deviation"`
+	SellRateDeviation float64 `json:"sell_rate_deviation"`
+	// Unrated is the number of trades no set rate was found for, they are not in set rates and
+	// deviations.
+	Unrated int `json:"unrated"`
+}
+
+// OnChainTradeStats is the summary of trades with the reserve contract in a time range.
+type OnChainTradeStats struct {
+	FromTime uint64 `json:"from_time"`
+	ToTime   uint64 `json:"to_time"`
+	// IndexedBlock is the last block trade logs are indexed to.
+	IndexedBlock uint64              `json:"indexed_block"`
+	Assets       []OnChainAssetStats `json:"assets"`
+	// ETHNetFlow is the change of the reserve ETH inventory.
+	ETHNetFlow float64 `json:"eth_net_flow"`
+	// UnknownTrades is the number of trades of tokens which are not listed assets.
+	UnknownTrades int `json:"unknown_trades"`
+}
@@ -0,0 +1,36 @@
+package common
+
+// OpenOrder is an order which is still open on a centralized exchange.
+type OpenOrder struct {
+	ID             string     `json:"id"`
+	Exchange       ExchangeID `json:"exchange"`
+	PairID         uint64     `json:"pair_id"`
+	Base           string     `json:"base"`
+	Quote          string     `json:"quote"`
+	Side           string     `json:"side"`
+	Price          float64    `json:"price"`
+	Amount         float64    `json:"amount"`
+	ExecutedAmount float64    `json:"executed_amount"`
+	// Time is the creation time of the order in milliseconds.
+	Time uint64 `json:"time"`
+	// ActivityID is id of the trade activity placed the order, it is nil for orphaned orders.
+	ActivityID *ActivityID `json:"activity_id,omitempty"`
+	// Orphaned is true if the order is not tracked by any pending trade activity.
+	Orphaned bool `json:"orphaned"`
+}
+
+// OpenOrderExchange is implemented by exchanges which can list orders open on the exchange.
+type OpenOrderExchange interface {
+	OpenOrders() ([]OpenOrder, error)
+}
+
+// StaleOrderPolicy is the policy to cancel stale open orders automatically.
+type StaleOrderPolicy struct {
+	// Interval is how often open orders are checked, zero disables the policy.
+	Interval HumanDuration `json:"interval"`
+	// MaxAge cancels orders which are open longer than it, zero disables the check.
+	MaxAge HumanDuration `json:"max_age"`
+	// MaxPriceDeviation cancels orders which price deviates from mid market price by more than
+	// this ratio, e.g 0.05 for 5%, zero disables the check.
+	MaxPriceDeviation float64 `json:"max_price_deviation"`
+}
@@ -0,0 +1,60 @@
+package common
+
+// Groups of PnL report.
+const (
+	// PnLGroupByAsset reports PnL of an asset across all exchanges.
+	PnLGroupByAsset = "asset"
+	// PnLGroupByExchange reports PnL of an asset on each exchange.
+	PnLGroupByExchange = "exchange"
+)
+
+// Value is an amount valued in ETH and USD.
+type Value struct {
+	ETH float64 `json:"eth"`
+	USD float64 `json:"usd"`
+}
+
+// AssetPnL is the realised PnL and volume of an asset traded in a period.
+type AssetPnL struct {
+	// Exchange is empty if PnL is grouped by asset.
+	Exchange string  `json:"exchange,omitempty"`
+	Asset    string  `json:"asset"`
+	Trades   int     `json:"trades"`
+	Bought   float64 `json:"bought"`
+	Sold     float64 `json:"sold"`
+	Volume   Value   `json:"volume"`
+	// FIFO is the realised PnL matching sells with the earliest buys.
+	FIFO Value `json:"fifo"`
+	// AverageCost is the realised PnL of sells at the average cost of holdings.
+	AverageCost Value `json:"average_cost"`
+	// Unmatched is the quantity sold without buys in the period, it is not in realised PnL.
+	Unmatched float64 `json:"unmatched"`
+}
+
+// FeePaid is the commission paid on an exchange in an asset.
+type FeePaid struct {
+	Exchange string  `json:"exchange"`
+	Asset    string  `json:"asset"`
+	Amount   float64 `json:"amount"`
+	Value    Value   `json:"value"`
+	// Valued is false if the asset has no price in ETH, value is zero then.
+	Valued bool `json:"valued"`
+}
+
+// PnLReport is the realised PnL, volume and fees of trades in a period.
+type PnLReport struct {
+	FromTime uint64     `json:"from_time"`
+	ToTime   uint64     `json:"to_time"`
+	GroupBy  string     `json:"group_by"`
+	Assets   []AssetPnL `json:"assets"`
+	Fees     []FeePaid  `json:"fees"`
+	Total    struct {
+		Volume      Value `json:"volume"`
+		FIFO        Value `json:"fifo"`
+		AverageCost Value `json:"average_cost"`
+		Fees        Value `json:"fees"`
+	} `json:"total"`
+	// UnvaluedTrades is the number of trades which quote asset has no price in ETH, they are
+	// excluded from PnL and volume.
+	UnvaluedTrades int `json:"unvalued_trades"`
+}
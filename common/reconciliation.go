@@ -0,0 +1,75 @@
+package common
+
+// Types of transfers between reserve and exchanges.
+const (
+	TransferTypeDeposit  = "deposit"
+	TransferTypeWithdraw = "withdraw"
+)
+
+// Kinds of reconciliation items.
+const (
+	// ReconciliationUnmatched is a transfer on exchange without a matching activity.
+	ReconciliationUnmatched = "unmatched"
+	// ReconciliationMismatched is a transfer which amount or status differs from its activity.
+	ReconciliationMismatched = "mismatched"
+	// ReconciliationLate is an activity which transfer is not found or not done on exchange in time.
+	ReconciliationLate = "late"
+)
+
+// ExchangeTransfer is a deposit or withdrawal in history of an exchange.
+type ExchangeTransfer struct {
+	Type string `json:"type"`
+	// ID is the id of the transfer on exchange, withdraw activities keep it as result id.
+	ID     string  `json:"id"`
+	TxHash string  `json:"tx_hash"`
+	Asset  string  `json:"asset"`
+	Amount float64 `json:"amount"`
+	// Status is pending, done or failed.
+	Status string `json:"status"`
+	// Timestamp is the creation time of the transfer in millisecond.
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// TransferHistoryExchange is implemented by exchanges which can list deposits and withdrawals
+// made in a time range.
+type TransferHistoryExchange interface {
+	TransferHistory(fromTime, toTime uint64) ([]ExchangeTransfer, error)
+}
+
+// ReconciliationPolicy is the configuration of periodic transfer reconciliation.
+type ReconciliationPolicy struct {
+	// Interval is how often transfers are reconciled, zero disables the job.
+	Interval HumanDuration `json:"interval"`
+	// Window is the time range before now which transfers are reconciled.
+	Window HumanDuration `json:"window"`
+	// LateAfter is how long a transfer can take to be done on exchange before it is late.
+	LateAfter HumanDuration `json:"late_after"`
+	// AmountTolerance is the ratio amounts of an activity and its transfer can differ by,
+	// e.g 0.001 for 0.1%.
+	AmountTolerance float64 `json:"amount_tolerance"`
+	// AlertWebhook receives new reconciliation items as JSON if it is set, items are always
+	// logged as errors.
+	AlertWebhook string `json:"alert_webhook"`
+}
+
+// ReconciliationItem is a transfer or an activity which does not reconcile.
+type ReconciliationItem struct {
+	Kind     string            `json:"kind"`
+	Type     string            `json:"type"`
+	Asset    string            `json:"asset"`
+	Transfer *ExchangeTransfer `json:"transfer,omitempty"`
+	// ActivityID is nil for unmatched transfers.
+	ActivityID     *ActivityID `json:"activity_id,omitempty"`
+	ActivityAmount float64     `json:"activity_amount,omitempty"`
+	Detail         string      `json:"detail"`
+}
+
+// ReconciliationReport is the result of reconciling transfers of an exchange in a time range.
+type ReconciliationReport struct {
+	Exchange  string               `json:"exchange"`
+	FromTime  uint64               `json:"from_time"`
+	ToTime    uint64               `json:"to_time"`
+	Timestamp uint64               `json:"timestamp"`
+	Matched   int                  `json:"matched"`
+	Items     []ReconciliationItem `json:"items"`
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// AssetRiskLimitUtilisation is the risk limit of an asset on an exchange together with
+// its current utilisation in the last 24 hours.
+type AssetRiskLimitUtilisation struct {
+	common.RiskLimit
+	DailyTradeVolume    float64 `json:"daily_trade_volume"`
+	DailyWithdrawAmount float64 `json:"daily_withdraw_amount"`
+	DailyDepositAmount  float64 `json:"daily_deposit_amount"`
+}
+
+// ExchangeRiskLimitUtilisation is the open orders limit of an exchange and the number of
+// orders currently open.
+type ExchangeRiskLimitUtilisation struct {
+	ExchangeID    uint64 `json:"exchange_id"`
+	MaxOpenOrders uint64 `json:"max_open_orders"`
+	OpenOrders    uint64 `json:"open_orders"`
+}
+
+// RiskLimitUtilisation is the current utilisation of all configured risk limits.
+type RiskLimitUtilisation struct {
+	Assets    []AssetRiskLimitUtilisation    `json:"assets"`
+	Exchanges []ExchangeRiskLimitUtilisation `json:"exchanges"`
+}
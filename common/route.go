@@ -0,0 +1,36 @@
+package common
+
+import (
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// RouteVenue is an exchange listing the trading pair with its latest order book, taker fee and
+// available balance of the asset spent by the order.
+type RouteVenue struct {
+	Exchange  Exchange
+	Pair      common.TradingPairSymbols
+	Book      ExchangePrice
+	TakerFee  float64
+	Available float64
+}
+
+// RouteFill is the part of the order routed to an exchange.
+type RouteFill struct {
+	Exchange ExchangeID `json:"exchange"`
+	PairID   uint64     `json:"pair_id"`
+	Amount   float64    `json:"amount"`
+	// Rate is the price of the worst order book level the fill takes, child trade is placed at it.
+	Rate float64 `json:"rate"`
+	// AveragePrice is the expected average price including taker fee.
+	AveragePrice float64     `json:"average_price"`
+	ActivityID   *ActivityID `json:"activity_id,omitempty"`
+	Error        string      `json:"error,omitempty"`
+}
+
+// RoutePlan is the split of an order across exchanges listing its trading pair.
+type RoutePlan struct {
+	Side   string      `json:"side"`
+	Amount float64     `json:"amount"`
+	Routed float64     `json:"routed"`
+	Fills  []RouteFill `json:"fills"`
+}
@@ -0,0 +1,42 @@
+package common
+
+// SimulationConfig configures simulated exchanges which replace real exchanges in simulation deployment.
+type SimulationConfig struct {
+	// Seed of the random source of synthetic order books and failure injection.
+	Seed int64 `json:"seed"`
+	// Exchanges is the configuration of each simulated exchange by exchange name.
+	Exchanges map[string]SimulatedExchangeConfig `json:"exchanges"`
+}
+
+// SimulatedExchangeConfig is the configuration of a simulated exchange.
+type SimulatedExchangeConfig struct {
+	// Balances is the initial available balance of assets by asset id.
+	Balances map[AssetID]float64 `json:"balances"`
+	// Books is the synthetic order book of trading pairs by trading pair id, pairs without a
+	// synthetic book only have orders placed by core.
+	Books map[uint64]SyntheticBook `json:"books"`
+	// TradingFee is the ratio of received asset charged for every fill.
+	TradingFee float64 `json:"trading_fee"`
+	// DepositDelay is the time a deposit takes to be credited after it is first seen.
+	DepositDelay HumanDuration `json:"deposit_delay"`
+	// WithdrawDelay is the time a withdrawal takes to be done.
+	WithdrawDelay HumanDuration `json:"withdraw_delay"`
+	// FailureRate is the ratio of trade and withdraw requests rejected with an error.
+	FailureRate float64 `json:"failure_rate"`
+	// TransferFailureRate is the ratio of deposits and withdrawals which end up failed.
+	TransferFailureRate float64 `json:"transfer_failure_rate"`
+}
+
+// SyntheticBook generates liquidity of a trading pair around a mid price which moves randomly
+// every time order books are fetched.
+type SyntheticBook struct {
+	MidPrice float64 `json:"mid_price"`
+	// Spread is the ratio between best ask and best bid, also the ratio between two levels.
+	Spread float64 `json:"spread"`
+	// Levels is the number of price levels of each side.
+	Levels int `json:"levels"`
+	// Quantity is the amount of base asset of each level.
+	Quantity float64 `json:"quantity"`
+	// Volatility is the standard deviation of the relative change of mid price per fetch.
+	Volatility float64 `json:"volatility"`
+}
@@ -245,6 +245,8 @@ type ActivityParams struct {
 	Asset     uint64     `json:"asset,omitempty"`
 	Amount    float64    `json:"amount,omitempty"`
 	Timepoint uint64     `json:"timepoint,omitempty"`
+	// transfer params, Exchange is the exchange funds are withdrawn from
+	DestinationExchange ExchangeID `json:"destination_exchange,omitempty"`
 	// SetRates params
 	Assets []uint64   `json:"assets,omitempty"` // list of asset id
 	Buys   []*big.Int `json:"buys,omitempty"`
@@ -257,6 +259,8 @@ type ActivityParams struct {
 	Base  string  `json:"base,omitempty"`
 	Quote string  `json:"quote,omitempty"`
 	Rate  float64 `json:"rate,omitempty"`
+	// Parent is id of the execution activity a child trade is placed by
+	Parent *ActivityID `json:"parent,omitempty"`
 }
 
 // ActivityResult is result of an activity
@@ -274,6 +278,8 @@ type ActivityResult struct {
 	//
 	StatusError string `json:"status_error,omitempty"`
 	BlockNumber uint64 `json:"blockNumber,omitempty"`
+	// progress of execution, saved to resume it after a restart
+	Execution *Execution `json:"execution,omitempty"`
 }
 
 //NewActivityRecord return an activity record
@@ -290,51 +296,6 @@ func NewActivityRecord(action string, id ActivityID, destination string, params
 	}
 }
 
-// IsExchangePending return true if activity is pending on centralized exchange
-func (ar ActivityRecord) IsExchangePending() bool {
-	switch ar.Action {
-	case ActionWithdraw:
-		return (ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusSubmitted) &&
-			ar.MiningStatus != MiningStatusFailed
-	case ActionDeposit:
-		return (ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusPending) &&
-			ar.MiningStatus != MiningStatusFailed
-	case ActionTrade:
-		return ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusSubmitted
-	}
-	return true
-}
-
-// IsBlockchainPending return true if activity is pending on blockchain
-func (ar ActivityRecord) IsBlockchainPending() bool {
-	switch ar.Action {
-	case ActionWithdraw, ActionDeposit, ActionSetRate:
-		return (ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted) && ar.ExchangeStatus != ExchangeStatusFailed
-	}
-	return true
-}
-
-// IsPending return true if activity is pending
-func (ar ActivityRecord) IsPending() bool {
-	switch ar.Action {
-	case ActionWithdraw:
-		return (ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusSubmitted ||
-			ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted) &&
-			ar.MiningStatus != MiningStatusFailed && ar.ExchangeStatus != ExchangeStatusFailed
-	case ActionDeposit:
-		return (ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusPending ||
-			ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted) &&
-			ar.MiningStatus != MiningStatusFailed && ar.ExchangeStatus != ExchangeStatusFailed
-	case ActionTrade:
-		return (ar.ExchangeStatus == "" || ar.ExchangeStatus == ExchangeStatusSubmitted) &&
-			ar.ExchangeStatus != ExchangeStatusFailed
-	case ActionSetRate:
-		return (ar.MiningStatus == "" || ar.MiningStatus == MiningStatusSubmitted) &&
-			ar.ExchangeStatus != ExchangeStatusFailed
-	}
-	return true
-}
-
 // ActivityStatus is status of an activity
 type ActivityStatus struct {
 	ExchangeStatus string
@@ -547,9 +508,11 @@ type AuthdataBalance struct {
 
 //PendingActivities is pending activities for authdata
 type PendingActivities struct {
-	SetRates []ActivityRecord `json:"set_rates"`
-	Withdraw []ActivityRecord `json:"withdraw"`
-	Deposit  []ActivityRecord `json:"deposit"`
+	SetRates        []ActivityRecord `json:"set_rates"`
+	Withdraw        []ActivityRecord `json:"withdraw"`
+	Deposit         []ActivityRecord `json:"deposit"`
+	ReserveWithdraw []ActivityRecord `json:"reserve_withdraw"`
+	Transfer        []ActivityRecord `json:"transfer"`
 }
 
 // AuthDataResponseV3 is auth data format for reservesetting
@@ -638,6 +601,13 @@ type TradeHistory struct {
 	Qty       float64
 	Type      string // buy or sell
 	Timestamp uint64
+	// OrderID is the id of the order the trade fills
+	OrderID string `json:",omitempty"`
+	// Fee is the commission of the trade paid in FeeAsset
+	Fee      float64 `json:",omitempty"`
+	FeeAsset string  `json:",omitempty"`
+	// Maker is true if the order of the trade was resting on the order book
+	Maker bool `json:",omitempty"`
 }
 
 // NewTradeHistory creates a new TradeHistory instance.
@@ -652,6 +622,15 @@ func NewTradeHistory(id string, price, qty float64, typ string, timestamp uint64
 	}
 }
 
+// WithCommission returns the trade history with its order and commission.
+func (th TradeHistory) WithCommission(orderID string, fee float64, feeAsset string, maker bool) TradeHistory {
+	th.OrderID = orderID
+	th.Fee = fee
+	th.FeeAsset = feeAsset
+	th.Maker = maker
+	return th
+}
+
 type ExchangeTradeHistory map[uint64][]TradeHistory
 
 type AllTradeHistory struct {
@@ -724,6 +703,11 @@ type ExchangeEndpoints struct {
 type Nodes struct {
 	Main   string   `json:"main"`
 	Backup []string `json:"backup"`
+	// MaxBlockLag is the number of blocks a node can be behind the highest head of nodes
+	// and still serve requests.
+	MaxBlockLag uint64 `json:"max_block_lag"`
+	// CheckInterval is how often heads of nodes are checked.
+	CheckInterval HumanDuration `json:"check_interval"`
 }
 
 // HumanDuration ...
@@ -742,6 +726,11 @@ func (d *HumanDuration) UnmarshalJSON(text []byte) error {
 	return nil
 }
 
+// MarshalJSON ...
+func (d HumanDuration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(time.Duration(d).String())), nil
+}
+
 // FetcherDelay ...
 type FetcherDelay struct {
 	OrderBook     HumanDuration `json:"order_book"`
@@ -752,14 +741,32 @@ type FetcherDelay struct {
 	TradeHistory  HumanDuration `json:"trade_history"`
 }
 
+// ExchangeCredentials is the API key of an exchange account. Huobi accounts also have their own
+// intermediator account which deposits are sent through.
+type ExchangeCredentials struct {
+	Key    string `json:"key"`
+	Secret string `json:"secret"`
+
+	IntermediatorKeystore   string `json:"keystore_intermediator_path"`
+	IntermediatorPassphrase string `json:"passphrase_intermediate_account"`
+}
+
 // RawConfig include all configs read from files
 type RawConfig struct {
-	AWSConfig         archive.AWSConfig `json:"aws_config"`
-	WorldEndpoints    WorldEndpoints    `json:"world_endpoints"`
-	ContractAddresses ContractAddresses `json:"contract_addresses"`
-	ExchangeEndpoints ExchangeEndpoints `json:"exchange_endpoints"`
-	Nodes             Nodes             `json:"nodes"`
-	FetcherDelay      FetcherDelay      `json:"fetcher_delay"`
+	AWSConfig         archive.AWSConfig     `json:"aws_config"`
+	WorldEndpoints    WorldEndpoints        `json:"world_endpoints"`
+	ContractAddresses ContractAddresses     `json:"contract_addresses"`
+	ExchangeEndpoints ExchangeEndpoints     `json:"exchange_endpoints"`
+	Nodes             Nodes                 `json:"nodes"`
+	FetcherDelay      FetcherDelay          `json:"fetcher_delay"`
+	FetcherSchedule   FetcherScheduleConfig `json:"fetcher_schedule"`
+	StaleOrderPolicy  StaleOrderPolicy      `json:"stale_order_policy"`
+	ActivityLifetime  ActivityLifetime      `json:"activity_lifetime"`
+	Reconciliation    ReconciliationPolicy  `json:"reconciliation"`
+	OnChainIndexer    OnChainIndexerConfig  `json:"onchain_indexer"`
+	Inventory         InventoryConfig       `json:"inventory"`
+	Simulation        SimulationConfig      `json:"simulation"`
+	Leader            LeaderConfig          `json:"leader"`
 
 	HTTPAPIAddr string `json:"http_api_addr"`
 
@@ -772,8 +779,13 @@ type RawConfig struct {
 	BinanceSecret  string `json:"binance_secret"`
 	Binance2Key    string `json:"binance_2_key"`
 	Binance2Secret string `json:"binance_2_secret"`
-	HoubiKey       string `json:"huobi_key"`
-	HoubiSecret    string `json:"huobi_secret"`
+	// Binance2Email is email of binance_2 account if it is a sub account of binance account,
+	// funds can be transferred between them instantly.
+	Binance2Email string `json:"binance_2_email"`
+	HoubiKey      string `json:"huobi_key"`
+	HoubiSecret   string `json:"huobi_secret"`
+	// ExchangeCredentials are API keys of exchange accounts by the credentials_ref of the accounts.
+	ExchangeCredentials map[string]ExchangeCredentials `json:"exchange_credentials"`
 
 	IntermediatorKeystore   string `json:"keystore_intermediator_path"`
 	IntermediatorPassphrase string `json:"passphrase_intermediate_account"`
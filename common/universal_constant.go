@@ -11,8 +11,13 @@ const (
 	ExchangeStatusFailed    = "failed"
 	ExchangeStatusSubmitted = "submitted"
 	ExchangeStatusLost      = "lost"
+	ExchangeStatusCancelled = "cancelled"
 	ActionDeposit           = "deposit"
 	ActionTrade             = "trade"
 	ActionWithdraw          = "withdraw"
 	ActionSetRate           = "set_rates"
+	ActionReserveWithdraw   = "reserve_withdraw"
+	ActionTransfer          = "transfer"
+	ActionInternalTransfer  = "internal_transfer"
+	ActionExecution         = "execution"
 )
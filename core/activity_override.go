@@ -0,0 +1,25 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// OverrideActivity resolves a pending activity with the status and amounts given by an
+// operator, the change is recorded in the activity timeline with its reason. The activity is
+// resolved while it is locked so concurrent updates of fetcher are not lost. Running executions
+// are rejected as they update their activity when they finish, they should be cancelled instead.
+func (rc ReserveCore) OverrideActivity(id common.ActivityID, override common.ActivityOverride) (common.ActivityRecord, error) {
+	if e, ok := rc.executions.get(id); ok && e.get().Status == common.ExecutionStatusRunning {
+		return common.ActivityRecord{}, fmt.Errorf("execution %s is running, cancel it instead", id.String())
+	}
+	update := common.ActivityUpdate{Source: common.ActivitySourceOperator, Reason: override.Reason}
+	resolved, err := rc.activityStorage.ModifyActivity(id, update, override.Apply)
+	if err != nil {
+		return common.ActivityRecord{}, err
+	}
+	rc.l.Infow("activity overridden by operator", "id", id.String(), "status", override.Status,
+		"reason", override.Reason)
+	return resolved, nil
+}
@@ -19,6 +19,13 @@ type ActivityStorage interface {
 	HasPendingDeposit(token commonv3.Asset, exchange common.Exchange) (bool, error)
 
 	GetActivity(id common.ActivityID) (common.ActivityRecord, error)
+	GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
+	GetPendingActivities() ([]common.ActivityRecord, error)
+	// UpdateActivity updates the activity after validating the change of its statuses.
+	UpdateActivity(id common.ActivityID, activity common.ActivityRecord, update common.ActivityUpdate) error
+	// ModifyActivity applies modify on the activity and updates it while the activity is locked.
+	ModifyActivity(id common.ActivityID, update common.ActivityUpdate,
+		modify func(common.ActivityRecord) (common.ActivityRecord, error)) (common.ActivityRecord, error)
 
 	// PendingSetRate return the last pending set rate and number of pending
 	// transactions.
@@ -0,0 +1,632 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	// defaultExecutionPollInterval is how often child orders of executions are checked.
+	defaultExecutionPollInterval = 5 * time.Second
+	// defaultTWAPSlices is the number of child orders of TWAP order without slice size.
+	defaultTWAPSlices = 10
+	executionEpsilon  = 0.0000000001 // 10e-10
+)
+
+var (
+	// ErrExecutionNotFound is returned when the execution does not exist.
+	ErrExecutionNotFound = errors.New("execution not found")
+	// ErrExecutionsNotRunning is returned when an execution is started before the instance runs
+	// executions as the leader.
+	ErrExecutionsNotRunning = errors.New("executions are not running on this instance")
+
+	errExecutionResolved = errors.New("execution activity is already resolved")
+)
+
+// orderQuerier is implemented by exchanges which can return filled amount and status of an order.
+type orderQuerier interface {
+	QueryOrder(symbol string, id uint64) (done float64, remaining float64, status string, err error)
+}
+
+// execution is a running parent order, state is only modified by the goroutine working the
+// order and published to snapshot after every step. saved is the state last saved with the
+// activity of the execution.
+type execution struct {
+	state    common.Execution
+	exchange common.Exchange
+	querier  orderQuerier
+	pair     commonv3.TradingPairSymbols
+	cancel   chan struct{}
+	once     sync.Once
+	saved    common.Execution
+
+	mu       sync.RWMutex
+	snapshot common.Execution
+}
+
+func (e *execution) copyState() common.Execution {
+	state := e.state
+	state.Children = append([]common.ChildOrder{}, e.state.Children...)
+	return state
+}
+
+func (e *execution) publish() {
+	snapshot := e.copyState()
+	e.mu.Lock()
+	e.snapshot = snapshot
+	e.mu.Unlock()
+}
+
+// changed returns true if child orders of the execution changed since it was saved.
+func (e *execution) changed() bool {
+	if len(e.saved.Children) != len(e.state.Children) {
+		return true
+	}
+	for i, child := range e.state.Children {
+		if child != e.saved.Children[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *execution) stop() {
+	e.once.Do(func() {
+		close(e.cancel)
+	})
+}
+
+func (e *execution) get() common.Execution {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.snapshot
+}
+
+func (e *execution) openChild() *common.ChildOrder {
+	for i := range e.state.Children {
+		if e.state.Children[i].Open {
+			return &e.state.Children[i]
+		}
+	}
+	return nil
+}
+
+func (e *execution) filled() float64 {
+	var filled float64
+	for _, child := range e.state.Children {
+		filled += child.Filled
+	}
+	return filled
+}
+
+type executionManager struct {
+	mu           sync.RWMutex
+	executions   map[common.ActivityID]*execution
+	pollInterval time.Duration
+	// ctx stops working executions, it is nil when the instance does not run executions.
+	ctx context.Context
+	wg  sync.WaitGroup
+}
+
+func newExecutionManager() *executionManager {
+	return &executionManager{
+		executions:   make(map[common.ActivityID]*execution),
+		pollInterval: defaultExecutionPollInterval,
+	}
+}
+
+func (em *executionManager) get(id common.ActivityID) (*execution, bool) {
+	em.mu.RLock()
+	defer em.mu.RUnlock()
+	e, ok := em.executions[id]
+	return e, ok
+}
+
+func (em *executionManager) remove(id common.ActivityID) {
+	em.mu.Lock()
+	defer em.mu.Unlock()
+	delete(em.executions, id)
+}
+
+// roundAmount rounds amount down to precision decimals.
+func roundAmount(amount float64, precision uint64) float64 {
+	exp := math.Pow10(int(precision))
+	return math.Floor(amount*exp+executionEpsilon) / exp
+}
+
+func validateParentOrder(order common.ParentOrder) error {
+	if order.Side != "buy" && order.Side != "sell" {
+		return fmt.Errorf("side %s is not supported", order.Side)
+	}
+	if order.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if order.LimitPrice <= 0 {
+		return errors.New("limit price must be positive")
+	}
+	if order.SliceSize < 0 || order.SliceSize > order.Amount {
+		return errors.New("slice size must be positive and not bigger than amount")
+	}
+	switch order.Algorithm {
+	case common.ExecutionAlgorithmTWAP:
+		if order.Duration <= 0 {
+			return errors.New("duration is required for twap order")
+		}
+	case common.ExecutionAlgorithmIceberg:
+		if order.SliceSize == 0 {
+			return errors.New("slice size is required for iceberg order")
+		}
+	default:
+		return fmt.Errorf("algorithm %s is not supported", order.Algorithm)
+	}
+	return nil
+}
+
+func (rc ReserveCore) recordExecution(e common.Execution, status string, err error) error {
+	rc.l.Infof("Core ----------> %s %s on %s: base: %s, quote: %s, limit price: %s, amount: %s ==> Result: id: %s, error: %v",
+		e.Algorithm, e.Side, e.Exchange.String(), e.Base, e.Quote,
+		formatFloat(e.LimitPrice), formatFloat(e.Amount), e.ID, err,
+	)
+	activityResult := common.ActivityResult{Execution: &e}
+	if err != nil {
+		activityResult.Error = err.Error()
+	}
+	return rc.activityStorage.Record(
+		common.ActionExecution,
+		e.ID,
+		e.Exchange.String(),
+		common.ActivityParams{
+			Exchange:  e.Exchange,
+			Type:      e.Side,
+			Base:      e.Base,
+			Quote:     e.Quote,
+			Rate:      e.LimitPrice,
+			Amount:    e.Amount,
+			Timepoint: e.Created,
+		},
+		activityResult,
+		status,
+		"",
+		e.Created,
+	)
+}
+
+// StartExecution starts working the parent order as TWAP or iceberg child orders on exchange.
+func (rc ReserveCore) StartExecution(exchange common.Exchange, pair commonv3.TradingPairSymbols, order common.ParentOrder) (common.ActivityID, error) {
+	rc.executions.mu.RLock()
+	running := rc.executions.ctx != nil
+	rc.executions.mu.RUnlock()
+	if !running {
+		return common.ActivityID{}, ErrExecutionsNotRunning
+	}
+	order.Exchange = exchange.ID()
+	order.PairID = pair.ID
+	order.Base = pair.BaseSymbol
+	order.Quote = pair.QuoteSymbol
+	now := common.NowInMillis()
+	e := &execution{
+		state: common.Execution{
+			ID:          timebasedID(order.Algorithm),
+			ParentOrder: order,
+			Status:      common.ExecutionStatusRunning,
+			Created:     now,
+			Updated:     now,
+		},
+		exchange: exchange,
+		pair:     pair,
+		cancel:   make(chan struct{}),
+	}
+
+	err := validateParentOrder(order)
+	if err == nil {
+		var ok bool
+		if e.querier, ok = exchange.(orderQuerier); !ok {
+			err = fmt.Errorf("exchange %s does not support querying orders", exchange.ID().String())
+		}
+	}
+	if err != nil {
+		sErr := rc.recordExecution(e.state, statusFailed, err)
+		if sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+	if err = rc.recordExecution(e.state, statusSubmitted, nil); err != nil {
+		return common.ActivityID{}, err
+	}
+
+	e.saved = e.copyState()
+	e.publish()
+	rc.runExecution(e)
+	return e.state.ID, nil
+}
+
+// RunExecutions works executions until ctx is done, executions of pending activities are resumed
+// from their saved progress. It returns once all executions stopped, their activities are kept
+// pending so the next leader resumes them.
+func (rc ReserveCore) RunExecutions(ctx context.Context, exchanges []common.Exchange) {
+	em := rc.executions
+	em.mu.Lock()
+	em.ctx = ctx
+	em.mu.Unlock()
+
+	pendings, err := rc.activityStorage.GetPendingActivities()
+	if err != nil {
+		rc.l.Errorw("failed to get pending activities, executions are not resumed", "err", err)
+	}
+	for _, activity := range pendings {
+		if activity.Action != common.ActionExecution {
+			continue
+		}
+		if _, ok := em.get(activity.ID); ok {
+			continue
+		}
+		e, err := rc.loadExecution(activity, exchanges)
+		if err != nil {
+			rc.finishExecution(e, common.ExecutionStatusFailed, err)
+			continue
+		}
+		rc.l.Infow("resuming execution", "id", activity.ID.String(), "filled", e.state.Filled)
+		rc.runExecution(e)
+	}
+
+	<-ctx.Done()
+	em.mu.Lock()
+	em.ctx = nil
+	em.mu.Unlock()
+	em.wg.Wait()
+}
+
+// loadExecution returns the execution of activity from its saved progress, the returned
+// execution only has its state if it can not be resumed.
+func (rc ReserveCore) loadExecution(activity common.ActivityRecord, exchanges []common.Exchange) (*execution, error) {
+	e := &execution{cancel: make(chan struct{})}
+	if activity.Result.Execution == nil {
+		e.state = common.Execution{
+			ID: activity.ID,
+			ParentOrder: common.ParentOrder{
+				Exchange:   activity.Params.Exchange,
+				Base:       activity.Params.Base,
+				Quote:      activity.Params.Quote,
+				Side:       activity.Params.Type,
+				Amount:     activity.Params.Amount,
+				LimitPrice: activity.Params.Rate,
+			},
+			Created: activity.Params.Timepoint,
+		}
+		return e, errors.New("progress of execution is not saved")
+	}
+	e.state = *activity.Result.Execution
+	e.saved = e.copyState()
+	for _, exchange := range exchanges {
+		if exchange.ID() == e.state.Exchange {
+			e.exchange = exchange
+		}
+	}
+	if e.exchange == nil {
+		return e, fmt.Errorf("exchange %s is not enabled", e.state.Exchange.String())
+	}
+	var ok bool
+	if e.querier, ok = e.exchange.(orderQuerier); !ok {
+		return e, fmt.Errorf("exchange %s does not support querying orders", e.state.Exchange.String())
+	}
+	pair, err := rc.riskLimitStorage.GetTradingPair(e.state.PairID)
+	if err != nil {
+		return e, err
+	}
+	e.pair = pair
+	e.publish()
+	return e, nil
+}
+
+// GetExecutions returns progress of all executions.
+func (rc ReserveCore) GetExecutions() []common.Execution {
+	rc.executions.mu.RLock()
+	result := make([]common.Execution, 0, len(rc.executions.executions))
+	for _, e := range rc.executions.executions {
+		result = append(result, e.get())
+	}
+	rc.executions.mu.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.Timepoint < result[j].ID.Timepoint
+	})
+	return result
+}
+
+// GetExecution returns progress of an execution, the progress saved with its activity is
+// returned if the execution is not worked by this instance.
+func (rc ReserveCore) GetExecution(id common.ActivityID) (common.Execution, error) {
+	if e, ok := rc.executions.get(id); ok {
+		return e.get(), nil
+	}
+	activity, err := rc.activityStorage.GetActivity(id)
+	if err != nil || activity.Action != common.ActionExecution || activity.Result.Execution == nil {
+		return common.Execution{}, ErrExecutionNotFound
+	}
+	state := *activity.Result.Execution
+	if !activity.IsPending() && state.Status == common.ExecutionStatusRunning {
+		// the activity was resolved without the execution, e.g it is expired
+		switch activity.ExchangeStatus {
+		case common.ExchangeStatusDone:
+			state.Status = common.ExecutionStatusDone
+		case common.ExchangeStatusCancelled:
+			state.Status = common.ExecutionStatusCancelled
+		default:
+			state.Status = common.ExecutionStatusFailed
+		}
+	}
+	return state, nil
+}
+
+// CancelExecution stops working the parent order and cancels its open child order.
+func (rc ReserveCore) CancelExecution(id common.ActivityID) error {
+	e, ok := rc.executions.get(id)
+	if !ok {
+		return ErrExecutionNotFound
+	}
+	if e.get().Status != common.ExecutionStatusRunning {
+		return fmt.Errorf("execution %s is not running", id.String())
+	}
+	e.stop()
+	return nil
+}
+
+// runExecution starts working the execution until it finishes or executions are stopped, it is
+// kept pending with the saved progress if it is not started.
+func (rc ReserveCore) runExecution(e *execution) {
+	em := rc.executions
+	em.mu.Lock()
+	defer em.mu.Unlock()
+	if em.ctx == nil || em.ctx.Err() != nil {
+		rc.l.Warnw("executions are stopped, execution is not started", "id", e.state.ID.String())
+		return
+	}
+	em.executions[e.state.ID] = e
+	em.wg.Add(1)
+	go func(ctx context.Context) {
+		defer em.wg.Done()
+		rc.workExecution(ctx, e)
+	}(em.ctx)
+}
+
+func (rc ReserveCore) workExecution(ctx context.Context, e *execution) {
+	ticker := time.NewTicker(rc.executions.pollInterval)
+	defer ticker.Stop()
+	for {
+		status, err := rc.stepExecution(e, common.NowInMillis())
+		if status != common.ExecutionStatusRunning {
+			rc.finishExecution(e, status, err)
+			return
+		}
+		rc.saveExecution(e)
+		e.publish()
+		select {
+		case <-ctx.Done():
+			// the progress is saved, the next leader resumes the execution
+			rc.executions.remove(e.state.ID)
+			return
+		case <-e.cancel:
+			if child := e.openChild(); child != nil {
+				if err := rc.cancelChild(e, child); err != nil {
+					rc.finishExecution(e, common.ExecutionStatusCancelled, err)
+					return
+				}
+			}
+			rc.finishExecution(e, common.ExecutionStatusCancelled, nil)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateChild updates filled amount of the child order from exchange, the child order is closed
+// when it is filled or the exchange cancelled, expired or rejected it. Unfilled amount of a closed
+// child order is placed again by the next step.
+func (rc ReserveCore) updateChild(e *execution, child *common.ChildOrder) error {
+	orderID, err := strconv.ParseUint(child.ActivityID.EID, 10, 64)
+	if err != nil {
+		return err
+	}
+	done, _, status, err := e.querier.QueryOrder(e.pair.BaseSymbol+e.pair.QuoteSymbol, orderID)
+	if err != nil {
+		return err
+	}
+	child.Filled = done
+	if status != common.OrderStatusOpen {
+		if status != common.OrderStatusFilled {
+			rc.l.Infow("child order is closed by exchange", "execution", e.state.ID.String(),
+				"order", child.ActivityID.EID, "status", status, "filled", done)
+		}
+		child.Open = false
+	}
+	return nil
+}
+
+// cancelChild cancels unfilled part of the child order, the child order is kept open if it
+// can not be cancelled.
+func (rc ReserveCore) cancelChild(e *execution, child *common.ChildOrder) error {
+	cErr := e.exchange.CancelOrder(child.ActivityID.EID, e.pair.BaseSymbol, e.pair.QuoteSymbol)
+	if err := rc.updateChild(e, child); err != nil {
+		return err
+	}
+	if cErr != nil && child.Open {
+		return cErr
+	}
+	child.Open = false
+	return nil
+}
+
+// placeChild places a child order of amount, rounded down to amount precision of the pair.
+func (rc ReserveCore) placeChild(e *execution, amount float64, now uint64) error {
+	amount = roundAmount(amount, e.pair.AmountPrecision)
+	if amount <= 0 {
+		return nil
+	}
+	parent := e.state.ID
+	id, done, _, finished, err := rc.trade(e.exchange, e.state.Side, e.pair, e.state.LimitPrice, amount, &parent)
+	if err != nil {
+		return err
+	}
+	e.state.Children = append(e.state.Children, common.ChildOrder{
+		ActivityID: id,
+		Amount:     amount,
+		Filled:     done,
+		Open:       !finished,
+		Time:       now,
+	})
+	return nil
+}
+
+// stepExecution updates child orders and places new child order if needed, it returns the
+// status of execution after the step.
+func (rc ReserveCore) stepExecution(e *execution, now uint64) (string, error) {
+	e.state.Updated = now
+	child := e.openChild()
+	if child != nil {
+		if err := rc.updateChild(e, child); err != nil {
+			rc.l.Warnw("failed to query child order", "execution", e.state.ID.String(), "err", err)
+		}
+		if !child.Open {
+			child = nil
+		}
+	}
+	e.state.Filled = e.filled()
+	remaining := e.state.Amount - e.state.Filled
+	if remaining < executionEpsilon {
+		return common.ExecutionStatusDone, nil
+	}
+
+	var (
+		duration = uint64(time.Duration(e.state.Duration) / time.Millisecond)
+		want     float64
+	)
+	switch e.state.Algorithm {
+	case common.ExecutionAlgorithmTWAP:
+		slices := uint64(defaultTWAPSlices)
+		if e.state.SliceSize > 0 {
+			slices = uint64(math.Ceil(e.state.Amount/e.state.SliceSize - executionEpsilon))
+		}
+		interval := duration / slices
+		elapsed := now - e.state.Created
+		if elapsed >= duration {
+			// duration is over, unfilled amount is not worked anymore
+			if child != nil {
+				if err := rc.cancelChild(e, child); err != nil {
+					return common.ExecutionStatusRunning, nil
+				}
+			}
+			e.state.Filled = e.filled()
+			return common.ExecutionStatusDone, nil
+		}
+		slice := slices - 1
+		if interval > 0 && elapsed/interval < slices {
+			slice = elapsed / interval
+		}
+		if child != nil && child.Time < e.state.Created+slice*interval {
+			// replace unfilled child order of previous slice
+			if err := rc.cancelChild(e, child); err != nil {
+				rc.l.Warnw("failed to cancel child order", "execution", e.state.ID.String(), "err", err)
+				return common.ExecutionStatusRunning, nil
+			}
+			child = nil
+		}
+		want = e.state.Amount*float64(slice+1)/float64(slices) - e.filled()
+	case common.ExecutionAlgorithmIceberg:
+		if child != nil && duration > 0 && now-child.Time >= duration {
+			if err := rc.cancelChild(e, child); err != nil {
+				rc.l.Warnw("failed to cancel child order", "execution", e.state.ID.String(), "err", err)
+				return common.ExecutionStatusRunning, nil
+			}
+			child = nil
+		}
+		want = math.Min(e.state.SliceSize, e.state.Amount-e.filled())
+		if child == nil && roundAmount(want, e.pair.AmountPrecision) <= 0 {
+			// remaining amount is smaller than amount precision
+			return common.ExecutionStatusDone, nil
+		}
+	}
+	if child != nil {
+		return common.ExecutionStatusRunning, nil
+	}
+	if err := rc.placeChild(e, want, now); err != nil {
+		return common.ExecutionStatusFailed, err
+	}
+	e.state.Filled = e.filled()
+	return common.ExecutionStatusRunning, nil
+}
+
+// saveExecution saves progress of the execution with its activity if its child orders changed,
+// the execution is stopped if its activity was resolved, e.g it is expired.
+func (rc ReserveCore) saveExecution(e *execution) {
+	if !e.changed() {
+		return
+	}
+	state := e.copyState()
+	_, err := rc.activityStorage.ModifyActivity(e.state.ID, common.ActivityUpdate{Source: common.ActivitySourceCore},
+		func(activity common.ActivityRecord) (common.ActivityRecord, error) {
+			if !activity.IsPending() {
+				return activity, errExecutionResolved
+			}
+			activity.Result.Execution = &state
+			activity.Result.Done = state.Filled
+			activity.Result.Remaining = state.Amount - state.Filled
+			return activity, nil
+		})
+	switch {
+	case err == errExecutionResolved:
+		rc.l.Warnw("execution activity is resolved, stopping execution", "id", e.state.ID.String())
+		e.stop()
+	case err != nil:
+		rc.l.Warnw("failed to save execution", "id", e.state.ID.String(), "err", err)
+	default:
+		e.saved = state
+	}
+}
+
+// finishExecution publishes the final status of execution and updates its activity.
+func (rc ReserveCore) finishExecution(e *execution, status string, err error) {
+	e.state.Status = status
+	e.state.Filled = e.filled()
+	e.state.Updated = common.NowInMillis()
+	if err != nil {
+		e.state.Error = err.Error()
+	}
+	e.publish()
+	rc.l.Infow("execution finished", "id", e.state.ID.String(), "status", status,
+		"filled", e.state.Filled, "amount", e.state.Amount, "err", err)
+
+	// the activity is updated while it is locked, it is kept if it was already resolved
+	state := e.copyState()
+	_, uErr := rc.activityStorage.ModifyActivity(e.state.ID, common.ActivityUpdate{Source: common.ActivitySourceCore},
+		func(activity common.ActivityRecord) (common.ActivityRecord, error) {
+			if !activity.IsPending() {
+				return activity, errExecutionResolved
+			}
+			switch status {
+			case common.ExecutionStatusDone:
+				activity.ExchangeStatus = common.ExchangeStatusDone
+			case common.ExecutionStatusCancelled:
+				activity.ExchangeStatus = common.ExchangeStatusCancelled
+			default:
+				activity.ExchangeStatus = common.ExchangeStatusFailed
+			}
+			activity.Result.Done = e.state.Filled
+			activity.Result.Remaining = e.state.Amount - e.state.Filled
+			activity.Result.Finished = activity.Result.Remaining < executionEpsilon
+			activity.Result.Error = e.state.Error
+			activity.Result.Execution = &state
+			return activity, nil
+		})
+	if uErr != nil {
+		rc.l.Warnw("failed to update execution activity", "id", e.state.ID.String(), "err", uErr)
+	}
+}
@@ -0,0 +1,330 @@
+package core
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testOrder struct {
+	amount float64
+	filled float64
+	status string
+}
+
+// testExecutionExchange keeps placed orders in memory, orders are filled by fillAll or setFilled.
+type testExecutionExchange struct {
+	testExchange
+	mu      sync.Mutex
+	orders  []*testOrder
+	fillAll bool
+}
+
+func (te *testExecutionExchange) Trade(tradeType string, pair commonv3.TradingPairSymbols, rate float64, amount float64) (string, float64, float64, bool, error) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.orders = append(te.orders, &testOrder{amount: amount, status: common.OrderStatusOpen})
+	return strconv.Itoa(len(te.orders)), 0, amount, false, nil
+}
+
+func (te *testExecutionExchange) QueryOrder(symbol string, id uint64) (float64, float64, string, error) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	order := te.orders[id-1]
+	if te.fillAll && order.status == common.OrderStatusOpen {
+		order.filled = order.amount
+	}
+	if order.status == common.OrderStatusOpen && order.filled == order.amount {
+		order.status = common.OrderStatusFilled
+	}
+	return order.filled, order.amount - order.filled, order.status, nil
+}
+
+func (te *testExecutionExchange) CancelOrder(id, base, quote string) error {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	index, _ := strconv.Atoi(id)
+	te.orders[index-1].status = common.OrderStatusCancelled
+	return nil
+}
+
+func (te *testExecutionExchange) setFilled(id int, filled float64) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.orders[id-1].filled = filled
+}
+
+// closeOrder closes the order on the exchange side, e.g it is expired.
+func (te *testExecutionExchange) closeOrder(id int, status string) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	te.orders[id-1].status = status
+}
+
+func (te *testExecutionExchange) amounts() []float64 {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	var result []float64
+	for _, order := range te.orders {
+		result = append(result, order.amount)
+	}
+	return result
+}
+
+var testExecutionPair = commonv3.TradingPairSymbols{
+	TradingPair: commonv3.TradingPair{ID: 1, Base: 2, Quote: 1, AmountPrecision: 2},
+	BaseSymbol:  "KNC",
+	QuoteSymbol: "ETH",
+}
+
+func newTestExecutionCore(storage ActivityStorage) *ReserveCore {
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{}, &common.ContractAddressConfiguration{})
+	rc.executions.pollInterval = time.Millisecond
+	rc.executions.ctx = context.Background()
+	return rc
+}
+
+func TestIcebergExecution(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := newTestExecutionCore(storage)
+	exchange := &testExecutionExchange{fillAll: true}
+
+	_, err := rc.StartExecution(exchange, testExecutionPair, common.ParentOrder{
+		Side: "buy", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmIceberg,
+	})
+	require.Error(t, err, "slice size is required for iceberg order")
+
+	id, err := rc.StartExecution(exchange, testExecutionPair, common.ParentOrder{
+		Side: "buy", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmIceberg, SliceSize: 3,
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		execution, err := rc.GetExecution(id)
+		return err == nil && execution.Status == common.ExecutionStatusDone
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, []float64{3, 3, 3, 1}, exchange.amounts())
+	execution, err := rc.GetExecution(id)
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), execution.Filled)
+	assert.Len(t, execution.Children, 4)
+
+	parent, err := storage.GetActivity(id)
+	require.NoError(t, err)
+	assert.Equal(t, common.ActionExecution, parent.Action)
+	assert.Equal(t, common.ExchangeStatusDone, parent.ExchangeStatus)
+	assert.False(t, parent.IsPending())
+	records, err := storage.GetAllRecords(0, 0)
+	require.NoError(t, err)
+	var children int
+	for _, record := range records {
+		if record.Action == common.ActionTrade {
+			require.NotNil(t, record.Params.Parent)
+			assert.Equal(t, id, *record.Params.Parent)
+			children++
+		}
+	}
+	assert.Equal(t, 4, children)
+}
+
+func TestTWAPExecution(t *testing.T) {
+	rc := newTestExecutionCore(&recordingActivityStorage{})
+	exchange := &testExecutionExchange{}
+	now := common.NowInMillis()
+	e := &execution{
+		state: common.Execution{
+			ParentOrder: common.ParentOrder{
+				Side: "sell", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmTWAP,
+				Duration: common.HumanDuration(10 * time.Second), SliceSize: 5,
+			},
+			Created: now,
+		},
+		exchange: exchange,
+		querier:  exchange,
+		pair:     testExecutionPair,
+	}
+
+	status, err := rc.stepExecution(e, now)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+	assert.Equal(t, []float64{5}, exchange.amounts())
+
+	// first slice is partially filled, it is replaced when second slice starts
+	exchange.setFilled(1, 2)
+	status, err = rc.stepExecution(e, now+1000)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+	assert.Equal(t, []float64{5}, exchange.amounts())
+
+	status, err = rc.stepExecution(e, now+5000)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+	assert.Equal(t, []float64{5, 8}, exchange.amounts())
+	assert.Equal(t, common.OrderStatusCancelled, exchange.orders[0].status)
+
+	// unfilled amount is cancelled when duration is over
+	exchange.setFilled(2, 4)
+	status, err = rc.stepExecution(e, now+10000)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusDone, status)
+	assert.Equal(t, common.OrderStatusCancelled, exchange.orders[1].status)
+	assert.Equal(t, float64(6), e.state.Filled)
+}
+
+func TestExecutionChildClosedByExchange(t *testing.T) {
+	rc := newTestExecutionCore(&recordingActivityStorage{})
+	exchange := &testExecutionExchange{}
+	now := common.NowInMillis()
+	e := &execution{
+		state: common.Execution{
+			ParentOrder: common.ParentOrder{
+				Side: "sell", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmTWAP,
+				Duration: common.HumanDuration(10 * time.Second), SliceSize: 5,
+			},
+			Created: now,
+		},
+		exchange: exchange,
+		querier:  exchange,
+		pair:     testExecutionPair,
+	}
+
+	status, err := rc.stepExecution(e, now)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+
+	// the child order is partially filled then expired by the exchange, the rest of the slice
+	// is placed again
+	exchange.setFilled(1, 2)
+	exchange.closeOrder(1, common.OrderStatusExpired)
+	status, err = rc.stepExecution(e, now+1000)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+	assert.Equal(t, []float64{5, 3}, exchange.amounts())
+	require.Len(t, e.state.Children, 2)
+	assert.False(t, e.state.Children[0].Open)
+	assert.Equal(t, float64(2), e.state.Children[0].Filled)
+	assert.True(t, e.state.Children[1].Open)
+	assert.Equal(t, float64(2), e.state.Filled)
+
+	// a rejected child order is closed the same way
+	exchange.closeOrder(2, common.OrderStatusRejected)
+	status, err = rc.stepExecution(e, now+2000)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, status)
+	assert.Equal(t, []float64{5, 3, 3}, exchange.amounts())
+}
+
+func TestCancelExecution(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := newTestExecutionCore(storage)
+	exchange := &testExecutionExchange{}
+
+	id, err := rc.StartExecution(exchange, testExecutionPair, common.ParentOrder{
+		Side: "buy", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmTWAP,
+		Duration: common.HumanDuration(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(exchange.amounts()) == 1
+	}, time.Second, time.Millisecond)
+
+	_, err = rc.OverrideActivity(id, common.ActivityOverride{Status: common.ExchangeStatusDone, Reason: "filled"})
+	require.Error(t, err, "running execution can not be overridden")
+
+	require.NoError(t, rc.CancelExecution(id))
+	require.Eventually(t, func() bool {
+		execution, err := rc.GetExecution(id)
+		return err == nil && execution.Status == common.ExecutionStatusCancelled
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []float64{1}, exchange.amounts())
+	assert.Equal(t, common.OrderStatusCancelled, exchange.orders[0].status)
+	require.Error(t, rc.CancelExecution(id))
+
+	parent, err := storage.GetActivity(id)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusCancelled, parent.ExchangeStatus)
+	assert.False(t, parent.IsPending())
+	_, err = rc.OverrideActivity(id, common.ActivityOverride{Status: common.ExchangeStatusDone, Reason: "filled"})
+	require.Error(t, err, "cancelled execution is not pending")
+
+	_, err = rc.GetExecution(common.ActivityID{})
+	assert.Equal(t, ErrExecutionNotFound, err)
+}
+
+func TestResumeExecution(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := newTestExecutionCore(storage)
+	rc.executions.ctx = nil
+	exchange := &testExecutionExchange{}
+	order := common.ParentOrder{
+		Side: "buy", Amount: 10, LimitPrice: 0.01, Algorithm: common.ExecutionAlgorithmIceberg, SliceSize: 3,
+	}
+
+	_, err := rc.StartExecution(exchange, testExecutionPair, order)
+	assert.Equal(t, ErrExecutionsNotRunning, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		rc.RunExecutions(ctx, []common.Exchange{exchange})
+		close(stopped)
+	}()
+	var id common.ActivityID
+	require.Eventually(t, func() bool {
+		id, err = rc.StartExecution(exchange, testExecutionPair, order)
+		return err == nil
+	}, time.Second, time.Millisecond)
+	require.Eventually(t, func() bool {
+		parent, err := storage.GetActivity(id)
+		return err == nil && parent.Result.Execution != nil && len(parent.Result.Execution.Children) == 1
+	}, time.Second, time.Millisecond)
+
+	// the execution is stopped with the leader, it is kept pending with its progress
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("executions are not stopped")
+	}
+	parent, err := storage.GetActivity(id)
+	require.NoError(t, err)
+	assert.True(t, parent.IsPending())
+	execution, err := rc.GetExecution(id)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExecutionStatusRunning, execution.Status)
+	assert.Len(t, execution.Children, 1)
+
+	lost := common.ActivityID{Timepoint: 1, EID: common.ExecutionAlgorithmTWAP}
+	require.NoError(t, storage.Record(common.ActionExecution, lost, common.Binance.String(),
+		common.ActivityParams{Exchange: common.Binance, Amount: 10}, common.ActivityResult{}, statusSubmitted, "", 1))
+
+	// the next leader resumes the execution
+	exchange.mu.Lock()
+	exchange.fillAll = true
+	exchange.mu.Unlock()
+	rc = newTestExecutionCore(storage)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go rc.RunExecutions(ctx, []common.Exchange{exchange})
+	require.Eventually(t, func() bool {
+		execution, err := rc.GetExecution(id)
+		return err == nil && execution.Status == common.ExecutionStatusDone
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []float64{3, 3, 3, 1}, exchange.amounts())
+	parent, err = storage.GetActivity(id)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, parent.ExchangeStatus)
+
+	// execution without saved progress can not be resumed
+	parent, err = storage.GetActivity(lost)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusFailed, parent.ExchangeStatus)
+}
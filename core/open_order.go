@@ -0,0 +1,45 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// GetOpenOrders returns orders which are still open on exchange joined with the pending trade
+// activities which placed them. Orders not placed by any pending trade activity are marked as orphaned.
+func (rc ReserveCore) GetOpenOrders(exchange common.Exchange) ([]common.OpenOrder, error) {
+	openOrderExchange, ok := exchange.(common.OpenOrderExchange)
+	if !ok {
+		return nil, fmt.Errorf("exchange %s does not support listing open orders", exchange.ID().String())
+	}
+	orders, err := openOrderExchange.OpenOrders()
+	if err != nil {
+		return nil, err
+	}
+	pendings, err := rc.activityStorage.GetPendingActivities()
+	if err != nil {
+		return nil, err
+	}
+	activities := make(map[string]common.ActivityID)
+	for _, activity := range pendings {
+		if activity.Action == common.ActionTrade && activity.Params.Exchange == exchange.ID() {
+			activities[activity.ID.EID] = activity.ID
+		}
+	}
+	for i := range orders {
+		if id, ok := activities[orders[i].ID]; ok {
+			orders[i].ActivityID = &id
+			continue
+		}
+		orders[i].Orphaned = true
+	}
+	return orders, nil
+}
+
+// CancelOpenOrder cancels an open order on exchange, it also works for orphaned orders.
+func (rc ReserveCore) CancelOpenOrder(exchange common.Exchange, order common.OpenOrder) error {
+	rc.l.Infow("cancel open order", "exchange", exchange.ID().String(), "id", order.ID,
+		"base", order.Base, "quote", order.Quote, "orphaned", order.Orphaned)
+	return exchange.CancelOrder(order.ID, order.Base, order.Quote)
+}
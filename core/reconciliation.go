@@ -0,0 +1,375 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const (
+	// defaultReconciliationWindow is the time range reconciled by the periodic job if it is not
+	// configured.
+	defaultReconciliationWindow = 24 * time.Hour
+	// defaultTransferLateAfter is how long a transfer can take if it is not configured.
+	defaultTransferLateAfter = time.Hour
+	alertTimeout             = 10 * time.Second
+)
+
+// reconciler keeps the policy and latest reports of transfer reconciliation.
+type reconciler struct {
+	mu      sync.RWMutex
+	policy  common.ReconciliationPolicy
+	reports map[common.ExchangeID]common.ReconciliationReport
+	// alerted are keys of items alerted by the last periodic run of each exchange, an item is
+	// only alerted once while it is reported.
+	alerted map[common.ExchangeID]map[string]struct{}
+	client  *http.Client
+}
+
+func newReconciler() *reconciler {
+	return &reconciler{
+		reports: make(map[common.ExchangeID]common.ReconciliationReport),
+		alerted: make(map[common.ExchangeID]map[string]struct{}),
+		client:  &http.Client{Timeout: alertTimeout},
+	}
+}
+
+func (r *reconciler) getPolicy() common.ReconciliationPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	policy := r.policy
+	if policy.Window == 0 {
+		policy.Window = common.HumanDuration(defaultReconciliationWindow)
+	}
+	if policy.LateAfter == 0 {
+		policy.LateAfter = common.HumanDuration(defaultTransferLateAfter)
+	}
+	return policy
+}
+
+// SetReconciliationPolicy sets the policy of transfer reconciliation.
+func (rc *ReserveCore) SetReconciliationPolicy(policy common.ReconciliationPolicy) {
+	rc.reconciler.mu.Lock()
+	rc.reconciler.policy = policy
+	rc.reconciler.mu.Unlock()
+}
+
+// amountMatch returns true if the amounts differ by no more than the tolerance ratio.
+func amountMatch(expected, actual, tolerance float64) bool {
+	return math.Abs(expected-actual) <= expected*tolerance+executionEpsilon
+}
+
+// transferType returns the type of transfer the activity makes on the exchange, a transfer
+// between exchanges is a withdrawal on its source exchange and a deposit on its destination.
+func transferType(activity common.ActivityRecord, exchange string) string {
+	switch activity.Action {
+	case common.ActionDeposit:
+		if activity.Destination == exchange {
+			return common.TransferTypeDeposit
+		}
+	case common.ActionWithdraw:
+		if activity.Destination == exchange {
+			return common.TransferTypeWithdraw
+		}
+	case common.ActionTransfer:
+		switch exchange {
+		case activity.Params.Exchange.String():
+			return common.TransferTypeWithdraw
+		case activity.Params.DestinationExchange.String():
+			return common.TransferTypeDeposit
+		}
+	}
+	return ""
+}
+
+// activityTime returns creation time of the activity in millisecond.
+func activityTime(activity common.ActivityRecord) uint64 {
+	created, _ := strconv.ParseUint(string(activity.Timestamp), 10, 64)
+	return created
+}
+
+// reconcileTransfers matches transfers of an exchange with deposit, withdraw and transfer
+// activities to it. Activities are matched by tx hash for deposits and exchange id for withdrawals first,
+// the rest are matched by asset and amount with the earliest transfer made after them.
+// Activities created before fromTime are only used for matching transfers in the range.
+func reconcileTransfers(
+	exchange string,
+	fromTime, toTime, now uint64,
+	transfers []common.ExchangeTransfer,
+	activities []common.ActivityRecord,
+	symbols map[uint64]string,
+	policy common.ReconciliationPolicy) common.ReconciliationReport {
+	report := common.ReconciliationReport{
+		Exchange:  exchange,
+		FromTime:  fromTime,
+		ToTime:    toTime,
+		Timestamp: now,
+		Items:     []common.ReconciliationItem{},
+	}
+	lateAfter := uint64(time.Duration(policy.LateAfter) / time.Millisecond)
+
+	sort.Slice(transfers, func(i, j int) bool { return transfers[i].Timestamp < transfers[j].Timestamp })
+	sort.Slice(activities, func(i, j int) bool { return activityTime(activities[i]) < activityTime(activities[j]) })
+
+	byKey := make(map[string]int)
+	for i, transfer := range transfers {
+		if transfer.Type == common.TransferTypeDeposit && transfer.TxHash != "" {
+			byKey[transfer.Type+strings.ToLower(transfer.TxHash)] = i
+		}
+		if transfer.Type == common.TransferTypeWithdraw && transfer.ID != "" {
+			byKey[transfer.Type+transfer.ID] = i
+		}
+	}
+	var (
+		matched       = make(map[int]bool)
+		unmatched     []common.ActivityRecord
+		matchActivity = func(activity common.ActivityRecord, i int) {
+			matched[i] = true
+			report.Matched++
+			transfer := transfers[i]
+			item := common.ReconciliationItem{
+				Type:           transfer.Type,
+				Asset:          transfer.Asset,
+				Transfer:       &transfer,
+				ActivityID:     &activity.ID,
+				ActivityAmount: activity.Params.Amount,
+			}
+			switch {
+			case !amountMatch(activity.Params.Amount, transfer.Amount, policy.AmountTolerance):
+				item.Kind = common.ReconciliationMismatched
+				item.Detail = fmt.Sprintf("amount on exchange is %s, activity amount is %s",
+					formatFloat(transfer.Amount), formatFloat(activity.Params.Amount))
+			case transfer.Status == common.ExchangeStatusDone && activity.State() == common.ActivityStateFailed:
+				item.Kind = common.ReconciliationMismatched
+				item.Detail = "transfer is done on exchange but activity is failed"
+			case transfer.Status == common.ExchangeStatusFailed && activity.State() == common.ActivityStateDone:
+				item.Kind = common.ReconciliationMismatched
+				item.Detail = "transfer is failed on exchange but activity is done"
+			case transfer.Status == common.ExchangeStatusPending && activityTime(activity)+lateAfter < now:
+				item.Kind = common.ReconciliationLate
+				item.Detail = fmt.Sprintf("transfer is not done on exchange after %s", time.Duration(policy.LateAfter))
+			default:
+				return
+			}
+			report.Items = append(report.Items, item)
+		}
+	)
+	for _, activity := range activities {
+		typ := transferType(activity, exchange)
+		if typ == "" {
+			continue
+		}
+		var key string
+		if typ == common.TransferTypeDeposit {
+			key = typ + strings.ToLower(activity.Result.Tx)
+		} else {
+			key = typ + activity.Result.ID
+		}
+		if i, ok := byKey[key]; ok && !matched[i] {
+			matchActivity(activity, i)
+			continue
+		}
+		unmatched = append(unmatched, activity)
+	}
+	for _, activity := range unmatched {
+		typ, symbol := transferType(activity, exchange), symbols[activity.Params.Asset]
+		found := false
+		for i, transfer := range transfers {
+			if matched[i] || transfer.Type != typ || !strings.EqualFold(transfer.Asset, symbol) ||
+				transfer.Timestamp < activityTime(activity) ||
+				!amountMatch(activity.Params.Amount, transfer.Amount, policy.AmountTolerance) {
+				continue
+			}
+			matchActivity(activity, i)
+			found = true
+			break
+		}
+		created := activityTime(activity)
+		if found || created < fromTime || created+lateAfter >= now {
+			continue
+		}
+		if state := activity.State(); state == common.ActivityStateFailed || state == common.ActivityStateCancelled {
+			continue
+		}
+		id := activity.ID
+		report.Items = append(report.Items, common.ReconciliationItem{
+			Kind:           common.ReconciliationLate,
+			Type:           typ,
+			Asset:          symbol,
+			ActivityID:     &id,
+			ActivityAmount: activity.Params.Amount,
+			Detail:         fmt.Sprintf("transfer is not found on exchange after %s", time.Duration(policy.LateAfter)),
+		})
+	}
+	for i, transfer := range transfers {
+		if matched[i] || transfer.Timestamp < fromTime || transfer.Timestamp > toTime {
+			continue
+		}
+		transfer := transfer
+		report.Items = append(report.Items, common.ReconciliationItem{
+			Kind:     common.ReconciliationUnmatched,
+			Type:     transfer.Type,
+			Asset:    transfer.Asset,
+			Transfer: &transfer,
+			Detail:   "transfer on exchange has no activity",
+		})
+	}
+	return report
+}
+
+// Reconcile matches deposits and withdrawals on the exchange made in the time range with
+// activities, the report is kept as the latest report of the exchange.
+func (rc ReserveCore) Reconcile(exchange common.Exchange, fromTime, toTime uint64) (common.ReconciliationReport, error) {
+	historyExchange, ok := exchange.(common.TransferHistoryExchange)
+	if !ok {
+		return common.ReconciliationReport{}, fmt.Errorf("exchange %s does not support transfer history", exchange.ID().String())
+	}
+	policy := rc.reconciler.getPolicy()
+	transfers, err := historyExchange.TransferHistory(fromTime, toTime)
+	if err != nil {
+		return common.ReconciliationReport{}, err
+	}
+	// transfers in the range can be made for activities created a bit earlier
+	lateAfter := uint64(time.Duration(policy.LateAfter) / time.Millisecond)
+	activitiesFrom := uint64(0)
+	if fromTime > lateAfter {
+		activitiesFrom = fromTime - lateAfter
+	}
+	activities, err := rc.activityStorage.GetAllRecords(activitiesFrom, toTime)
+	if err != nil {
+		return common.ReconciliationReport{}, err
+	}
+	symbols := make(map[uint64]string)
+	for _, activity := range activities {
+		if transferType(activity, exchange.ID().String()) == "" {
+			continue
+		}
+		if _, ok := symbols[activity.Params.Asset]; ok {
+			continue
+		}
+		asset, err := rc.riskLimitStorage.GetAsset(activity.Params.Asset)
+		if err != nil {
+			rc.l.Warnw("failed to get asset of activity", "asset", activity.Params.Asset, "err", err)
+			continue
+		}
+		symbols[asset.ID] = asset.Symbol
+		for _, ae := range asset.Exchanges {
+			if ae.ExchangeID == uint64(exchange.ID()) {
+				symbols[asset.ID] = ae.Symbol
+			}
+		}
+	}
+	report := reconcileTransfers(exchange.ID().String(), fromTime, toTime, common.NowInMillis(),
+		transfers, activities, symbols, policy)
+	rc.reconciler.mu.Lock()
+	rc.reconciler.reports[exchange.ID()] = report
+	rc.reconciler.mu.Unlock()
+	return report, nil
+}
+
+// GetReconciliationReports returns the latest reconciliation report of each exchange.
+func (rc ReserveCore) GetReconciliationReports() []common.ReconciliationReport {
+	rc.reconciler.mu.RLock()
+	defer rc.reconciler.mu.RUnlock()
+	reports := make([]common.ReconciliationReport, 0, len(rc.reconciler.reports))
+	for _, report := range rc.reconciler.reports {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Exchange < reports[j].Exchange })
+	return reports
+}
+
+func reconciliationItemKey(item common.ReconciliationItem) string {
+	var activityID, transferID string
+	if item.ActivityID != nil {
+		activityID = item.ActivityID.String()
+	}
+	if item.Transfer != nil {
+		transferID = item.Transfer.ID + item.Transfer.TxHash
+	}
+	return strings.Join([]string{item.Kind, item.Type, activityID, transferID}, "|")
+}
+
+// alert logs items of the report which are not alerted yet and posts them to the webhook.
+func (rc ReserveCore) alert(exchange common.ExchangeID, report common.ReconciliationReport, webhook string) {
+	rc.reconciler.mu.Lock()
+	previous := rc.reconciler.alerted[exchange]
+	current := make(map[string]struct{}, len(report.Items))
+	var items []common.ReconciliationItem
+	for _, item := range report.Items {
+		key := reconciliationItemKey(item)
+		current[key] = struct{}{}
+		if _, ok := previous[key]; !ok {
+			items = append(items, item)
+		}
+	}
+	rc.reconciler.alerted[exchange] = current
+	rc.reconciler.mu.Unlock()
+
+	for _, item := range items {
+		rc.l.Errorw("transfer reconciliation alert", "exchange", report.Exchange, "kind", item.Kind,
+			"type", item.Type, "asset", item.Asset, "activity_id", item.ActivityID, "transfer", item.Transfer,
+			"detail", item.Detail)
+	}
+	if len(items) == 0 || webhook == "" {
+		return
+	}
+	alert := report
+	alert.Items = items
+	body, err := json.Marshal(alert)
+	if err != nil {
+		rc.l.Warnw("failed to encode reconciliation alert", "err", err)
+		return
+	}
+	resp, err := rc.reconciler.client.Post(webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		rc.l.Warnw("failed to send reconciliation alert", "err", err)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		rc.l.Warnw("reconciliation alert webhook returned error", "status", resp.StatusCode)
+	}
+}
+
+// RunReconciliation reconciles transfers of exchanges in the policy window periodically and
+// alerts new items until ctx is done.
+func (rc ReserveCore) RunReconciliation(ctx context.Context, exchanges []common.Exchange) {
+	policy := rc.reconciler.getPolicy()
+	if policy.Interval == 0 {
+		rc.l.Infow("transfer reconciliation is not configured")
+		return
+	}
+	ticker := time.NewTicker(time.Duration(policy.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		now := common.NowInMillis()
+		window := uint64(time.Duration(policy.Window) / time.Millisecond)
+		for _, exchange := range exchanges {
+			if _, ok := exchange.(common.TransferHistoryExchange); !ok {
+				continue
+			}
+			report, err := rc.Reconcile(exchange, now-window, now)
+			if err != nil {
+				rc.l.Warnw("failed to reconcile transfers", "exchange", exchange.ID().String(), "err", err)
+				continue
+			}
+			rc.alert(exchange.ID(), report, policy.AlertWebhook)
+		}
+	}
+}
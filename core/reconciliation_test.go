@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+func TestReconcileTransfers(t *testing.T) {
+	const (
+		hour = uint64(time.Hour / time.Millisecond)
+		now  = 100 * hour
+		from = now - 24*hour
+	)
+	newActivity := func(action, eid string, amount float64, created uint64, result common.ActivityResult, estatus, mstatus string) common.ActivityRecord {
+		return common.NewActivityRecord(action, common.NewActivityID(created, eid), "binance",
+			common.ActivityParams{Asset: 1, Amount: amount}, result, estatus, mstatus,
+			common.Timestamp(strconv.FormatUint(created, 10)))
+	}
+	activities := []common.ActivityRecord{
+		// matched by tx hash
+		newActivity(common.ActionDeposit, "d1", 10, from+hour, common.ActivityResult{Tx: "0xAA"}, "", common.MiningStatusMined),
+		// matched by id with a different amount
+		newActivity(common.ActionWithdraw, "w1", 5, from+hour, common.ActivityResult{ID: "w1"}, common.ExchangeStatusDone, common.MiningStatusMined),
+		// matched by asset and amount
+		newActivity(common.ActionDeposit, "d2", 3, from+2*hour, common.ActivityResult{Tx: "0xcc"}, "", common.MiningStatusMined),
+		// not found on exchange for too long
+		newActivity(common.ActionDeposit, "d3", 7, from+3*hour, common.ActivityResult{Tx: "0xdd"}, "", common.MiningStatusMined),
+		// not found on exchange but recent
+		newActivity(common.ActionDeposit, "d4", 8, now-hour/2, common.ActivityResult{Tx: "0xee"}, "", common.MiningStatusSubmitted),
+		// failed on chain, never reaches exchange
+		newActivity(common.ActionDeposit, "d5", 9, from+hour, common.ActivityResult{Tx: "0xff"}, "", common.MiningStatusFailed),
+		// matched by id but still pending on exchange
+		newActivity(common.ActionWithdraw, "w2", 2, from+hour, common.ActivityResult{ID: "w2"}, common.ExchangeStatusSubmitted, ""),
+		// transfer from huobi is matched by tx hash of its deposit
+		newActivity(common.ActionTransfer, "tr1", 6, from+hour, common.ActivityResult{ID: "hw1", Tx: "0x11"}, common.ExchangeStatusPending, ""),
+		// transfer to huobi is matched by id of its withdrawal
+		newActivity(common.ActionTransfer, "tr2", 4, from+hour, common.ActivityResult{ID: "w3"}, common.ExchangeStatusSubmitted, ""),
+		// other exchanges and actions are ignored
+		newActivity(common.ActionTrade, "t1", 1, from+hour, common.ActivityResult{ID: "t1"}, common.ExchangeStatusDone, ""),
+	}
+	activities[len(activities)-3].Params.Exchange = common.Huobi
+	activities[len(activities)-3].Params.DestinationExchange = common.Binance
+	activities[len(activities)-2].Params.Exchange = common.Binance
+	activities[len(activities)-2].Params.DestinationExchange = common.Huobi
+	activities[len(activities)-1].Destination = "huobi"
+	transfers := []common.ExchangeTransfer{
+		{Type: common.TransferTypeDeposit, TxHash: "0xaa", Asset: "KNC", Amount: 10, Status: common.ExchangeStatusDone, Timestamp: from + hour},
+		{Type: common.TransferTypeWithdraw, ID: "w1", Asset: "KNC", Amount: 4, Status: common.ExchangeStatusDone, Timestamp: from + hour},
+		{Type: common.TransferTypeDeposit, TxHash: "0x2cc", Asset: "KNC", Amount: 3.001, Status: common.ExchangeStatusDone, Timestamp: from + 3*hour},
+		{Type: common.TransferTypeWithdraw, ID: "w2", Asset: "KNC", Amount: 2, Status: common.ExchangeStatusPending, Timestamp: from + hour},
+		{Type: common.TransferTypeDeposit, TxHash: "0x11", Asset: "KNC", Amount: 6, Status: common.ExchangeStatusDone, Timestamp: from + 2*hour},
+		{Type: common.TransferTypeWithdraw, ID: "w3", Asset: "KNC", Amount: 4, Status: common.ExchangeStatusDone, Timestamp: from + hour},
+		// made without activity
+		{Type: common.TransferTypeDeposit, TxHash: "0xbb", Asset: "KNC", Amount: 1, Status: common.ExchangeStatusDone, Timestamp: from + 4*hour},
+	}
+	policy := common.ReconciliationPolicy{
+		LateAfter:       common.HumanDuration(time.Hour),
+		AmountTolerance: 0.001,
+	}
+
+	report := reconcileTransfers("binance", from, now, now, transfers, activities, map[uint64]string{1: "KNC"}, policy)
+	assert.Equal(t, 6, report.Matched)
+	require.Len(t, report.Items, 4)
+
+	byKind := make(map[string][]common.ReconciliationItem)
+	for _, item := range report.Items {
+		byKind[item.Kind] = append(byKind[item.Kind], item)
+	}
+	require.Len(t, byKind[common.ReconciliationMismatched], 1)
+	mismatched := byKind[common.ReconciliationMismatched][0]
+	assert.Equal(t, "w1", mismatched.ActivityID.EID)
+	assert.Equal(t, 4.0, mismatched.Transfer.Amount)
+
+	require.Len(t, byKind[common.ReconciliationLate], 2)
+	assert.Equal(t, "w2", byKind[common.ReconciliationLate][0].ActivityID.EID)
+	assert.NotNil(t, byKind[common.ReconciliationLate][0].Transfer)
+	assert.Equal(t, "d3", byKind[common.ReconciliationLate][1].ActivityID.EID)
+	assert.Nil(t, byKind[common.ReconciliationLate][1].Transfer)
+
+	require.Len(t, byKind[common.ReconciliationUnmatched], 1)
+	assert.Equal(t, "0xbb", byKind[common.ReconciliationUnmatched][0].Transfer.TxHash)
+	assert.Nil(t, byKind[common.ReconciliationUnmatched][0].ActivityID)
+}
+
+func TestRunReconciliationStops(t *testing.T) {
+	rc := NewReserveCore(testBlockchain{}, &recordingActivityStorage{}, testRiskLimitStorage{}, &common.ContractAddressConfiguration{})
+	rc.SetReconciliationPolicy(common.ReconciliationPolicy{Interval: common.HumanDuration(time.Millisecond)})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		rc.RunReconciliation(ctx, nil)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reconciliation is not stopped")
+	}
+}
@@ -28,22 +28,29 @@ const (
 
 // ReserveCore instance
 type ReserveCore struct {
-	blockchain      Blockchain
-	activityStorage ActivityStorage
-	addressConf     *common.ContractAddressConfiguration
-	l               *zap.SugaredLogger
+	blockchain       Blockchain
+	activityStorage  ActivityStorage
+	riskLimitStorage RiskLimitStorage
+	addressConf      *common.ContractAddressConfiguration
+	executions       *executionManager
+	reconciler       *reconciler
+	l                *zap.SugaredLogger
 }
 
 // NewReserveCore return reserve core
 func NewReserveCore(
 	blockchain Blockchain,
 	storage ActivityStorage,
+	riskLimitStorage RiskLimitStorage,
 	addressConf *common.ContractAddressConfiguration) *ReserveCore {
 	return &ReserveCore{
-		blockchain:      blockchain,
-		activityStorage: storage,
-		addressConf:     addressConf,
-		l:               zap.S(),
+		blockchain:       blockchain,
+		activityStorage:  storage,
+		riskLimitStorage: riskLimitStorage,
+		addressConf:      addressConf,
+		executions:       newExecutionManager(),
+		reconciler:       newReconciler(),
+		l:                zap.S(),
 	}
 }
 
@@ -73,6 +80,18 @@ func (rc ReserveCore) Trade(
 	pair commonv3.TradingPairSymbols,
 	rate float64,
 	amount float64) (common.ActivityID, float64, float64, bool, error) {
+	return rc.trade(exchange, tradeType, pair, rate, amount, nil)
+}
+
+// trade places an order on exchange, parent is id of the execution activity if the order
+// is a child order of an execution algorithm.
+func (rc ReserveCore) trade(
+	exchange common.Exchange,
+	tradeType string,
+	pair commonv3.TradingPairSymbols,
+	rate float64,
+	amount float64,
+	parent *common.ActivityID) (common.ActivityID, float64, float64, bool, error) {
 	var err error
 
 	timepoint := common.NowInMillis()
@@ -113,6 +132,7 @@ func (rc ReserveCore) Trade(
 				Rate:      rate,
 				Amount:    amount,
 				Timepoint: timepoint,
+				Parent:    parent,
 			},
 			activityResult,
 			status,
@@ -129,6 +149,14 @@ func (rc ReserveCore) Trade(
 		return common.ActivityID{}, 0, 0, false, err
 	}
 
+	if err = rc.checkTradeRiskLimit(exchange, pair, rate, amount); err != nil {
+		if sErr := recordActivity("", statusFailed, 0, 0, false, err); sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+			return common.ActivityID{}, 0, 0, false, common.CombineActivityStorageErrs(err, sErr)
+		}
+		return common.ActivityID{}, 0, 0, false, err
+	}
+
 	id, done, remaining, finished, err := exchange.Trade(tradeType, pair, rate, amount)
 	uid := timebasedID(id)
 	if err != nil {
@@ -239,6 +267,15 @@ func (rc ReserveCore) Deposit(
 		}
 		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
 	}
+
+	if err = rc.checkTransferRiskLimit(common.ActionDeposit, exchange, asset, amountFloat); err != nil {
+		sErr := recordActivity(statusFailed, "", 0, "", err)
+		if sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
 	if tx, err = rc.blockchain.Send(asset, amount, address); err != nil {
 		sErr := recordActivity(statusFailed, "", 0, "", err)
 		if sErr != nil {
@@ -312,6 +349,15 @@ func (rc ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset, a
 		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
 	}
 
+	amountFloat := common.BigToFloat(amount, int64(asset.Decimals))
+	if err = rc.checkTransferRiskLimit(common.ActionWithdraw, exchange, asset, amountFloat); err != nil {
+		sErr := activityRecord("", statusFailed, err)
+		if sErr != nil {
+			rc.l.Warnw("failed to store activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
 	reserveAddr := rc.addressConf.Reserve
 
 	id, err := exchange.Withdraw(asset, amount, reserveAddr)
@@ -327,6 +373,253 @@ func (rc ReserveCore) Withdraw(exchange common.Exchange, asset commonv3.Asset, a
 	return timebasedID(id), common.CombineActivityStorageErrs(err, sErr)
 }
 
+// Transfer withdraws token from an exchange directly to deposit address of another exchange. The deposit
+// address of an exchange depositing through an intermediator account, like Huobi, is the intermediator,
+// which forwards the withdrawal to the exchange like a deposit of the reserve.
+func (rc ReserveCore) Transfer(from, to common.Exchange, asset commonv3.Asset, amount *big.Int) (common.ActivityID, error) {
+	var (
+		err         error
+		timepoint   = common.NowInMillis()
+		amountFloat = common.BigToFloat(amount, int64(asset.Decimals))
+	)
+	activityRecord := func(id, status string, err error) error {
+		uid := timebasedID(id)
+		rc.l.Infof("Core ----------> Transfer from %s to %s: asset: %d, amount: %s, timestamp: %d ==> Result: id: %s, error: %v",
+			from.ID().String(), to.ID().String(), asset.ID, amount.Text(10), timepoint, id, err,
+		)
+		activityResult := common.ActivityResult{
+			ID: id,
+			// this field will be updated with real tx when data fetcher can fetch it
+			// from source exchange
+			Tx:    "",
+			Error: "",
+		}
+		if err != nil {
+			activityResult.Error = err.Error()
+		}
+		return rc.activityStorage.Record(
+			common.ActionTransfer,
+			uid,
+			from.ID().String(),
+			common.ActivityParams{
+				Exchange:            from.ID(),
+				DestinationExchange: to.ID(),
+				Asset:               asset.ID,
+				Amount:              amountFloat,
+				Timepoint:           timepoint,
+			},
+			activityResult,
+			status,
+			"",
+			timepoint,
+		)
+	}
+	failed := func(err error) (common.ActivityID, error) {
+		sErr := activityRecord("", statusFailed, err)
+		if sErr != nil {
+			rc.l.Warnw("failed to store activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
+	if from.ID() == to.ID() {
+		return failed(fmt.Errorf("can not transfer from %s to itself", from.ID().String()))
+	}
+	if _, supported := from.Address(asset); !supported {
+		return failed(fmt.Errorf("exchange %s doesn't support asset %d", from.ID().String(), asset.ID))
+	}
+	address, supported := to.Address(asset)
+	if !supported {
+		return failed(fmt.Errorf("exchange %s doesn't support asset %d", to.ID().String(), asset.ID))
+	}
+	if err = sanityCheckAmount(from, asset, amount); err != nil {
+		return failed(err)
+	}
+	if err = rc.checkTransferRiskLimit(common.ActionWithdraw, from, asset, amountFloat); err != nil {
+		return failed(err)
+	}
+	if err = rc.checkTransferRiskLimit(common.ActionDeposit, to, asset, amountFloat); err != nil {
+		return failed(err)
+	}
+
+	id, err := from.Withdraw(asset, amount, address)
+	if err != nil {
+		return failed(err)
+	}
+
+	sErr := activityRecord(id, statusSubmitted, nil)
+	return timebasedID(id), common.CombineActivityStorageErrs(err, sErr)
+}
+
+// InternalTransfer transfers asset instantly between two accounts of the same exchange,
+// e.g Binance master and sub accounts.
+func (rc ReserveCore) InternalTransfer(from, to common.Exchange, asset commonv3.Asset, amount *big.Int) (common.ActivityID, error) {
+	var (
+		err         error
+		timepoint   = common.NowInMillis()
+		amountFloat = common.BigToFloat(amount, int64(asset.Decimals))
+		executor    common.InternalTransferExchange
+	)
+	for _, exchange := range []common.Exchange{from, to} {
+		if transferExchange, ok := exchange.(common.InternalTransferExchange); ok && transferExchange.SupportInternalTransfer(from.ID(), to.ID()) {
+			executor = transferExchange
+			break
+		}
+	}
+	// destination of the activity is the exchange executing the transfer, so that data
+	// fetcher can query status of the transfer from it.
+	destination := from.ID().String()
+	if executor != nil {
+		destination = executor.(common.Exchange).ID().String()
+	}
+	activityRecord := func(id, status string, err error) error {
+		uid := timebasedID(id)
+		rc.l.Infof("Core ----------> Internal transfer from %s to %s: asset: %d, amount: %s, timestamp: %d ==> Result: id: %s, error: %v",
+			from.ID().String(), to.ID().String(), asset.ID, amount.Text(10), timepoint, id, err,
+		)
+		activityResult := common.ActivityResult{
+			ID:    id,
+			Error: "",
+		}
+		if err != nil {
+			activityResult.Error = err.Error()
+		}
+		return rc.activityStorage.Record(
+			common.ActionInternalTransfer,
+			uid,
+			destination,
+			common.ActivityParams{
+				Exchange:            from.ID(),
+				DestinationExchange: to.ID(),
+				Asset:               asset.ID,
+				Amount:              amountFloat,
+				Timepoint:           timepoint,
+			},
+			activityResult,
+			status,
+			"",
+			timepoint,
+		)
+	}
+	failed := func(err error) (common.ActivityID, error) {
+		sErr := activityRecord("", statusFailed, err)
+		if sErr != nil {
+			rc.l.Warnw("failed to store activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
+	if executor == nil {
+		return failed(fmt.Errorf("internal transfer from %s to %s is not supported", from.ID().String(), to.ID().String()))
+	}
+	if err = sanityCheckAmount(from, asset, amount); err != nil {
+		return failed(err)
+	}
+	if err = rc.checkTransferRiskLimit(common.ActionWithdraw, from, asset, amountFloat); err != nil {
+		return failed(err)
+	}
+	if err = rc.checkTransferRiskLimit(common.ActionDeposit, to, asset, amountFloat); err != nil {
+		return failed(err)
+	}
+
+	id, err := executor.InternalTransfer(from.ID(), to.ID(), asset, amount)
+	if err != nil {
+		return failed(err)
+	}
+
+	sErr := activityRecord(id, statusSubmitted, nil)
+	return timebasedID(id), common.CombineActivityStorageErrs(err, sErr)
+}
+
+// ReserveWithdraw withdraws token from reserve contract to a whitelisted address
+func (rc ReserveCore) ReserveWithdraw(
+	asset commonv3.Asset,
+	amount *big.Int,
+	address ethereum.Address) (common.ActivityID, error) {
+	var (
+		err         error
+		tx          *types.Transaction
+		timepoint   = common.NowInMillis()
+		amountFloat = common.BigToFloat(amount, int64(asset.Decimals))
+	)
+
+	uidGenerator := func(txhex string) common.ActivityID {
+		id := fmt.Sprintf("%s|%s|%s",
+			txhex,
+			asset.Symbol,
+			strconv.FormatFloat(amountFloat, 'f', -1, 64),
+		)
+		return timebasedID(id)
+	}
+	recordActivity := func(status, txhex string, txnonce uint64, txprice string, err error) error {
+		uid := uidGenerator(txhex)
+		rc.l.Infof(
+			"Core ----------> Reserve withdraw to %s: token: %s, amount: %s, timestamp: %d ==> Result: tx: %s, error: %v",
+			address.Hex(), asset.Symbol, amount.Text(10), timepoint, txhex, err,
+		)
+
+		activityResult := common.ActivityResult{
+			Tx:       txhex,
+			Nonce:    txnonce,
+			GasPrice: txprice,
+			Error:    "",
+		}
+
+		if err != nil {
+			activityResult.Error = err.Error()
+		}
+
+		return rc.activityStorage.Record(
+			common.ActionReserveWithdraw,
+			uid,
+			address.Hex(),
+			common.ActivityParams{
+				Asset:     asset.ID,
+				Amount:    amountFloat,
+				Timepoint: timepoint,
+			},
+			activityResult,
+			"",
+			status,
+			timepoint,
+		)
+	}
+
+	if amount.Sign() <= 0 {
+		err = errors.New("amount must be positive")
+		sErr := recordActivity(statusFailed, "", 0, "", err)
+		if sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
+	if err = rc.checkReserveWithdrawLimit(asset, amountFloat, address); err != nil {
+		sErr := recordActivity(statusFailed, "", 0, "", err)
+		if sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
+	if tx, err = rc.blockchain.Send(asset, amount, address); err != nil {
+		sErr := recordActivity(statusFailed, "", 0, "", err)
+		if sErr != nil {
+			rc.l.Warnw("failed to save activity record", "err", sErr)
+		}
+		return common.ActivityID{}, common.CombineActivityStorageErrs(err, sErr)
+	}
+
+	sErr := recordActivity(
+		statusSubmitted,
+		tx.Hash().Hex(),
+		tx.Nonce(),
+		tx.GasPrice().Text(10),
+		nil,
+	)
+	return uidGenerator(tx.Hash().Hex()), common.CombineActivityStorageErrs(err, sErr)
+}
+
 func calculateNewGasPrice(initPrice *big.Int, count uint64) *big.Int {
 	// in this case after 5 tries the tx is still not mined.
 	// at this point, 100.1 gwei is not enough but it doesn't matter
@@ -105,6 +105,23 @@ func (tas testActivityStorage) GetActivity(id common.ActivityID) (common.Activit
 	return common.ActivityRecord{}, nil
 }
 
+func (tas testActivityStorage) GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error) {
+	return nil, nil
+}
+
+func (tas testActivityStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
+	return nil, nil
+}
+
+func (tas testActivityStorage) UpdateActivity(id common.ActivityID, activity common.ActivityRecord, update common.ActivityUpdate) error {
+	return nil
+}
+
+func (tas testActivityStorage) ModifyActivity(id common.ActivityID, update common.ActivityUpdate,
+	modify func(common.ActivityRecord) (common.ActivityRecord, error)) (common.ActivityRecord, error) {
+	return common.ActivityRecord{}, nil
+}
+
 func (tas testActivityStorage) PendingSetRate(minedNonce uint64) (*common.ActivityRecord, uint64, error) {
 	return nil, 0, nil
 }
@@ -122,6 +139,7 @@ func getTestCore(hasPendingDeposit bool) *ReserveCore {
 	return NewReserveCore(
 		testBlockchain{},
 		testActivityStorage{hasPendingDeposit},
+		testRiskLimitStorage{},
 		addressSetting,
 	)
 }
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// riskLimitWindow is the time window daily limits are calculated on.
+const riskLimitWindow = uint64(24 * time.Hour / time.Millisecond)
+
+func errRiskLimitExceeded(format string, args ...interface{}) error {
+	return fmt.Errorf("risk limit exceeded: "+format, args...)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// getRiskLimit returns the risk limit of asset on exchange, an empty limit is returned if
+// nothing is configured.
+func (rc ReserveCore) getRiskLimit(assetID, exchangeID uint64) (commonv3.RiskLimit, error) {
+	limit, err := rc.riskLimitStorage.GetRiskLimit(assetID, exchangeID)
+	if err == commonv3.ErrNotFound {
+		return commonv3.RiskLimit{AssetID: assetID, ExchangeID: exchangeID}, nil
+	}
+	return limit, err
+}
+
+func (rc ReserveCore) dailyRecords() ([]common.ActivityRecord, error) {
+	toTime := common.NowInMillis()
+	return rc.activityStorage.GetAllRecords(toTime-riskLimitWindow, toTime)
+}
+
+func isFailedActivity(activity common.ActivityRecord) bool {
+	return activity.ExchangeStatus == common.ExchangeStatusFailed || activity.MiningStatus == common.MiningStatusFailed
+}
+
+// tradeVolume returns the base amount of all not failed orders of base symbol on exchange.
+func tradeVolume(records []common.ActivityRecord, exchangeID common.ExchangeID, baseSymbol string) float64 {
+	var volume float64
+	for _, activity := range records {
+		if activity.Action != common.ActionTrade || isFailedActivity(activity) {
+			continue
+		}
+		if activity.Params.Exchange == exchangeID && activity.Params.Base == baseSymbol {
+			volume += activity.Params.Amount
+		}
+	}
+	return volume
+}
+
+// transferAmount returns the amount of all not failed deposits or withdrawals of asset on exchange.
+// Transfers between exchanges or accounts are counted as withdrawals of source and deposits of destination exchange.
+func transferAmount(records []common.ActivityRecord, action string, exchangeID common.ExchangeID, assetID uint64) float64 {
+	var amount float64
+	for _, activity := range records {
+		if isFailedActivity(activity) || activity.Params.Asset != assetID {
+			continue
+		}
+		isTransfer := activity.Action == common.ActionTransfer || activity.Action == common.ActionInternalTransfer
+		switch {
+		case activity.Action == action && activity.Params.Exchange == exchangeID:
+			amount += activity.Params.Amount
+		case isTransfer && action == common.ActionWithdraw && activity.Params.Exchange == exchangeID:
+			amount += activity.Params.Amount
+		case isTransfer && action == common.ActionDeposit && activity.Params.DestinationExchange == exchangeID:
+			amount += activity.Params.Amount
+		}
+	}
+	return amount
+}
+
+// openOrders returns number of pending orders on exchange.
+func openOrders(pendings []common.ActivityRecord, exchangeID common.ExchangeID) uint64 {
+	var count uint64
+	for _, activity := range pendings {
+		if activity.Action == common.ActionTrade && activity.Params.Exchange == exchangeID {
+			count++
+		}
+	}
+	return count
+}
+
+// checkTradeRiskLimit returns an error if placing the order would exceed open orders,
+// order notional or daily trade volume limits.
+func (rc ReserveCore) checkTradeRiskLimit(exchange common.Exchange, pair commonv3.TradingPairSymbols, rate, amount float64) error {
+	ex, err := rc.riskLimitStorage.GetExchange(uint64(exchange.ID()))
+	if err != nil {
+		return err
+	}
+	if ex.MaxOpenOrders != 0 {
+		pendings, err := rc.activityStorage.GetPendingActivities()
+		if err != nil {
+			return err
+		}
+		if count := openOrders(pendings, exchange.ID()); count >= ex.MaxOpenOrders {
+			return errRiskLimitExceeded("there are %d open orders on %s, max_open_orders is %d",
+				count, exchange.ID().String(), ex.MaxOpenOrders)
+		}
+	}
+
+	limit, err := rc.getRiskLimit(pair.Base, uint64(exchange.ID()))
+	if err != nil {
+		return err
+	}
+	if notional := rate * amount; limit.MaxOrderNotional != 0 && notional > limit.MaxOrderNotional {
+		return errRiskLimitExceeded("order notional %s is bigger than max_order_notional %s of %s on %s",
+			formatFloat(notional), formatFloat(limit.MaxOrderNotional), pair.BaseSymbol, exchange.ID().String())
+	}
+	if limit.MaxDailyTradeVolume != 0 {
+		records, err := rc.dailyRecords()
+		if err != nil {
+			return err
+		}
+		volume := tradeVolume(records, exchange.ID(), pair.BaseSymbol)
+		if volume+amount > limit.MaxDailyTradeVolume {
+			return errRiskLimitExceeded("%s traded in 24 hours on %s would be %s, max_daily_trade_volume is %s",
+				pair.BaseSymbol, exchange.ID().String(), formatFloat(volume+amount), formatFloat(limit.MaxDailyTradeVolume))
+		}
+	}
+	return nil
+}
+
+// checkTransferRiskLimit returns an error if the deposit or withdraw of amount would exceed
+// single or daily transfer limits.
+func (rc ReserveCore) checkTransferRiskLimit(action string, exchange common.Exchange, asset commonv3.Asset, amount float64) error {
+	limit, err := rc.getRiskLimit(asset.ID, uint64(exchange.ID()))
+	if err != nil {
+		return err
+	}
+	maxAmount, maxDailyAmount := limit.MaxWithdrawAmount, limit.MaxDailyWithdrawAmount
+	if action == common.ActionDeposit {
+		maxAmount, maxDailyAmount = limit.MaxDepositAmount, limit.MaxDailyDepositAmount
+	}
+	if maxAmount != 0 && amount > maxAmount {
+		return errRiskLimitExceeded("%s amount %s of %s is bigger than max_%s_amount %s on %s",
+			action, formatFloat(amount), asset.Symbol, action, formatFloat(maxAmount), exchange.ID().String())
+	}
+	if maxDailyAmount != 0 {
+		records, err := rc.dailyRecords()
+		if err != nil {
+			return err
+		}
+		total := transferAmount(records, action, exchange.ID(), asset.ID) + amount
+		if total > maxDailyAmount {
+			return errRiskLimitExceeded("%s amount of %s in 24 hours on %s would be %s, max_daily_%s_amount is %s",
+				action, asset.Symbol, exchange.ID().String(), formatFloat(total), action, formatFloat(maxDailyAmount))
+		}
+	}
+	return nil
+}
+
+// reserveWithdrawAmount returns the amount of all not failed reserve withdrawals of asset to address.
+func reserveWithdrawAmount(records []common.ActivityRecord, address ethereum.Address, assetID uint64) float64 {
+	var amount float64
+	for _, activity := range records {
+		if activity.Action != common.ActionReserveWithdraw || isFailedActivity(activity) {
+			continue
+		}
+		if activity.Destination == address.Hex() && activity.Params.Asset == assetID {
+			amount += activity.Params.Amount
+		}
+	}
+	return amount
+}
+
+// checkReserveWithdrawLimit returns an error if address is not whitelisted for asset or the
+// withdrawal would exceed amount limits of the whitelisted address.
+func (rc ReserveCore) checkReserveWithdrawLimit(asset commonv3.Asset, amount float64, address ethereum.Address) error {
+	withdrawAddress, err := rc.riskLimitStorage.GetWithdrawAddress(address, asset.ID)
+	if err == commonv3.ErrNotFound {
+		return fmt.Errorf("address %s is not whitelisted for %s", address.Hex(), asset.Symbol)
+	}
+	if err != nil {
+		return err
+	}
+	if withdrawAddress.MaxAmount != 0 && amount > withdrawAddress.MaxAmount {
+		return errRiskLimitExceeded("withdraw amount %s of %s is bigger than max_amount %s of %s",
+			formatFloat(amount), asset.Symbol, formatFloat(withdrawAddress.MaxAmount), address.Hex())
+	}
+	if withdrawAddress.MaxDailyAmount != 0 {
+		records, err := rc.dailyRecords()
+		if err != nil {
+			return err
+		}
+		total := reserveWithdrawAmount(records, address, asset.ID) + amount
+		if total > withdrawAddress.MaxDailyAmount {
+			return errRiskLimitExceeded("%s withdrawn to %s in 24 hours would be %s, max_daily_amount is %s",
+				asset.Symbol, address.Hex(), formatFloat(total), formatFloat(withdrawAddress.MaxDailyAmount))
+		}
+	}
+	return nil
+}
+
+// GetRiskLimitUtilisation returns all configured risk limits with their utilisation.
+func (rc ReserveCore) GetRiskLimitUtilisation() (common.RiskLimitUtilisation, error) {
+	var result common.RiskLimitUtilisation
+	records, err := rc.dailyRecords()
+	if err != nil {
+		return result, err
+	}
+	pendings, err := rc.activityStorage.GetPendingActivities()
+	if err != nil {
+		return result, err
+	}
+
+	limits, err := rc.riskLimitStorage.GetRiskLimits()
+	if err != nil {
+		return result, err
+	}
+	result.Assets = make([]common.AssetRiskLimitUtilisation, 0, len(limits))
+	for _, limit := range limits {
+		asset, err := rc.riskLimitStorage.GetAsset(limit.AssetID)
+		if err != nil {
+			return result, err
+		}
+		exchangeID := common.ExchangeID(limit.ExchangeID)
+		utilisation := common.AssetRiskLimitUtilisation{
+			RiskLimit:           limit,
+			DailyWithdrawAmount: transferAmount(records, common.ActionWithdraw, exchangeID, asset.ID),
+			DailyDepositAmount:  transferAmount(records, common.ActionDeposit, exchangeID, asset.ID),
+		}
+		for _, assetExchange := range asset.Exchanges {
+			if assetExchange.ExchangeID == limit.ExchangeID {
+				utilisation.DailyTradeVolume = tradeVolume(records, exchangeID, assetExchange.Symbol)
+			}
+		}
+		result.Assets = append(result.Assets, utilisation)
+	}
+
+	exchanges, err := rc.riskLimitStorage.GetExchanges()
+	if err != nil {
+		return result, err
+	}
+	result.Exchanges = make([]common.ExchangeRiskLimitUtilisation, 0, len(exchanges))
+	for _, ex := range exchanges {
+		result.Exchanges = append(result.Exchanges, common.ExchangeRiskLimitUtilisation{
+			ExchangeID:    ex.ID,
+			MaxOpenOrders: ex.MaxOpenOrders,
+			OpenOrders:    openOrders(pendings, common.ExchangeID(ex.ID)),
+		})
+	}
+	return result, nil
+}
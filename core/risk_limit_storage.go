@@ -0,0 +1,22 @@
+package core
+
+import (
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// RiskLimitStorage is the interface to read risk limits configuration which core
+// enforces before calling exchanges or moving funds out of reserve.
+type RiskLimitStorage interface {
+	GetAsset(id uint64) (commonv3.Asset, error)
+	GetExchange(id uint64) (commonv3.Exchange, error)
+	GetExchanges() ([]commonv3.Exchange, error)
+	GetRiskLimits() ([]commonv3.RiskLimit, error)
+	// GetRiskLimit returns commonv3.ErrNotFound if there is no limit configured.
+	GetRiskLimit(assetID, exchangeID uint64) (commonv3.RiskLimit, error)
+	// GetWithdrawAddress returns commonv3.ErrNotFound if the address is not whitelisted for the asset.
+	GetWithdrawAddress(address ethereum.Address, assetID uint64) (commonv3.WithdrawAddress, error)
+	// GetTradingPair returns the trading pair of an execution being resumed.
+	GetTradingPair(id uint64) (commonv3.TradingPairSymbols, error)
+}
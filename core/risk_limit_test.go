@@ -0,0 +1,392 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testRiskLimitStorage struct {
+	maxOpenOrders     uint64
+	limits            []commonv3.RiskLimit
+	withdrawAddresses []commonv3.WithdrawAddress
+}
+
+func (trs testRiskLimitStorage) GetAsset(id uint64) (commonv3.Asset, error) {
+	return commonv3.Asset{
+		ID:       id,
+		Symbol:   "KNC",
+		Decimals: 18,
+		Exchanges: []commonv3.AssetExchange{
+			{AssetID: id, ExchangeID: uint64(common.Binance), Symbol: "KNC"},
+		},
+	}, nil
+}
+
+func (trs testRiskLimitStorage) GetExchange(id uint64) (commonv3.Exchange, error) {
+	return commonv3.Exchange{ID: id, MaxOpenOrders: trs.maxOpenOrders}, nil
+}
+
+func (trs testRiskLimitStorage) GetExchanges() ([]commonv3.Exchange, error) {
+	return []commonv3.Exchange{{ID: uint64(common.Binance), MaxOpenOrders: trs.maxOpenOrders}}, nil
+}
+
+func (trs testRiskLimitStorage) GetRiskLimits() ([]commonv3.RiskLimit, error) {
+	return trs.limits, nil
+}
+
+func (trs testRiskLimitStorage) GetRiskLimit(assetID, exchangeID uint64) (commonv3.RiskLimit, error) {
+	for _, limit := range trs.limits {
+		if limit.AssetID == assetID && limit.ExchangeID == exchangeID {
+			return limit, nil
+		}
+	}
+	return commonv3.RiskLimit{}, commonv3.ErrNotFound
+}
+
+func (trs testRiskLimitStorage) GetTradingPair(id uint64) (commonv3.TradingPairSymbols, error) {
+	return commonv3.TradingPairSymbols{
+		TradingPair: commonv3.TradingPair{ID: id, Base: 2, Quote: 1, AmountPrecision: 2},
+		BaseSymbol:  "KNC",
+		QuoteSymbol: "ETH",
+	}, nil
+}
+
+func (trs testRiskLimitStorage) GetWithdrawAddress(address ethereum.Address, assetID uint64) (commonv3.WithdrawAddress, error) {
+	for _, withdrawAddress := range trs.withdrawAddresses {
+		if withdrawAddress.Address == address && withdrawAddress.AssetID == assetID {
+			return withdrawAddress, nil
+		}
+	}
+	return commonv3.WithdrawAddress{}, commonv3.ErrNotFound
+}
+
+// recordingActivityStorage keeps all recorded activities in memory.
+type recordingActivityStorage struct {
+	testActivityStorage
+	mu      sync.Mutex
+	records []common.ActivityRecord
+}
+
+func (ras *recordingActivityStorage) Record(action string, id common.ActivityID, destination string,
+	params common.ActivityParams, result common.ActivityResult, estatus string, mstatus string, timepoint uint64) error {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	ras.records = append(ras.records, common.NewActivityRecord(action, id, destination, params, result,
+		estatus, mstatus, common.Timestamp("0")))
+	return nil
+}
+
+func (ras *recordingActivityStorage) GetActivity(id common.ActivityID) (common.ActivityRecord, error) {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	for _, activity := range ras.records {
+		if activity.ID == id {
+			return activity, nil
+		}
+	}
+	return common.ActivityRecord{}, errors.New("activity not found")
+}
+
+func (ras *recordingActivityStorage) UpdateActivity(id common.ActivityID, activity common.ActivityRecord, update common.ActivityUpdate) error {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	for i := range ras.records {
+		if ras.records[i].ID == id {
+			ras.records[i] = activity
+		}
+	}
+	return nil
+}
+
+func (ras *recordingActivityStorage) ModifyActivity(id common.ActivityID, update common.ActivityUpdate,
+	modify func(common.ActivityRecord) (common.ActivityRecord, error)) (common.ActivityRecord, error) {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	for i := range ras.records {
+		if ras.records[i].ID != id {
+			continue
+		}
+		next, err := modify(ras.records[i])
+		if err != nil {
+			return common.ActivityRecord{}, err
+		}
+		if _, err = ras.records[i].Transition(next, update, common.NowInMillis()); err != nil {
+			return common.ActivityRecord{}, err
+		}
+		ras.records[i] = next
+		return next, nil
+	}
+	return common.ActivityRecord{}, errors.New("activity not found")
+}
+
+func (ras *recordingActivityStorage) GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error) {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	return append([]common.ActivityRecord{}, ras.records...), nil
+}
+
+func (ras *recordingActivityStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
+	ras.mu.Lock()
+	defer ras.mu.Unlock()
+	var pendings []common.ActivityRecord
+	for _, activity := range ras.records {
+		if activity.IsPending() {
+			pendings = append(pendings, activity)
+		}
+	}
+	return pendings, nil
+}
+
+func TestTradeRiskLimit(t *testing.T) {
+	var (
+		storage = &recordingActivityStorage{}
+		pair    = commonv3.TradingPairSymbols{
+			TradingPair: commonv3.TradingPair{ID: 1, Base: 2, Quote: 1},
+			BaseSymbol:  "KNC",
+			QuoteSymbol: "ETH",
+		}
+	)
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{
+		maxOpenOrders: 2,
+		limits: []commonv3.RiskLimit{{
+			AssetID:             2,
+			ExchangeID:          uint64(common.Binance),
+			MaxOrderNotional:    10,
+			MaxDailyTradeVolume: 150,
+		}},
+	}, &common.ContractAddressConfiguration{})
+
+	_, _, _, _, err := rc.Trade(testExchange{}, "buy", pair, 0.1, 200)
+	require.Error(t, err, "order notional is bigger than max_order_notional")
+	require.Len(t, storage.records, 1)
+	assert.Equal(t, common.ExchangeStatusFailed, storage.records[0].ExchangeStatus)
+	assert.Contains(t, storage.records[0].Result.Error, "max_order_notional")
+
+	_, _, _, _, err = rc.Trade(testExchange{}, "buy", pair, 0.1, 80)
+	require.NoError(t, err)
+	_, _, _, _, err = rc.Trade(testExchange{}, "buy", pair, 0.1, 80)
+	require.Error(t, err, "daily trade volume is exceeded")
+	assert.Contains(t, err.Error(), "max_daily_trade_volume")
+
+	_, _, _, _, err = rc.Trade(testExchange{}, "buy", pair, 0.1, 50)
+	require.NoError(t, err)
+	_, _, _, _, err = rc.Trade(testExchange{}, "buy", pair, 0.1, 1)
+	require.Error(t, err, "there are already 2 open orders")
+	assert.Contains(t, err.Error(), "max_open_orders")
+
+	utilisation, err := rc.GetRiskLimitUtilisation()
+	require.NoError(t, err)
+	require.Len(t, utilisation.Assets, 1)
+	assert.Equal(t, float64(130), utilisation.Assets[0].DailyTradeVolume)
+	require.Len(t, utilisation.Exchanges, 1)
+	assert.Equal(t, uint64(2), utilisation.Exchanges[0].OpenOrders)
+}
+
+func TestWithdrawRiskLimit(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{
+		limits: []commonv3.RiskLimit{{
+			AssetID:                2,
+			ExchangeID:             uint64(common.Binance),
+			MaxWithdrawAmount:      10,
+			MaxDailyWithdrawAmount: 15,
+		}},
+	}, &common.ContractAddressConfiguration{})
+	asset, err := testRiskLimitStorage{}.GetAsset(2)
+	require.NoError(t, err)
+
+	_, err = rc.Withdraw(testExchange{}, asset, common.EthToWei(11))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_withdraw_amount")
+
+	_, err = rc.Withdraw(testExchange{}, asset, common.EthToWei(10))
+	require.NoError(t, err)
+	_, err = rc.Withdraw(testExchange{}, asset, common.EthToWei(6))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_daily_withdraw_amount")
+
+	// other assets are not limited
+	asset.ID = 3
+	_, err = rc.Withdraw(testExchange{}, asset, big.NewInt(0).Mul(common.EthToWei(1), big.NewInt(100)))
+	require.NoError(t, err)
+}
+
+func TestReserveWithdraw(t *testing.T) {
+	var (
+		storage     = &recordingActivityStorage{}
+		coldWallet  = ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+		unknownAddr = ethereum.HexToAddress("0x2222222222222222222222222222222222222222")
+	)
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{
+		withdrawAddresses: []commonv3.WithdrawAddress{{
+			ID:             1,
+			Address:        coldWallet,
+			AssetID:        2,
+			MaxAmount:      10,
+			MaxDailyAmount: 15,
+		}},
+	}, &common.ContractAddressConfiguration{})
+	asset, err := testRiskLimitStorage{}.GetAsset(2)
+	require.NoError(t, err)
+
+	_, err = rc.ReserveWithdraw(asset, common.EthToWei(1), unknownAddr)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not whitelisted")
+
+	_, err = rc.ReserveWithdraw(asset, common.EthToWei(11), coldWallet)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_amount")
+
+	_, err = rc.ReserveWithdraw(asset, common.EthToWei(10), coldWallet)
+	require.NoError(t, err)
+	_, err = rc.ReserveWithdraw(asset, common.EthToWei(6), coldWallet)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_daily_amount")
+
+	require.Len(t, storage.records, 4)
+	submitted := storage.records[2]
+	assert.Equal(t, common.ActionReserveWithdraw, submitted.Action)
+	assert.Equal(t, coldWallet.Hex(), submitted.Destination)
+	assert.Equal(t, common.MiningStatusSubmitted, submitted.MiningStatus)
+	assert.True(t, submitted.IsPending())
+	assert.True(t, submitted.IsBlockchainPending())
+	assert.False(t, submitted.IsExchangePending())
+}
+
+type testDestinationExchange struct {
+	testExchange
+}
+
+func (te testDestinationExchange) ID() common.ExchangeID {
+	return common.Huobi
+}
+
+var testIntermediatorAddress = ethereum.HexToAddress("0x3333333333333333333333333333333333333333")
+
+// testIntermediatedExchange is an exchange whose deposit address is the intermediator, like Huobi.
+type testIntermediatedExchange struct {
+	testDestinationExchange
+}
+
+func (te testIntermediatedExchange) Address(_ commonv3.Asset) (ethereum.Address, bool) {
+	return testIntermediatorAddress, true
+}
+
+// testSourceExchange records the address it withdraws to.
+type testSourceExchange struct {
+	testExchange
+	withdrawn *ethereum.Address
+}
+
+func (te testSourceExchange) Withdraw(token commonv3.Asset, amount *big.Int, address ethereum.Address) (string, error) {
+	*te.withdrawn = address
+	return "withdrawid", nil
+}
+
+func TestTransfer(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{
+		limits: []commonv3.RiskLimit{{
+			AssetID:               2,
+			ExchangeID:            uint64(common.Huobi),
+			MaxDailyDepositAmount: 15,
+		}},
+	}, &common.ContractAddressConfiguration{})
+	asset, err := testRiskLimitStorage{}.GetAsset(2)
+	require.NoError(t, err)
+
+	_, err = rc.Transfer(testExchange{}, testExchange{}, asset, common.EthToWei(1))
+	require.Error(t, err)
+
+	id, err := rc.Transfer(testExchange{}, testDestinationExchange{}, asset, common.EthToWei(10))
+	require.NoError(t, err)
+	assert.Equal(t, "withdrawid", id.EID)
+
+	transfer := storage.records[1]
+	assert.Equal(t, common.ActionTransfer, transfer.Action)
+	assert.Equal(t, common.Binance, transfer.Params.Exchange)
+	assert.Equal(t, common.Huobi, transfer.Params.DestinationExchange)
+	assert.Equal(t, common.ExchangeStatusSubmitted, transfer.ExchangeStatus)
+	assert.True(t, transfer.IsPending())
+
+	// transfer to Huobi is withdrawn to the intermediator, which forwards it to Huobi
+	var withdrawn ethereum.Address
+	_, err = rc.Transfer(testSourceExchange{withdrawn: &withdrawn}, testIntermediatedExchange{}, asset, common.EthToWei(1))
+	require.NoError(t, err)
+	assert.Equal(t, testIntermediatorAddress, withdrawn)
+	transfer = storage.records[2]
+	assert.Equal(t, common.Huobi, transfer.Params.DestinationExchange)
+	assert.Equal(t, common.ExchangeStatusSubmitted, transfer.ExchangeStatus)
+
+	// transfer is counted as deposit of destination exchange
+	_, err = rc.Transfer(testExchange{}, testDestinationExchange{}, asset, common.EthToWei(6))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_daily_deposit_amount")
+}
+
+type testMasterAccountExchange struct {
+	testExchange
+}
+
+func (te testMasterAccountExchange) SupportInternalTransfer(from, to common.ExchangeID) bool {
+	return from == common.Binance && to == common.Binance2 || from == common.Binance2 && to == common.Binance
+}
+
+func (te testMasterAccountExchange) InternalTransfer(from, to common.ExchangeID, asset commonv3.Asset, amount *big.Int) (string, error) {
+	return "tranid", nil
+}
+
+func (te testMasterAccountExchange) InternalTransferStatus(ctx context.Context, id string, timepoint uint64) (string, error) {
+	return common.ExchangeStatusDone, nil
+}
+
+type testSubAccountExchange struct {
+	testExchange
+}
+
+func (te testSubAccountExchange) ID() common.ExchangeID {
+	return common.Binance2
+}
+
+func TestInternalTransfer(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{
+		limits: []commonv3.RiskLimit{{
+			AssetID:                2,
+			ExchangeID:             uint64(common.Binance2),
+			MaxDailyWithdrawAmount: 15,
+		}},
+	}, &common.ContractAddressConfiguration{})
+	asset, err := testRiskLimitStorage{}.GetAsset(2)
+	require.NoError(t, err)
+
+	_, err = rc.InternalTransfer(testExchange{}, testDestinationExchange{}, asset, common.EthToWei(1))
+	require.Error(t, err)
+
+	id, err := rc.InternalTransfer(testSubAccountExchange{}, testMasterAccountExchange{}, asset, common.EthToWei(10))
+	require.NoError(t, err)
+	assert.Equal(t, "tranid", id.EID)
+
+	transfer := storage.records[1]
+	assert.Equal(t, common.ActionInternalTransfer, transfer.Action)
+	// status of the transfer is fetched from the exchange executing it
+	assert.Equal(t, common.Binance.String(), transfer.Destination)
+	assert.Equal(t, common.Binance2, transfer.Params.Exchange)
+	assert.Equal(t, common.Binance, transfer.Params.DestinationExchange)
+	assert.True(t, transfer.IsExchangePending())
+
+	// internal transfer is counted as withdrawal of source account
+	_, err = rc.InternalTransfer(testSubAccountExchange{}, testMasterAccountExchange{}, asset, common.EthToWei(6))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max_daily_withdraw_amount")
+}
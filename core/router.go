@@ -0,0 +1,126 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+type routeLevel struct {
+	venue    int
+	price    float64
+	quantity float64
+	// effective is the price including taker fee
+	effective float64
+}
+
+// PlanRoute splits amount of base asset to buy or sell across venues, taking the best order
+// book levels of all venues after taker fees first, and never spending more than available
+// balance of each venue.
+func PlanRoute(venues []common.RouteVenue, side string, amount float64) (common.RoutePlan, error) {
+	plan := common.RoutePlan{Side: side, Amount: amount}
+	var levels []routeLevel
+	for i, venue := range venues {
+		entries := venue.Book.Asks
+		fee := 1 + venue.TakerFee
+		if side == "sell" {
+			entries = venue.Book.Bids
+			fee = 1 - venue.TakerFee
+		}
+		for _, entry := range entries {
+			levels = append(levels, routeLevel{
+				venue:     i,
+				price:     entry.Rate,
+				quantity:  entry.Quantity,
+				effective: entry.Rate * fee,
+			})
+		}
+	}
+	switch side {
+	case "buy":
+		sort.SliceStable(levels, func(i, j int) bool { return levels[i].effective < levels[j].effective })
+	case "sell":
+		sort.SliceStable(levels, func(i, j int) bool { return levels[i].effective > levels[j].effective })
+	default:
+		return plan, fmt.Errorf("side %s is not supported", side)
+	}
+
+	var (
+		amounts   = make([]float64, len(venues))
+		costs     = make([]float64, len(venues))
+		rates     = make([]float64, len(venues))
+		spent     = make([]float64, len(venues))
+		remaining = amount
+	)
+	for _, level := range levels {
+		if remaining < executionEpsilon {
+			break
+		}
+		quantity := level.quantity
+		if quantity > remaining {
+			quantity = remaining
+		}
+		// buy order spends quote asset, sell order spends base asset
+		available := venues[level.venue].Available - spent[level.venue]
+		if side == "buy" {
+			available /= level.effective
+		}
+		if quantity > available {
+			quantity = available
+		}
+		if quantity < executionEpsilon {
+			continue
+		}
+		if side == "buy" {
+			spent[level.venue] += quantity * level.effective
+		} else {
+			spent[level.venue] += quantity
+		}
+		amounts[level.venue] += quantity
+		costs[level.venue] += quantity * level.effective
+		rates[level.venue] = level.price
+		remaining -= quantity
+	}
+
+	for i, venue := range venues {
+		filled := roundAmount(amounts[i], venue.Pair.AmountPrecision)
+		if filled <= 0 || filled < venue.Pair.AmountLimitMin || filled*rates[i] < venue.Pair.MinNotional {
+			continue
+		}
+		plan.Fills = append(plan.Fills, common.RouteFill{
+			Exchange:     venue.Exchange.ID(),
+			PairID:       venue.Pair.ID,
+			Amount:       filled,
+			Rate:         rates[i],
+			AveragePrice: costs[i] / amounts[i],
+		})
+		plan.Routed += filled
+	}
+	return plan, nil
+}
+
+// RouteTrade plans the order across venues and places a trade on each exchange of the plan,
+// with dryRun the plan is returned without placing any trade.
+func (rc ReserveCore) RouteTrade(venues []common.RouteVenue, side string, amount float64, dryRun bool) (common.RoutePlan, error) {
+	plan, err := PlanRoute(venues, side, amount)
+	if err != nil || dryRun {
+		return plan, err
+	}
+	for i, fill := range plan.Fills {
+		var venue common.RouteVenue
+		for _, v := range venues {
+			if v.Exchange.ID() == fill.Exchange {
+				venue = v
+			}
+		}
+		id, _, _, _, err := rc.Trade(venue.Exchange, side, venue.Pair, fill.Rate, fill.Amount)
+		if err != nil {
+			rc.l.Warnw("failed to place routed trade", "exchange", fill.Exchange.String(), "err", err)
+			plan.Fills[i].Error = err.Error()
+			continue
+		}
+		plan.Fills[i].ActivityID = &id
+	}
+	return plan, nil
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// testRouteExchange is a testExecutionExchange with configurable exchange ID.
+type testRouteExchange struct {
+	testExecutionExchange
+	id common.ExchangeID
+}
+
+func (te *testRouteExchange) ID() common.ExchangeID {
+	return te.id
+}
+
+func testRouteVenues(binance, huobi common.Exchange) []common.RouteVenue {
+	return []common.RouteVenue{
+		{
+			Exchange: binance,
+			Pair:     testExecutionPair,
+			Book: common.ExchangePrice{
+				Valid: true,
+				Bids:  []common.PriceEntry{{Rate: 0.0099, Quantity: 5}, {Rate: 0.0098, Quantity: 10}},
+				Asks:  []common.PriceEntry{{Rate: 0.0100, Quantity: 4}, {Rate: 0.0102, Quantity: 10}},
+			},
+			TakerFee:  0.001,
+			Available: 1,
+		},
+		{
+			Exchange: huobi,
+			Pair:     testExecutionPair,
+			Book: common.ExchangePrice{
+				Valid: true,
+				Bids:  []common.PriceEntry{{Rate: 0.0099, Quantity: 3}, {Rate: 0.0097, Quantity: 10}},
+				Asks:  []common.PriceEntry{{Rate: 0.0100, Quantity: 3}, {Rate: 0.0101, Quantity: 10}},
+			},
+			TakerFee:  0.002,
+			Available: 4,
+		},
+	}
+}
+
+func TestPlanRoute(t *testing.T) {
+	venues := testRouteVenues(&testRouteExchange{id: common.Binance}, &testRouteExchange{id: common.Huobi})
+
+	// binance best ask is cheaper after fee, huobi second level is cheaper than binance's
+	plan, err := PlanRoute(venues, "buy", 10)
+	require.NoError(t, err)
+	require.Len(t, plan.Fills, 2)
+	assert.Equal(t, common.Binance, plan.Fills[0].Exchange)
+	assert.Equal(t, float64(4), plan.Fills[0].Amount)
+	assert.Equal(t, 0.0100, plan.Fills[0].Rate)
+	assert.Equal(t, common.Huobi, plan.Fills[1].Exchange)
+	assert.Equal(t, float64(6), plan.Fills[1].Amount)
+	assert.Equal(t, 0.0101, plan.Fills[1].Rate)
+	assert.Equal(t, float64(10), plan.Routed)
+
+	// sell is capped by available base balance of each exchange
+	plan, err = PlanRoute(venues, "sell", 10)
+	require.NoError(t, err)
+	require.Len(t, plan.Fills, 2)
+	assert.Equal(t, float64(1), plan.Fills[0].Amount)
+	assert.Equal(t, float64(4), plan.Fills[1].Amount)
+	assert.Equal(t, 0.0097, plan.Fills[1].Rate)
+	assert.Equal(t, float64(5), plan.Routed)
+
+	_, err = PlanRoute(venues, "hold", 10)
+	require.Error(t, err)
+}
+
+func TestRouteTrade(t *testing.T) {
+	storage := &recordingActivityStorage{}
+	rc := newTestExecutionCore(storage)
+	binance := &testRouteExchange{id: common.Binance}
+	huobi := &testRouteExchange{id: common.Huobi}
+	venues := testRouteVenues(binance, huobi)
+
+	plan, err := rc.RouteTrade(venues, "buy", 10, true)
+	require.NoError(t, err)
+	require.Len(t, plan.Fills, 2)
+	assert.Nil(t, plan.Fills[0].ActivityID)
+	assert.Empty(t, binance.amounts())
+	assert.Empty(t, huobi.amounts())
+
+	plan, err = rc.RouteTrade(venues, "buy", 10, false)
+	require.NoError(t, err)
+	require.Len(t, plan.Fills, 2)
+	assert.Equal(t, []float64{4}, binance.amounts())
+	assert.Equal(t, []float64{6}, huobi.amounts())
+	for _, fill := range plan.Fills {
+		require.NotNil(t, fill.ActivityID)
+		assert.Empty(t, fill.Error)
+	}
+	records, err := storage.GetAllRecords(0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for i, record := range records {
+		assert.Equal(t, common.ActionTrade, record.Action)
+		assert.Equal(t, plan.Fills[i].Exchange.String(), record.Destination)
+		assert.Equal(t, plan.Fills[i].Amount, record.Params.Amount)
+	}
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/blockchain/simulation"
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+type chainSettingReader struct {
+	storage.SettingReader
+	assets []commonv3.Asset
+}
+
+func (s *chainSettingReader) GetAssets() ([]commonv3.Asset, error) {
+	return s.assets, nil
+}
+
+// chainExchange is an exchange with a deposit address on the simulated chain.
+type chainExchange struct {
+	testExchange
+	address ethereum.Address
+}
+
+func (ce chainExchange) Address(_ commonv3.Asset) (ethereum.Address, bool) {
+	return ce.address, true
+}
+
+func requireMined(t *testing.T, h *simulation.Harness, tx string) {
+	status, _, err := h.TxStatus(ethereum.HexToHash(tx))
+	require.NoError(t, err)
+	require.Equal(t, common.MiningStatusMined, status)
+}
+
+func TestReserveCoreOnSimulatedChain(t *testing.T) {
+	sr := &chainSettingReader{}
+	h, err := simulation.NewHarness(sr)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, h.Close())
+	}()
+	knc, err := h.DeployToken(common.EthToWei(1000000))
+	require.NoError(t, err)
+	sr.assets = []commonv3.Asset{
+		{ID: 1, Symbol: "ETH", Address: simulation.ETHAddress, Decimals: 18, Transferable: true},
+		{ID: 2, Symbol: "KNC", Address: knc, Decimals: 18, Transferable: true, SetRate: commonv3.ExchangeFeed},
+	}
+	var (
+		eth, kncAsset = sr.assets[0], sr.assets[1]
+		exchange      = chainExchange{address: ethereum.HexToAddress("0x1111111111111111111111111111111111111111")}
+		coldWallet    = ethereum.HexToAddress("0x2222222222222222222222222222222222222222")
+		activities    = &recordingActivityStorage{}
+	)
+	rc := NewReserveCore(h, activities, testRiskLimitStorage{
+		withdrawAddresses: []commonv3.WithdrawAddress{{ID: 1, Address: coldWallet, AssetID: eth.ID}},
+	}, &h.Contracts)
+
+	// set rates
+	block, err := h.CurrentBlock()
+	require.NoError(t, err)
+	var (
+		buy    = common.EthToWei(1000)
+		sell   = big.NewInt(0.00099e18)
+		afpMid = big.NewInt(0.00099e18)
+	)
+	_, err = rc.SetRates([]commonv3.Asset{kncAsset}, []*big.Int{buy}, []*big.Int{sell},
+		new(big.Int).SetUint64(block), []*big.Int{afpMid}, nil)
+	require.NoError(t, err)
+	require.Len(t, activities.records, 1)
+	assert.Equal(t, common.MiningStatusSubmitted, activities.records[0].MiningStatus)
+	h.Commit()
+	requireMined(t, h, activities.records[0].Result.Tx)
+	current := new(big.Int).SetUint64(block + 1)
+	price, err := h.GetPrice(knc, current, "buy", big.NewInt(0), 0)
+	require.NoError(t, err)
+	assert.Equal(t, buy, price)
+	price, err = h.GetPrice(knc, current, "sell", big.NewInt(0), 0)
+	require.NoError(t, err)
+	assert.Equal(t, sell, price)
+
+	// the reserve contract rejects deposits to addresses which are not approved
+	amount := common.EthToWei(5)
+	_, err = rc.Deposit(exchange, kncAsset, amount, common.NowInMillis())
+	require.Error(t, err)
+	require.Len(t, activities.records, 2)
+	assert.Equal(t, common.MiningStatusFailed, activities.records[1].MiningStatus)
+
+	// deposit
+	require.NoError(t, h.ApproveWithdrawAddress(knc, exchange.address))
+	_, err = rc.Deposit(exchange, kncAsset, amount, common.NowInMillis())
+	require.NoError(t, err)
+	require.Len(t, activities.records, 3)
+	deposit := activities.records[2]
+	assert.Equal(t, common.ActionDeposit, deposit.Action)
+	assert.Equal(t, common.MiningStatusSubmitted, deposit.MiningStatus)
+	h.Commit()
+	requireMined(t, h, deposit.Result.Tx)
+	balance, err := h.TokenBalance(knc, exchange.address)
+	require.NoError(t, err)
+	assert.Equal(t, amount, balance)
+
+	// withdraw from reserve
+	require.NoError(t, h.ApproveWithdrawAddress(simulation.ETHAddress, coldWallet))
+	_, err = rc.ReserveWithdraw(eth, amount, coldWallet)
+	require.NoError(t, err)
+	require.Len(t, activities.records, 4)
+	withdraw := activities.records[3]
+	assert.Equal(t, common.ActionReserveWithdraw, withdraw.Action)
+	assert.Equal(t, common.MiningStatusSubmitted, withdraw.MiningStatus)
+	h.Commit()
+	requireMined(t, h, withdraw.Result.Tx)
+	balance, err = h.BalanceAt(coldWallet)
+	require.NoError(t, err)
+	assert.Equal(t, amount, balance)
+	reserveBalance, err := h.BalanceAt(h.Contracts.Reserve)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Sub(simulation.ReserveFunds, amount), reserveBalance)
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// PriceSource is the interface to get latest order books of a trading pair.
+type PriceSource interface {
+	GetOnePrice(pairID uint64, timestamp uint64) (common.OnePriceResponse, error)
+}
+
+// StaleOrderCanceller periodically cancels open orders which are too old or priced too far
+// away from market according to the configured policy.
+type StaleOrderCanceller struct {
+	rc     *ReserveCore
+	prices PriceSource
+	policy common.StaleOrderPolicy
+	l      *zap.SugaredLogger
+}
+
+// NewStaleOrderCanceller creates a new StaleOrderCanceller instance.
+func NewStaleOrderCanceller(rc *ReserveCore, prices PriceSource, policy common.StaleOrderPolicy) *StaleOrderCanceller {
+	return &StaleOrderCanceller{
+		rc:     rc,
+		prices: prices,
+		policy: policy,
+		l:      zap.S(),
+	}
+}
+
+// Enabled returns true if the policy has a check interval and at least one check configured.
+func (sc *StaleOrderCanceller) Enabled() bool {
+	return sc.policy.Interval > 0 && (sc.policy.MaxAge > 0 || sc.policy.MaxPriceDeviation > 0)
+}
+
+// midPrice returns mid price of the best bid and best ask, false is returned if order book is not available.
+func midPrice(price common.ExchangePrice) (float64, bool) {
+	if !price.Valid || len(price.Bids) == 0 || len(price.Asks) == 0 {
+		return 0, false
+	}
+	return (price.Bids[0].Rate + price.Asks[0].Rate) / 2, true
+}
+
+// staleReason returns the reason the order is stale, empty string is returned if the order is not stale.
+func (sc *StaleOrderCanceller) staleReason(order common.OpenOrder, now uint64) string {
+	if maxAge := uint64(time.Duration(sc.policy.MaxAge) / time.Millisecond); maxAge != 0 && order.Time+maxAge < now {
+		return fmt.Sprintf("order is open longer than %s", time.Duration(sc.policy.MaxAge).String())
+	}
+	if sc.policy.MaxPriceDeviation == 0 {
+		return ""
+	}
+	prices, err := sc.prices.GetOnePrice(order.PairID, now)
+	if err != nil {
+		sc.l.Warnw("failed to get price of pair", "pair_id", order.PairID, "err", err)
+		return ""
+	}
+	mid, ok := midPrice(prices.Data[order.Exchange])
+	if !ok {
+		return ""
+	}
+	if deviation := math.Abs(order.Price-mid) / mid; deviation > sc.policy.MaxPriceDeviation {
+		return fmt.Sprintf("order price %s deviates %s from market price %s",
+			formatFloat(order.Price), formatFloat(deviation), formatFloat(mid))
+	}
+	return ""
+}
+
+// CancelStaleOrders cancels all stale orders open on exchange and returns cancelled orders.
+func (sc *StaleOrderCanceller) CancelStaleOrders(exchange common.Exchange) ([]common.OpenOrder, error) {
+	orders, err := sc.rc.GetOpenOrders(exchange)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		now       = common.NowInMillis()
+		cancelled []common.OpenOrder
+	)
+	for _, order := range orders {
+		reason := sc.staleReason(order, now)
+		if reason == "" {
+			continue
+		}
+		sc.l.Infow("cancelling stale order", "exchange", exchange.ID().String(), "id", order.ID, "reason", reason)
+		if err := sc.rc.CancelOpenOrder(exchange, order); err != nil {
+			sc.l.Warnw("failed to cancel stale order", "exchange", exchange.ID().String(), "id", order.ID, "err", err)
+			continue
+		}
+		cancelled = append(cancelled, order)
+	}
+	return cancelled, nil
+}
+
+// Run checks open orders of exchanges periodically and cancels stale ones until ctx is done.
+func (sc *StaleOrderCanceller) Run(ctx context.Context, exchanges []common.Exchange) {
+	if !sc.Enabled() {
+		sc.l.Infow("stale order policy is not configured")
+		return
+	}
+	ticker := time.NewTicker(time.Duration(sc.policy.Interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, exchange := range exchanges {
+			if _, ok := exchange.(common.OpenOrderExchange); !ok {
+				continue
+			}
+			if _, err := sc.CancelStaleOrders(exchange); err != nil {
+				sc.l.Warnw("failed to cancel stale orders", "exchange", exchange.ID().String(), "err", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+type testOpenOrderExchange struct {
+	testExchange
+	orders    []common.OpenOrder
+	cancelled *[]string
+}
+
+func (te testOpenOrderExchange) OpenOrders() ([]common.OpenOrder, error) {
+	return append([]common.OpenOrder{}, te.orders...), nil
+}
+
+func (te testOpenOrderExchange) CancelOrder(id string, base, quote string) error {
+	*te.cancelled = append(*te.cancelled, id)
+	return nil
+}
+
+type testPriceSource struct {
+	bid, ask float64
+}
+
+func (tps testPriceSource) GetOnePrice(pairID uint64, timestamp uint64) (common.OnePriceResponse, error) {
+	return common.OnePriceResponse{
+		Data: common.OnePrice{
+			common.Binance: common.ExchangePrice{
+				Valid: true,
+				Bids:  []common.PriceEntry{common.NewPriceEntry(1, tps.bid)},
+				Asks:  []common.PriceEntry{common.NewPriceEntry(1, tps.ask)},
+			},
+		},
+	}, nil
+}
+
+func TestStaleOrderCanceller(t *testing.T) {
+	var (
+		now       = common.NowInMillis()
+		storage   = &recordingActivityStorage{}
+		cancelled []string
+		exchange  = testOpenOrderExchange{
+			orders: []common.OpenOrder{
+				// tracked order priced at market
+				{ID: "1", Exchange: common.Binance, PairID: 1, Base: "KNC", Quote: "ETH", Price: 0.01, Time: now},
+				// orphaned order open for too long
+				{ID: "2", Exchange: common.Binance, PairID: 1, Base: "KNC", Quote: "ETH", Price: 0.01,
+					Time: now - uint64(2*time.Hour/time.Millisecond)},
+				// orphaned order priced away from market
+				{ID: "3", Exchange: common.Binance, PairID: 1, Base: "KNC", Quote: "ETH", Price: 0.02, Time: now},
+			},
+			cancelled: &cancelled,
+		}
+	)
+	require.NoError(t, storage.Record(common.ActionTrade, common.NewActivityID(now, "1"), common.Binance.String(),
+		common.ActivityParams{Exchange: common.Binance, Base: "KNC", Quote: "ETH"},
+		common.ActivityResult{}, common.ExchangeStatusSubmitted, "", now))
+	rc := NewReserveCore(testBlockchain{}, storage, testRiskLimitStorage{}, &common.ContractAddressConfiguration{})
+
+	_, err := rc.GetOpenOrders(testExchange{})
+	require.Error(t, err)
+
+	orders, err := rc.GetOpenOrders(exchange)
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+	require.NotNil(t, orders[0].ActivityID)
+	assert.Equal(t, "1", orders[0].ActivityID.EID)
+	assert.False(t, orders[0].Orphaned)
+	assert.Nil(t, orders[1].ActivityID)
+	assert.True(t, orders[1].Orphaned)
+
+	sc := NewStaleOrderCanceller(rc, testPriceSource{bid: 0.0099, ask: 0.0101}, common.StaleOrderPolicy{
+		Interval:          common.HumanDuration(time.Minute),
+		MaxAge:            common.HumanDuration(time.Hour),
+		MaxPriceDeviation: 0.05,
+	})
+	assert.True(t, sc.Enabled())
+	result, err := sc.CancelStaleOrders(exchange)
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, []string{"2", "3"}, cancelled)
+
+	assert.False(t, NewStaleOrderCanceller(rc, testPriceSource{}, common.StaleOrderPolicy{
+		Interval: common.HumanDuration(time.Minute),
+	}).Enabled())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		sc.Run(ctx, []common.Exchange{exchange})
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("canceller is not stopped")
+	}
+}
@@ -0,0 +1,196 @@
+// Package accounting computes realised PnL, volume and fees of exchange trade history and values
+// the inventory of assets.
+package accounting
+
+import (
+	"sort"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// tradeTypeBuy is the type of trade history buying the base asset, others sell it.
+const tradeTypeBuy = "buy"
+
+// Trade is a trade of a trading pair on an exchange.
+type Trade struct {
+	Exchange string
+	Base     string
+	Quote    string
+	common.TradeHistory
+}
+
+// Valuer prices assets in ETH and ETH in USD at a time.
+type Valuer interface {
+	// ETHRate returns the amount of ETH one unit of the asset is worth.
+	ETHRate(symbol string, timepoint uint64) (float64, error)
+	// USDRate returns the amount of USD one ETH is worth.
+	USDRate(timepoint uint64) (float64, error)
+}
+
+// lot is a quantity of asset bought at a cost per unit.
+type lot struct {
+	qty float64
+	eth float64
+	usd float64
+}
+
+// position is the holdings of an asset built from trades of a group.
+type position struct {
+	pnl common.AssetPnL
+	// lots are the bought quantities not sold yet, the oldest first
+	lots []lot
+	// qty, eth and usd are the quantity and total cost of holdings for average cost
+	qty float64
+	eth float64
+	usd float64
+}
+
+func (p *position) buy(qty, eth, usd float64) {
+	p.pnl.Bought += qty
+	p.lots = append(p.lots, lot{qty: qty, eth: eth / qty, usd: usd / qty})
+	p.qty += qty
+	p.eth += eth
+	p.usd += usd
+}
+
+func (p *position) sell(qty, eth, usd float64) {
+	p.pnl.Sold += qty
+	unitETH, unitUSD := eth/qty, usd/qty
+
+	remaining := qty
+	for remaining > 0 && len(p.lots) > 0 {
+		l := &p.lots[0]
+		matched := l.qty
+		if remaining < matched {
+			matched = remaining
+		}
+		p.pnl.FIFO.ETH += matched * (unitETH - l.eth)
+		p.pnl.FIFO.USD += matched * (unitUSD - l.usd)
+		l.qty -= matched
+		remaining -= matched
+		if l.qty <= 0 {
+			p.lots = p.lots[1:]
+		}
+	}
+	p.pnl.Unmatched += remaining
+
+	matched := qty - remaining
+	if matched > 0 {
+		avgETH, avgUSD := p.eth/p.qty, p.usd/p.qty
+		p.pnl.AverageCost.ETH += matched * (unitETH - avgETH)
+		p.pnl.AverageCost.USD += matched * (unitUSD - avgUSD)
+		p.eth -= matched * avgETH
+		p.usd -= matched * avgUSD
+		p.qty -= matched
+	}
+}
+
+type groupKey struct {
+	exchange string
+	asset    string
+}
+
+// Report computes realised PnL of base assets and fees of the trades. Trades are valued at the
+// time they are made, the ones which quote asset or ETH can not be priced are excluded and
+// counted as unvalued. Sells without holdings bought in the trades are not in realised PnL.
+func Report(fromTime, toTime uint64, groupBy string, trades []Trade, valuer Valuer) common.PnLReport {
+	report := common.PnLReport{
+		FromTime: fromTime,
+		ToTime:   toTime,
+		GroupBy:  groupBy,
+		Assets:   []common.AssetPnL{},
+		Fees:     []common.FeePaid{},
+	}
+	sorted := make([]Trade, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp < sorted[j].Timestamp
+	})
+
+	positions := make(map[groupKey]*position)
+	fees := make(map[groupKey]*common.FeePaid)
+	for _, trade := range sorted {
+		if trade.Fee > 0 && trade.FeeAsset != "" {
+			key := groupKey{exchange: trade.Exchange, asset: trade.FeeAsset}
+			fee, ok := fees[key]
+			if !ok {
+				fee = &common.FeePaid{Exchange: trade.Exchange, Asset: trade.FeeAsset, Valued: true}
+				fees[key] = fee
+			}
+			fee.Amount += trade.Fee
+			if value, err := valueOf(valuer, trade.FeeAsset, trade.Fee, trade.Timestamp); err != nil {
+				fee.Valued = false
+			} else {
+				fee.Value.ETH += value.ETH
+				fee.Value.USD += value.USD
+			}
+		}
+
+		if trade.Qty <= 0 {
+			continue
+		}
+		value, err := valueOf(valuer, trade.Quote, trade.Price*trade.Qty, trade.Timestamp)
+		if err != nil {
+			report.UnvaluedTrades++
+			continue
+		}
+		key := groupKey{asset: trade.Base}
+		if groupBy == common.PnLGroupByExchange {
+			key.exchange = trade.Exchange
+		}
+		p, ok := positions[key]
+		if !ok {
+			p = &position{pnl: common.AssetPnL{Exchange: key.exchange, Asset: key.asset}}
+			positions[key] = p
+		}
+		p.pnl.Trades++
+		p.pnl.Volume.ETH += value.ETH
+		p.pnl.Volume.USD += value.USD
+		if trade.Type == tradeTypeBuy {
+			p.buy(trade.Qty, value.ETH, value.USD)
+		} else {
+			p.sell(trade.Qty, value.ETH, value.USD)
+		}
+	}
+
+	for _, p := range positions {
+		report.Assets = append(report.Assets, p.pnl)
+		report.Total.Volume.ETH += p.pnl.Volume.ETH
+		report.Total.Volume.USD += p.pnl.Volume.USD
+		report.Total.FIFO.ETH += p.pnl.FIFO.ETH
+		report.Total.FIFO.USD += p.pnl.FIFO.USD
+		report.Total.AverageCost.ETH += p.pnl.AverageCost.ETH
+		report.Total.AverageCost.USD += p.pnl.AverageCost.USD
+	}
+	sort.Slice(report.Assets, func(i, j int) bool {
+		if report.Assets[i].Exchange != report.Assets[j].Exchange {
+			return report.Assets[i].Exchange < report.Assets[j].Exchange
+		}
+		return report.Assets[i].Asset < report.Assets[j].Asset
+	})
+	for _, fee := range fees {
+		report.Fees = append(report.Fees, *fee)
+		report.Total.Fees.ETH += fee.Value.ETH
+		report.Total.Fees.USD += fee.Value.USD
+	}
+	sort.Slice(report.Fees, func(i, j int) bool {
+		if report.Fees[i].Exchange != report.Fees[j].Exchange {
+			return report.Fees[i].Exchange < report.Fees[j].Exchange
+		}
+		return report.Fees[i].Asset < report.Fees[j].Asset
+	})
+	return report
+}
+
+func valueOf(valuer Valuer, symbol string, amount float64, timepoint uint64) (common.Value, error) {
+	ethRate, err := valuer.ETHRate(symbol, timepoint)
+	if err != nil {
+		return common.Value{}, err
+	}
+	usdRate, err := valuer.USDRate(timepoint)
+	if err != nil {
+		return common.Value{}, err
+	}
+	eth := amount * ethRate
+	return common.Value{ETH: eth, USD: eth * usdRate}, nil
+}
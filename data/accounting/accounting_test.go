@@ -0,0 +1,170 @@
+package accounting
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testValuer struct {
+	rates map[string]float64
+	usd   float64
+	// at is the only timepoint rates are known at if it is set.
+	at uint64
+}
+
+func (v testValuer) ETHRate(symbol string, timepoint uint64) (float64, error) {
+	if v.at != 0 && timepoint != v.at {
+		return 0, fmt.Errorf("no rate at %d", timepoint)
+	}
+	rate, ok := v.rates[symbol]
+	if !ok {
+		return 0, fmt.Errorf("no rate of %s", symbol)
+	}
+	return rate, nil
+}
+
+func (v testValuer) USDRate(timepoint uint64) (float64, error) {
+	if v.at != 0 && timepoint != v.at {
+		return 0, fmt.Errorf("no rate at %d", timepoint)
+	}
+	return v.usd, nil
+}
+
+func newTrade(exchange, typ string, price, qty float64, timestamp uint64) Trade {
+	return Trade{
+		Exchange:     exchange,
+		Base:         "KNC",
+		Quote:        "ETH",
+		TradeHistory: common.NewTradeHistory(fmt.Sprint(timestamp), price, qty, typ, timestamp),
+	}
+}
+
+func TestReport(t *testing.T) {
+	valuer := testValuer{rates: map[string]float64{"ETH": 1, "BNB": 0.1}, usd: 200}
+	trades := []Trade{
+		// sells are matched in time order, not in the given order
+		newTrade("binance", "sell", 0.004, 150, 3),
+		newTrade("binance", "buy", 0.001, 100, 1),
+		newTrade("huobi", "buy", 0.003, 100, 2),
+		newTrade("binance", "sell", 0.002, 100, 4),
+		{Exchange: "binance", Base: "XYZ", Quote: "ABC", TradeHistory: common.NewTradeHistory("x", 1, 1, "buy", 5)},
+	}
+	trades[1].TradeHistory = trades[1].WithCommission("1", 0.5, "BNB", true)
+	trades[2].TradeHistory = trades[2].WithCommission("2", 0.1, "KNC", false)
+	trades[0].TradeHistory = trades[0].WithCommission("3", 0.001, "XYZ", false)
+
+	report := Report(0, 10, common.PnLGroupByAsset, trades, valuer)
+	assert.Equal(t, 1, report.UnvaluedTrades)
+	require.Len(t, report.Assets, 1)
+	knc := report.Assets[0]
+	assert.Equal(t, "KNC", knc.Asset)
+	assert.Equal(t, "", knc.Exchange)
+	assert.Equal(t, 4, knc.Trades)
+	assert.InDelta(t, 200, knc.Bought, 1e-9)
+	assert.InDelta(t, 250, knc.Sold, 1e-9)
+	assert.InDelta(t, 50, knc.Unmatched, 1e-9)
+	assert.InDelta(t, 1.2, knc.Volume.ETH, 1e-9)
+	assert.InDelta(t, 240, knc.Volume.USD, 1e-9)
+	// FIFO: 100 * (0.004 - 0.001) + 50 * (0.004 - 0.003) + 50 * (0.002 - 0.003)
+	assert.InDelta(t, 0.3, knc.FIFO.ETH, 1e-9)
+	assert.InDelta(t, 60, knc.FIFO.USD, 1e-9)
+	// average cost 0.002: 150 * (0.004 - 0.002) + 50 * (0.002 - 0.002)
+	assert.InDelta(t, 0.3, knc.AverageCost.ETH, 1e-9)
+	assert.InDelta(t, 0.3, report.Total.FIFO.ETH, 1e-9)
+
+	require.Len(t, report.Fees, 3)
+	assert.Equal(t, common.FeePaid{Exchange: "binance", Asset: "BNB", Amount: 0.5,
+		Value: common.Value{ETH: 0.05, USD: 10}, Valued: true}, report.Fees[0])
+	assert.Equal(t, "XYZ", report.Fees[1].Asset)
+	assert.False(t, report.Fees[1].Valued)
+	assert.Equal(t, "huobi", report.Fees[2].Exchange)
+	assert.False(t, report.Fees[2].Valued)
+	assert.InDelta(t, 0.05, report.Total.Fees.ETH, 1e-9)
+
+	report = Report(0, 10, common.PnLGroupByExchange, trades, valuer)
+	require.Len(t, report.Assets, 2)
+	binance, huobi := report.Assets[0], report.Assets[1]
+	assert.Equal(t, "binance", binance.Exchange)
+	// 100 * (0.004 - 0.001), the rest is sold without buys on binance
+	assert.InDelta(t, 0.3, binance.FIFO.ETH, 1e-9)
+	assert.InDelta(t, 150, binance.Unmatched, 1e-9)
+	assert.Equal(t, "huobi", huobi.Exchange)
+	assert.InDelta(t, 100, huobi.Bought, 1e-9)
+	assert.Zero(t, huobi.FIFO.ETH)
+}
+
+func TestReportAverageCost(t *testing.T) {
+	valuer := testValuer{rates: map[string]float64{"ETH": 1}, usd: 100}
+	trades := []Trade{
+		newTrade("binance", "buy", 0.001, 100, 1),
+		newTrade("binance", "buy", 0.003, 100, 2),
+		newTrade("binance", "sell", 0.004, 100, 3),
+	}
+	report := Report(0, 10, common.PnLGroupByAsset, trades, valuer)
+	require.Len(t, report.Assets, 1)
+	// FIFO sells the cheaper lot, average cost uses 0.002
+	assert.InDelta(t, 0.3, report.Assets[0].FIFO.ETH, 1e-9)
+	assert.InDelta(t, 0.2, report.Assets[0].AverageCost.ETH, 1e-9)
+	assert.InDelta(t, 20, report.Assets[0].AverageCost.USD, 1e-9)
+	assert.Zero(t, report.Assets[0].Unmatched)
+}
+
+type testMarketData struct {
+	prices common.AllPriceResponse
+	usd    common.USDData
+	calls  int
+}
+
+func (d *testMarketData) GetAllPrices(timepoint uint64) (common.AllPriceResponse, error) {
+	d.calls++
+	return d.prices, nil
+}
+
+func (d *testMarketData) GetUSDData(timepoint uint64) (common.USDData, error) {
+	return d.usd, nil
+}
+
+func TestMarketValuer(t *testing.T) {
+	book := func(bid, ask float64) common.ExchangePrice {
+		return common.ExchangePrice{
+			Valid: true,
+			Bids:  []common.PriceEntry{common.NewPriceEntry(1, bid)},
+			Asks:  []common.PriceEntry{common.NewPriceEntry(1, ask)},
+		}
+	}
+	data := &testMarketData{
+		prices: common.AllPriceResponse{Data: map[uint64]common.OnePrice{
+			1: {common.Binance: book(0.001, 0.003), common.Huobi: {Valid: false}},
+			2: {common.Binance: book(190, 210)},
+		}},
+		usd: common.USDData{CoinbaseUSD: common.CoinbaseData{Valid: true, Price: "180.5"}},
+	}
+	pairs := []commonv3.TradingPairSymbols{
+		{TradingPair: commonv3.TradingPair{ID: 1}, BaseSymbol: "KNC", QuoteSymbol: "ETH"},
+		{TradingPair: commonv3.TradingPair{ID: 2}, BaseSymbol: "ETH", QuoteSymbol: "USDT"},
+	}
+	valuer := NewMarketValuer(data, pairs)
+
+	rate, err := valuer.ETHRate("ETH", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+	rate, err = valuer.ETHRate("KNC", 1000)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.002, rate, 1e-12)
+	rate, err = valuer.ETHRate("USDT", 2000)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.005, rate, 1e-12)
+	assert.Equal(t, 1, data.calls)
+	_, err = valuer.ETHRate("OMG", 1000)
+	assert.Error(t, err)
+
+	usd, err := valuer.USDRate(1000)
+	require.NoError(t, err)
+	assert.Equal(t, 180.5, usd)
+}
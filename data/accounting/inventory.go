@@ -0,0 +1,128 @@
+package accounting
+
+import (
+	"math"
+	"sort"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// deviation returns the ratio the value is above or below the target, zero without a target.
+func deviation(value, target float64) float64 {
+	if target <= 0 {
+		return 0
+	}
+	return (value - target) / target
+}
+
+// Inventory values balances of assets on the reserve, exchanges and in pending transfers of the
+// auth data at the timestamp and compares them with targets of the assets.
+func Inventory(timestamp uint64, authData common.AuthDataResponseV3, assets []commonv3.Asset, valuer Valuer) common.InventoryReport {
+	report := common.InventoryReport{Timestamp: timestamp, Unvalued: []string{}}
+	usdRate, err := valuer.USDRate(timestamp)
+	if err == nil {
+		report.USDRate = usdRate
+	}
+
+	byID := make(map[uint64]commonv3.Asset, len(assets))
+	for _, asset := range assets {
+		byID[asset.ID] = asset
+	}
+	inventory := make(map[uint64]*common.InventoryAsset)
+	get := func(assetID uint64) *common.InventoryAsset {
+		if item, ok := inventory[assetID]; ok {
+			return item
+		}
+		item := &common.InventoryAsset{AssetID: assetID, Symbol: byID[assetID].Symbol, Valid: true}
+		inventory[assetID] = item
+		return item
+	}
+	for _, balance := range authData.Balances {
+		item := get(balance.AssetID)
+		item.Valid = balance.Valid
+		item.Reserve = balance.Reserve
+		for _, exchange := range balance.Exchanges {
+			item.Exchanges = append(item.Exchanges, common.InventoryExchange{
+				ExchangeID: exchange.ExchangeID,
+				Exchange:   exchange.Name,
+				Available:  exchange.Available,
+				Locked:     exchange.Locked,
+			})
+		}
+	}
+	pending := authData.PendingActivities
+	for _, activity := range pending.Deposit {
+		// the reserve balance still holds a deposit until its tx is mined
+		if activity.MiningStatus != common.MiningStatusMined {
+			continue
+		}
+		get(activity.Params.Asset).PendingDeposit += activity.Params.Amount
+	}
+	for _, activity := range pending.Withdraw {
+		get(activity.Params.Asset).PendingWithdraw += activity.Params.Amount
+	}
+	for _, activity := range pending.Transfer {
+		get(activity.Params.Asset).PendingTransfer += activity.Params.Amount
+	}
+
+	report.Assets = make([]common.InventoryAsset, 0, len(inventory))
+	for assetID, item := range inventory {
+		asset, ok := byID[assetID]
+		if !ok {
+			continue
+		}
+		item.Total = item.Reserve + item.PendingDeposit + item.PendingWithdraw + item.PendingTransfer
+		for _, exchange := range item.Exchanges {
+			item.Total += exchange.Available + exchange.Locked
+		}
+		targetRatios := make(map[uint64]float64, len(asset.Exchanges))
+		for _, exchange := range asset.Exchanges {
+			targetRatios[exchange.ExchangeID] = exchange.TargetRatio
+		}
+		for i := range item.Exchanges {
+			exchange := &item.Exchanges[i]
+			if item.Total > 0 {
+				exchange.Ratio = (exchange.Available + exchange.Locked) / item.Total
+			}
+			exchange.TargetRatio = targetRatios[exchange.ExchangeID]
+			if exchange.TargetRatio > 0 {
+				exchange.RatioDeviation = exchange.Ratio - exchange.TargetRatio
+			}
+		}
+		if asset.Target != nil {
+			item.TargetTotal = asset.Target.Total
+			item.TargetReserve = asset.Target.Reserve
+			item.TotalDeviation = deviation(item.Total, item.TargetTotal)
+			item.ReserveDeviation = deviation(item.Reserve, item.TargetReserve)
+		}
+
+		rate, err := valuer.ETHRate(asset.Symbol, timestamp)
+		if err != nil {
+			report.Unvalued = append(report.Unvalued, asset.Symbol)
+			report.Assets = append(report.Assets, *item)
+			continue
+		}
+		item.Valued = true
+		item.ETHRate = rate
+		item.Value = common.Value{ETH: item.Total * rate, USD: item.Total * rate * usdRate}
+		report.Total.Value.ETH += item.Value.ETH
+		report.Total.Value.USD += item.Value.USD
+		if item.TargetTotal > 0 {
+			excess := item.Total - item.TargetTotal
+			item.Exposure = common.Value{ETH: excess * rate, USD: excess * rate * usdRate}
+			report.Total.TargetValue.ETH += item.TargetTotal * rate
+			report.Total.TargetValue.USD += item.TargetTotal * rate * usdRate
+			report.Total.NetExposure.ETH += item.Exposure.ETH
+			report.Total.NetExposure.USD += item.Exposure.USD
+			report.Total.GrossExposure.ETH += math.Abs(item.Exposure.ETH)
+			report.Total.GrossExposure.USD += math.Abs(item.Exposure.USD)
+		}
+		report.Assets = append(report.Assets, *item)
+	}
+	sort.Slice(report.Assets, func(i, j int) bool {
+		return report.Assets[i].AssetID < report.Assets[j].AssetID
+	})
+	sort.Strings(report.Unvalued)
+	return report
+}
@@ -0,0 +1,94 @@
+package accounting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestInventory(t *testing.T) {
+	valuer := testValuer{rates: map[string]float64{"ETH": 1, "KNC": 0.002}, usd: 200, at: 1000}
+	assets := []commonv3.Asset{
+		{ID: 1, Symbol: "ETH"},
+		{
+			ID:     2,
+			Symbol: "KNC",
+			Target: &commonv3.AssetTarget{Total: 10000, Reserve: 5000},
+			Exchanges: []commonv3.AssetExchange{
+				{ExchangeID: 1, TargetRatio: 0.3},
+				{ExchangeID: 2},
+			},
+		},
+		{ID: 3, Symbol: "XYZ"},
+	}
+	activity := func(assetID uint64, amount float64) common.ActivityRecord {
+		return common.ActivityRecord{Params: common.ActivityParams{Asset: assetID, Amount: amount}}
+	}
+	deposit := func(assetID uint64, amount float64, miningStatus string) common.ActivityRecord {
+		record := activity(assetID, amount)
+		record.MiningStatus = miningStatus
+		return record
+	}
+	authData := common.AuthDataResponseV3{
+		// version of auth data in postgres is a row id, not a time
+		Version: 7,
+		Balances: []common.AuthdataBalance{
+			{Valid: true, AssetID: 1, Symbol: "ETH", Reserve: 100},
+			{Valid: false, AssetID: 2, Symbol: "KNC", Reserve: 6000, Exchanges: []common.ExchangeBalance{
+				{ExchangeID: 1, Name: "binance", Available: 3000, Locked: 1000},
+				{ExchangeID: 2, Name: "huobi", Available: 1000},
+			}},
+			{Valid: true, AssetID: 3, Symbol: "XYZ", Reserve: 1},
+		},
+		PendingActivities: common.PendingActivities{
+			// the reserve balance still holds deposits which are not mined
+			Deposit: []common.ActivityRecord{
+				deposit(2, 500, common.MiningStatusMined),
+				deposit(2, 400, common.MiningStatusSubmitted),
+				deposit(1, 20, ""),
+			},
+			Withdraw: []common.ActivityRecord{activity(2, 300), activity(1, 10)},
+			Transfer: []common.ActivityRecord{activity(2, 200)},
+		},
+	}
+
+	report := Inventory(1000, authData, assets, valuer)
+	assert.Equal(t, uint64(1000), report.Timestamp)
+	assert.Equal(t, 200.0, report.USDRate)
+	assert.Equal(t, []string{"XYZ"}, report.Unvalued)
+	require.Len(t, report.Assets, 3)
+
+	eth := report.Assets[0]
+	assert.True(t, eth.Valid)
+	assert.Equal(t, 0.0, eth.PendingDeposit)
+	assert.Equal(t, 110.0, eth.Total)
+	assert.Equal(t, common.Value{ETH: 110, USD: 22000}, eth.Value)
+	assert.Equal(t, common.Value{}, eth.Exposure)
+
+	knc := report.Assets[1]
+	assert.False(t, knc.Valid)
+	assert.True(t, knc.Valued)
+	assert.Equal(t, 500.0, knc.PendingDeposit)
+	assert.Equal(t, 12000.0, knc.Total)
+	assert.InDelta(t, 0.2, knc.TotalDeviation, 1e-9)
+	assert.InDelta(t, 0.2, knc.ReserveDeviation, 1e-9)
+	assert.InDelta(t, 4, knc.Exposure.ETH, 1e-9)
+	assert.InDelta(t, 800, knc.Exposure.USD, 1e-9)
+	require.Len(t, knc.Exchanges, 2)
+	assert.InDelta(t, 1.0/3, knc.Exchanges[0].Ratio, 1e-9)
+	assert.InDelta(t, 1.0/3-0.3, knc.Exchanges[0].RatioDeviation, 1e-9)
+	assert.Equal(t, 0.0, knc.Exchanges[1].RatioDeviation)
+
+	xyz := report.Assets[2]
+	assert.False(t, xyz.Valued)
+	assert.Equal(t, common.Value{}, xyz.Value)
+
+	assert.InDelta(t, 134, report.Total.Value.ETH, 1e-9)
+	assert.InDelta(t, 20, report.Total.TargetValue.ETH, 1e-9)
+	assert.InDelta(t, 4, report.Total.NetExposure.ETH, 1e-9)
+	assert.InDelta(t, 4, report.Total.GrossExposure.ETH, 1e-9)
+}
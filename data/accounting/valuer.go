@@ -0,0 +1,116 @@
+package accounting
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	ethSymbol = "ETH"
+	// cacheBucket is the time range in millisecond prices of one stored snapshot are used for.
+	cacheBucket = 60 * 1000
+)
+
+// MarketData is the stored prices and USD feeds assets are valued from.
+type MarketData interface {
+	GetAllPrices(timepoint uint64) (common.AllPriceResponse, error)
+	GetUSDData(timepoint uint64) (common.USDData, error)
+}
+
+// marketValuer values assets with the mid price of their ETH pairs and ETH with the Coinbase
+// ETH-USD ticker. Snapshots are cached by minute, it is not safe for concurrent use.
+type marketValuer struct {
+	data   MarketData
+	pairs  []commonv3.TradingPairSymbols
+	prices map[uint64]common.AllPriceResponse
+	usd    map[uint64]float64
+}
+
+// NewMarketValuer returns a Valuer using stored order books of the trading pairs.
+func NewMarketValuer(data MarketData, pairs []commonv3.TradingPairSymbols) Valuer {
+	return &marketValuer{
+		data:   data,
+		pairs:  pairs,
+		prices: make(map[uint64]common.AllPriceResponse),
+		usd:    make(map[uint64]float64),
+	}
+}
+
+// midPrice returns the average of mid prices of the pair on exchanges with valid order books.
+func midPrice(price common.OnePrice) (float64, bool) {
+	var (
+		sum   float64
+		count int
+	)
+	for _, p := range price {
+		if !p.Valid || len(p.Bids) == 0 || len(p.Asks) == 0 {
+			continue
+		}
+		sum += (p.Bids[0].Rate + p.Asks[0].Rate) / 2
+		count++
+	}
+	if count == 0 || sum == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+func (v *marketValuer) ETHRate(symbol string, timepoint uint64) (float64, error) {
+	if symbol == ethSymbol {
+		return 1, nil
+	}
+	bucket := timepoint / cacheBucket
+	prices, ok := v.prices[bucket]
+	if !ok {
+		var err error
+		if prices, err = v.data.GetAllPrices(timepoint); err != nil {
+			return 0, err
+		}
+		v.prices[bucket] = prices
+	}
+	var (
+		sum   float64
+		count int
+	)
+	for _, pair := range v.pairs {
+		switch {
+		case pair.BaseSymbol == symbol && pair.QuoteSymbol == ethSymbol:
+			if mid, ok := midPrice(prices.Data[pair.ID]); ok {
+				sum += mid
+				count++
+			}
+		case pair.BaseSymbol == ethSymbol && pair.QuoteSymbol == symbol:
+			if mid, ok := midPrice(prices.Data[pair.ID]); ok {
+				sum += 1 / mid
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return 0, fmt.Errorf("no price of %s in ETH at %d", symbol, timepoint)
+	}
+	return sum / float64(count), nil
+}
+
+func (v *marketValuer) USDRate(timepoint uint64) (float64, error) {
+	bucket := timepoint / cacheBucket
+	if rate, ok := v.usd[bucket]; ok {
+		return rate, nil
+	}
+	data, err := v.data.GetUSDData(timepoint)
+	if err != nil {
+		return 0, err
+	}
+	if !data.CoinbaseUSD.Valid {
+		return 0, fmt.Errorf("no valid ETH price in USD at %d: %s", timepoint, data.CoinbaseUSD.Error)
+	}
+	rate, err := strconv.ParseFloat(data.CoinbaseUSD.Price, 64)
+	if err != nil {
+		return 0, err
+	}
+	v.usd[bucket] = rate
+	return rate, nil
+}
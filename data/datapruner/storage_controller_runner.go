@@ -8,6 +8,8 @@ import (
 type StorageControllerRunner interface {
 	GetAuthBucketTicker() <-chan time.Time
 	Start() error
+	// Stop stops the tickers, it must only be called after runner is started.
+	Stop() error
 }
 
 type ControllerTickerRunner struct {
@@ -29,6 +31,12 @@ func (c *ControllerTickerRunner) Start() error {
 	return nil
 }
 
+// Stop stops the auth data ticker.
+func (c *ControllerTickerRunner) Stop() error {
+	c.authClock.Stop()
+	return nil
+}
+
 func NewStorageControllerTickerRunner(
 	authDuration time.Duration) *ControllerTickerRunner {
 	return &ControllerTickerRunner{
@@ -1,7 +1,20 @@
 package data
 
+import (
+	"context"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
 // Fetcher is the common interface of a fetcher service.
 type Fetcher interface {
-	Run() error
+	// Run starts fetching, it stops when ctx is done or Stop is called.
+	Run(ctx context.Context) error
+	// Stop stops fetching and waits for the jobs in progress to finish.
 	Stop() error
+	// Jobs returns schedules and run statistics of fetcher jobs.
+	Jobs() ([]common.FetcherJobStatus, error)
+	// SetJobConfig changes the schedule of the job of the exchange, exchange is empty for jobs
+	// which are not bound to an exchange.
+	SetJobConfig(job, exchange string, config common.FetcherJobConfig) error
 }
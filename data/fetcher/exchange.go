@@ -1,6 +1,8 @@
 package fetcher
 
 import (
+	"context"
+
 	ethereum "github.com/ethereum/go-ethereum/common"
 
 	"github.com/KyberNetwork/reserve-data/common"
@@ -10,12 +12,12 @@ import (
 type Exchange interface {
 	ID() common.ExchangeID
 	FetchPriceData(timepoint uint64) (map[uint64]common.ExchangePrice, error)
-	FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error)
+	FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error)
 	// FetchTradeHistory gets history and save data in the exchange db
 	FetchTradeHistory()
 
-	OrderStatus(id string, base, quote string) (string, error)
-	DepositStatus(id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error)
-	WithdrawStatus(id string, assetID uint64, amount float64, timepoint uint64) (string, string, error)
+	OrderStatus(ctx context.Context, id string, base, quote string) (string, error)
+	DepositStatus(ctx context.Context, id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error)
+	WithdrawStatus(ctx context.Context, id string, assetID uint64, amount float64, timepoint uint64) (string, string, error)
 	TokenAddresses() (map[common.AssetID]ethereum.Address, error)
 }
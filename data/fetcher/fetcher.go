@@ -1,6 +1,8 @@
 package fetcher
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
@@ -12,11 +14,6 @@ import (
 	"github.com/KyberNetwork/reserve-data/common"
 )
 
-// maxActivityLifeTime is the longest time of an activity. If the
-// activity is pending for more than MAX_ACVITY_LIFE_TIME, it will be
-// considered as failed.
-const maxActivityLifeTime uint64 = 6 // activity max life time in hour
-
 type Fetcher struct {
 	storage                Storage
 	globalStorage          GlobalStorage
@@ -28,7 +25,35 @@ type Fetcher struct {
 	currentBlockUpdateTime uint64
 	simulationMode         bool
 	contractAddressConf    *common.ContractAddressConfiguration
-	l                      *zap.SugaredLogger
+	// activityLifetime is how long an activity can be pending before it is considered failed.
+	activityLifetime common.ActivityLifetime
+	l                *zap.SugaredLogger
+
+	// schedule is the schedules of fetcher jobs, used when there is no runner.
+	schedule common.FetcherScheduleConfig
+
+	// cancel stops the fetcher jobs started by Run, scheduler runs them.
+	cancel    context.CancelFunc
+	scheduler *Scheduler
+
+	// prices and authData are the latest results of exchange jobs, stored as snapshots by the
+	// order book and auth data jobs.
+	cacheMu  sync.Mutex
+	prices   map[common.ExchangeID]exchangePrices
+	authData map[common.ExchangeID]exchangeAuthData
+}
+
+// exchangePrices is the order books fetched from an exchange.
+type exchangePrices struct {
+	timepoint uint64
+	data      map[uint64]common.ExchangePrice
+}
+
+// exchangeAuthData is the balances and statuses of pending activities fetched from an exchange.
+type exchangeAuthData struct {
+	timepoint uint64
+	balances  common.EBalanceEntry
+	statuses  map[common.ActivityID]common.ActivityStatus
 }
 
 func NewFetcher(
@@ -48,6 +73,8 @@ func NewFetcher(
 		simulationMode:      simulationMode,
 		contractAddressConf: contractAddressConf,
 		l:                   zap.S(),
+		prices:              make(map[common.ExchangeID]exchangePrices),
+		authData:            make(map[common.ExchangeID]exchangeAuthData),
 	}
 }
 
@@ -56,38 +83,154 @@ func (f *Fetcher) SetBlockchain(blockchain Blockchain) {
 	f.FetchCurrentBlock(common.NowInMillis())
 }
 
+// SetActivityLifetime sets how long activities can be pending before they are considered failed.
+func (f *Fetcher) SetActivityLifetime(lifetime common.ActivityLifetime) {
+	f.activityLifetime = lifetime
+}
+
 func (f *Fetcher) AddExchange(exchange Exchange) {
 	f.exchanges = append(f.exchanges, exchange)
 }
 
+// SetSchedule sets schedules of fetcher jobs, they are used when the fetcher has no runner.
+func (f *Fetcher) SetSchedule(schedule common.FetcherScheduleConfig) {
+	f.schedule = schedule
+}
+
+// Stop stops the fetcher jobs, it waits for the jobs in progress, for example persisting an
+// auth data snapshot, to finish before stopping the runner.
 func (f *Fetcher) Stop() error {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	if f.scheduler != nil {
+		f.scheduler.Wait()
+	}
+	f.l.Info("Fetcher jobs are stopped")
+	if f.runner == nil {
+		return nil
+	}
 	return f.runner.Stop()
 }
 
-func (f *Fetcher) Run() error {
+// Run starts the runner and the fetcher jobs, the jobs stop when ctx is done or Stop is called.
+func (f *Fetcher) Run(ctx context.Context) error {
 	f.l.Info("Fetcher runner is starting...")
-	if err := f.runner.Start(); err != nil {
-		return err
+	if f.runner != nil {
+		if err := f.runner.Start(); err != nil {
+			return err
+		}
 	}
-	go f.RunOrderbookFetcher()
-	go f.RunAuthDataFetcher()
-	go f.RunRateFetcher()
-	go f.RunBlockFetcher()
-	go f.RunGlobalDataFetcher()
-	go f.RunFetchExchangeHistory()
+	f.scheduler = f.newScheduler()
+	ctx, f.cancel = context.WithCancel(ctx)
+	f.scheduler.Run(ctx)
 	f.l.Infof("Fetcher runner is running...")
 	return nil
 }
 
-func (f *Fetcher) RunGlobalDataFetcher() {
-	for {
-		f.l.Info("waiting for signal from global data channel")
-		t := <-f.runner.GetGlobalDataTicker()
-		f.l.Infof("got signal in global data channel with timestamp %d", common.TimeToMillis(t))
-		timepoint := common.TimeToMillis(t)
+// newScheduler returns a scheduler of fetcher jobs. Exchange jobs fetch the latest data of each
+// exchange, the order book and auth data jobs store snapshots of them.
+// With a runner, jobs run on its ticks and exchange jobs are run by the jobs storing their data,
+// so a tick gives a snapshot of fresh data as before.
+func (f *Fetcher) newScheduler() *Scheduler {
+	scheduler := NewScheduler()
+	// without runner, jobs run on their intervals as their triggers are nil
+	var orderBook, authData, rate, block, globalData <-chan time.Time
+	if f.runner != nil {
+		orderBook = f.runner.GetOrderbookTicker()
+		authData = f.runner.GetAuthDataTicker()
+		rate = f.runner.GetRateTicker()
+		block = f.runner.GetBlockTicker()
+		globalData = f.runner.GetGlobalDataTicker()
+	}
+	add := func(job string, trigger <-chan time.Time, fn JobFunc) {
+		scheduler.Add(JobKey{Job: job}, f.schedule.JobConfig(job, ""), trigger, fn)
+	}
+	add(common.FetcherJobOrderBook, orderBook, f.FetchOrderbook)
+	add(common.FetcherJobAuthData, authData, f.FetchAllAuthData)
+	add(common.FetcherJobRate, rate, func(ctx context.Context, timepoint uint64) error {
+		f.FetchRate(timepoint)
+		return nil
+	})
+	add(common.FetcherJobBlock, block, func(ctx context.Context, timepoint uint64) error {
+		f.FetchCurrentBlock(timepoint)
+		return nil
+	})
+	add(common.FetcherJobGlobalData, globalData, func(ctx context.Context, timepoint uint64) error {
 		f.FetchGlobalData(timepoint)
-		f.l.Info("fetched block from blockchain")
+		return nil
+	})
+	if f.runner != nil {
+		add(common.FetcherJobTradeHistory, f.runner.GetExchangeHistoryTicker(), func(ctx context.Context, timepoint uint64) error {
+			f.runExchangeJobs(ctx, common.FetcherJobTradeHistory, timepoint)
+			return nil
+		})
+	}
+
+	for _, exchange := range f.exchanges {
+		exchange := exchange
+		for job, fn := range map[string]func(context.Context, Exchange, uint64) error{
+			common.FetcherJobOrderBook:    f.fetchExchangeOrderbook,
+			common.FetcherJobAuthData:     f.fetchExchangeAuthData,
+			common.FetcherJobTradeHistory: f.fetchExchangeTradeHistory,
+		} {
+			fn := fn
+			var exchangeTrigger <-chan time.Time
+			if f.runner != nil {
+				// exchange jobs are only run by the jobs storing their data
+				exchangeTrigger = make(chan time.Time)
+			}
+			name := exchange.ID().String()
+			scheduler.Add(JobKey{Job: job, Exchange: name}, f.schedule.JobConfig(job, name), exchangeTrigger,
+				func(ctx context.Context, timepoint uint64) error {
+					return fn(ctx, exchange, timepoint)
+				})
+		}
 	}
+	return scheduler
+}
+
+// runExchangeJobs runs the job of all exchanges and waits for them to finish or time out.
+func (f *Fetcher) runExchangeJobs(ctx context.Context, job string, timepoint uint64) {
+	wg := sync.WaitGroup{}
+	for _, exchange := range f.exchanges {
+		wg.Add(1)
+		go func(key JobKey) {
+			defer wg.Done()
+			if err := f.scheduler.RunNow(ctx, key, timepoint); err != nil {
+				f.l.Warnw("fetcher job failed", "job", key.String(), "err", err)
+			}
+		}(JobKey{Job: job, Exchange: exchange.ID().String()})
+	}
+	wg.Wait()
+}
+
+// stale returns true if data fetched at timepoint by the job of the exchange is too old to be
+// stored, it is when the job has not succeeded for three intervals.
+func (f *Fetcher) stale(job string, exchange common.ExchangeID, timepoint uint64) bool {
+	config, err := f.scheduler.Config(JobKey{Job: job, Exchange: exchange.String()})
+	if err != nil || config.Interval <= 0 {
+		return false
+	}
+	maxAge := 3*time.Duration(config.Interval) + time.Duration(config.Timeout)
+	return common.NowInMillis()-timepoint > uint64(maxAge/time.Millisecond)
+}
+
+// Jobs returns schedules and run statistics of fetcher jobs.
+func (f *Fetcher) Jobs() ([]common.FetcherJobStatus, error) {
+	if f.scheduler == nil {
+		return nil, errors.New("fetcher is not running")
+	}
+	return f.scheduler.Jobs(), nil
+}
+
+// SetJobConfig changes the schedule of the job of the exchange, exchange is empty for jobs which
+// are not bound to an exchange.
+func (f *Fetcher) SetJobConfig(job, exchange string, config common.FetcherJobConfig) error {
+	if f.scheduler == nil {
+		return errors.New("fetcher is not running")
+	}
+	return f.scheduler.SetConfig(JobKey{Job: job, Exchange: exchange}, config)
 }
 
 func (f *Fetcher) FetchGlobalData(timepoint uint64) {
@@ -123,27 +266,6 @@ func (f *Fetcher) FetchGlobalData(timepoint uint64) {
 	}
 }
 
-func (f *Fetcher) RunBlockFetcher() {
-	for {
-		f.l.Info("waiting for signal from block channel")
-		t := <-f.runner.GetBlockTicker()
-		f.l.Infof("got signal in block channel with timestamp %d", common.TimeToMillis(t))
-		timepoint := common.TimeToMillis(t)
-		f.FetchCurrentBlock(timepoint)
-		f.l.Info("fetched block from blockchain")
-	}
-}
-
-func (f *Fetcher) RunRateFetcher() {
-	for {
-		f.l.Infof("waiting for signal from runner rate channel")
-		t := <-f.runner.GetRateTicker()
-		f.l.Infof("got signal in rate channel with timestamp %d", common.TimeToMillis(t))
-		f.FetchRate(common.TimeToMillis(t))
-		f.l.Infof("fetched rates from blockchain")
-	}
-}
-
 func (f *Fetcher) FetchRate(timepoint uint64) {
 	var (
 		err  error
@@ -172,17 +294,9 @@ func (f *Fetcher) FetchRate(timepoint uint64) {
 	}
 }
 
-func (f *Fetcher) RunAuthDataFetcher() {
-	for {
-		f.l.Infof("waiting for signal from runner auth data channel")
-		t := <-f.runner.GetAuthDataTicker()
-		f.l.Infof("got signal in auth data channel with timestamp %d", common.TimeToMillis(t))
-		f.FetchAllAuthData(common.TimeToMillis(t))
-		f.l.Infof("fetched data from exchanges")
-	}
-}
-
-func (f *Fetcher) FetchAllAuthData(timepoint uint64) {
+// FetchAllAuthData stores a snapshot of the latest balances and statuses of pending activities
+// fetched from exchanges and the blockchain.
+func (f *Fetcher) FetchAllAuthData(ctx context.Context, timepoint uint64) error {
 	snapshot := common.AuthDataSnapshot{
 		Valid:             true,
 		Timestamp:         common.GetTimestamp(),
@@ -195,19 +309,31 @@ func (f *Fetcher) FetchAllAuthData(timepoint uint64) {
 	ebalances := sync.Map{}
 	estatuses := sync.Map{}
 	bstatuses := sync.Map{}
+	if f.runner != nil {
+		f.runExchangeJobs(ctx, common.FetcherJobAuthData, timepoint)
+	}
 	pendings, err := f.storage.GetPendingActivities()
 	if err != nil {
 		f.l.Errorw("Getting pending activities failed", "err", err)
-		return
+		return err
 	}
-	wait := sync.WaitGroup{}
+	f.cacheMu.Lock()
 	for _, exchange := range f.exchanges {
-		wait.Add(1)
-		go f.FetchAuthDataFromExchange(
-			&wait, exchange, &ebalances, &estatuses,
-			pendings, timepoint)
+		data, ok := f.authData[exchange.ID()]
+		switch {
+		case !ok:
+			ebalances.Store(exchange.ID(), common.EBalanceEntry{Error: "auth data is not fetched from exchange yet"})
+			continue
+		case f.stale(common.FetcherJobAuthData, exchange.ID(), data.timepoint):
+			ebalances.Store(exchange.ID(), common.EBalanceEntry{Error: "auth data fetched from exchange is outdated"})
+			continue
+		}
+		ebalances.Store(exchange.ID(), data.balances)
+		for id, activityStatus := range data.statuses {
+			estatuses.Store(id, activityStatus)
+		}
 	}
-	wait.Wait()
+	f.cacheMu.Unlock()
 	// if we got tx info of withdrawals from the cexs, we have to
 	// update them to pending activities in order to also check
 	// their mining status.
@@ -241,8 +367,9 @@ func (f *Fetcher) FetchAllAuthData(timepoint uint64) {
 		pendings, &snapshot, timepoint)
 	if err != nil {
 		f.l.Warnw("Storing exchange balances failed", "err", err)
-		return
+		return err
 	}
+	return nil
 }
 
 func (f *Fetcher) FetchAuthDataFromBlockchain(
@@ -329,7 +456,8 @@ func (f *Fetcher) FetchStatusFromBlockchain(pendings []common.ActivityRecord) (m
 	nonceValidator := f.newNonceValidator()
 
 	for _, activity := range pendings {
-		if activity.IsBlockchainPending() && (activity.Action == common.ActionSetRate || activity.Action == common.ActionDeposit || activity.Action == common.ActionWithdraw) {
+		if activity.IsBlockchainPending() && (activity.Action == common.ActionSetRate || activity.Action == common.ActionDeposit ||
+			activity.Action == common.ActionWithdraw || activity.Action == common.ActionReserveWithdraw) {
 			var blockNum uint64
 			var status string
 			var err error
@@ -417,17 +545,19 @@ func unchanged(pre, post map[common.ActivityID]common.ActivityStatus) bool {
 	return true
 }
 
-func (f *Fetcher) updateActivitywithBlockchainStatus(activity *common.ActivityRecord, bstatuses *sync.Map, snapshot *common.AuthDataSnapshot) {
+// updateActivitywithBlockchainStatus updates activity with its status on blockchain and returns
+// the reported mining status.
+func (f *Fetcher) updateActivitywithBlockchainStatus(activity *common.ActivityRecord, bstatuses *sync.Map, snapshot *common.AuthDataSnapshot) string {
 	status, ok := bstatuses.Load(activity.ID)
 	if !ok || status == nil {
 		f.l.Infof("block chain status for %s is nil or not existed ", activity.ID.String())
-		return
+		return ""
 	}
 
 	activityStatus, ok := status.(common.ActivityStatus)
 	if !ok {
 		f.l.Errorw("ERROR: status cannot be asserted to common.ActivityStatus", "status", status)
-		return
+		return ""
 	}
 	f.l.Infof("In PersistSnapshot: blockchain activity status for %+v: %+v", activity.ID, activityStatus)
 	if activity.IsBlockchainPending() {
@@ -446,23 +576,26 @@ func (f *Fetcher) updateActivitywithBlockchainStatus(activity *common.ActivityRe
 		activity.Result.StatusError = ""
 	}
 	activity.Result.BlockNumber = activityStatus.BlockNumber
+	return activityStatus.MiningStatus
 }
 
-func (f *Fetcher) updateActivitywithExchangeStatus(activity *common.ActivityRecord, estatuses *sync.Map, snapshot *common.AuthDataSnapshot) {
+// updateActivitywithExchangeStatus updates activity with its status on exchange and returns the
+// reported exchange status, an empty status means exchange has not known the result yet.
+func (f *Fetcher) updateActivitywithExchangeStatus(activity *common.ActivityRecord, estatuses *sync.Map, snapshot *common.AuthDataSnapshot) string {
 	status, ok := estatuses.Load(activity.ID)
 	if !ok || status == nil {
 		f.l.Infof("exchange status for %s is nil or not existed ", activity.ID.String())
-		return
+		return ""
 	}
 	activityStatus, ok := status.(common.ActivityStatus)
 	if !ok {
 		f.l.Errorw("ERROR: status cannot be asserted to common.ActivityStatus", "status", status)
-		return
+		return ""
 	}
 	f.l.Infof("In PersistSnapshot: exchange activity status for %+v: %+v", activity.ID, activityStatus)
-	if activity.IsExchangePending() {
-		activity.ExchangeStatus = activityStatus.ExchangeStatus
-	} else if activityStatus.ExchangeStatus == common.ExchangeStatusFailed {
+	// the current status is kept until exchange reports one
+	if activityStatus.ExchangeStatus != "" &&
+		(activity.IsExchangePending() || activityStatus.ExchangeStatus == common.ExchangeStatusFailed) {
 		activity.ExchangeStatus = activityStatus.ExchangeStatus
 	}
 
@@ -477,6 +610,7 @@ func (f *Fetcher) updateActivitywithExchangeStatus(activity *common.ActivityReco
 	} else {
 		activity.Result.StatusError = ""
 	}
+	return activityStatus.ExchangeStatus
 }
 
 // PersistSnapshot save a authdata snapshot into db
@@ -528,18 +662,26 @@ func (f *Fetcher) PersistSnapshot(
 	})
 
 	pendingActivities := []common.ActivityRecord{}
-	for _, activity := range pendings {
-		activity := activity
-		f.updateActivitywithExchangeStatus(&activity, estatuses, snapshot)
-		f.updateActivitywithBlockchainStatus(&activity, bstatuses, snapshot)
-		f.l.Infof("Aggregate statuses, final activity: %+v", activity)
-		if activity.IsPending() {
-			pendingActivities = append(pendingActivities, activity)
+	for _, stored := range pendings {
+		activity := stored
+		update := common.ActivityUpdate{
+			Source:            common.ActivitySourceFetcher,
+			RawExchangeStatus: f.updateActivitywithExchangeStatus(&activity, estatuses, snapshot),
+			RawMiningStatus:   f.updateActivitywithBlockchainStatus(&activity, bstatuses, snapshot),
 		}
-		err := f.storage.UpdateActivity(activity.ID, activity)
-		if err != nil {
+		f.l.Infof("Aggregate statuses, final activity: %+v", activity)
+		err := f.storage.UpdateActivity(activity.ID, activity, update)
+		if errors.Is(err, common.ErrInvalidTransition) {
+			// the activity is kept in its stored statuses until a valid status is fetched
+			f.l.Warnw("ignored invalid activity status", "id", activity.ID.String(), "err", err)
+			activity = stored
+		} else if err != nil {
 			snapshot.Valid = false
 			snapshot.Error = err.Error()
+			activity = stored
+		}
+		if activity.IsPending() {
+			pendingActivities = append(pendingActivities, activity)
 		}
 	}
 	// note: only update status when it's pending status
@@ -565,12 +707,41 @@ func (f *Fetcher) PersistSnapshot(
 	return f.storage.StoreAuthSnapshot(snapshot, timepoint)
 }
 
-func (f *Fetcher) FetchAuthDataFromExchange(
-	wg *sync.WaitGroup, exchange Exchange,
-	allBalances *sync.Map, allStatuses *sync.Map,
+// fetchExchangeAuthData fetches balances and statuses of pending activities of the exchange as its
+// latest auth data.
+func (f *Fetcher) fetchExchangeAuthData(ctx context.Context, exchange Exchange, timepoint uint64) error {
+	pendings, err := f.storage.GetPendingActivities()
+	if err != nil {
+		return err
+	}
+	balances, statuses, err := f.fetchAuthDataFromExchange(ctx, exchange, pendings, timepoint)
+	if ctx.Err() != nil {
+		// the result is discarded as the job is timed out or stopped
+		return ctx.Err()
+	}
+	if err != nil {
+		balances = common.EBalanceEntry{
+			Error:      err.Error(),
+			Timestamp:  common.Timestamp(strconv.FormatUint(timepoint, 10)),
+			ReturnTime: common.GetTimestamp(),
+		}
+		statuses = nil
+	}
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	if f.authData[exchange.ID()].timepoint <= timepoint {
+		f.authData[exchange.ID()] = exchangeAuthData{timepoint: timepoint, balances: balances, statuses: statuses}
+	}
+	return err
+}
+
+// fetchAuthDataFromExchange fetches balances and statuses of pending activities of the exchange,
+// requests to the exchange are cancelled once ctx is done.
+func (f *Fetcher) fetchAuthDataFromExchange(
+	ctx context.Context,
+	exchange Exchange,
 	pendings []common.ActivityRecord,
-	timepoint uint64) {
-	defer wg.Done()
+	timepoint uint64) (common.EBalanceEntry, map[common.ActivityID]common.ActivityStatus, error) {
 	// we apply double check strategy to mitigate race condition on exchange side like this:
 	// 1. Get list of pending activity status (A)
 	// 2. Get list of balances (B)
@@ -581,8 +752,11 @@ func (f *Fetcher) FetchAuthDataFromExchange(
 	var err error
 	var tokenAddress map[common.AssetID]ethereum.Address
 	for {
-		preStatuses := f.FetchStatusFromExchange(exchange, pendings, timepoint)
-		balances, err = exchange.FetchEBalanceData(timepoint)
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		preStatuses := f.FetchStatusFromExchange(ctx, exchange, pendings, timepoint)
+		balances, err = exchange.FetchEBalanceData(ctx, timepoint)
 		if err != nil {
 			f.l.Warnw("Fetching exchange balances failed", "exchange", exchange.ID().String(), "err", err)
 			break
@@ -611,22 +785,25 @@ func (f *Fetcher) FetchAuthDataFromExchange(
 			}
 		}
 
-		statuses = f.FetchStatusFromExchange(exchange, pendings, timepoint)
+		statuses = f.FetchStatusFromExchange(ctx, exchange, pendings, timepoint)
 		if unchanged(preStatuses, statuses) {
 			break
 		}
 	}
-	if err == nil {
-		allBalances.Store(exchange.ID(), balances)
-		for id, activityStatus := range statuses {
-			allStatuses.Store(id, activityStatus)
-		}
-	}
+	return balances, statuses, err
 }
 
-func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.ActivityRecord, timepoint uint64) map[common.ActivityID]common.ActivityStatus {
+// FetchStatusFromExchange returns statuses of the pending activities on the exchange, requests to
+// the exchange are cancelled once ctx is done.
+func (f *Fetcher) FetchStatusFromExchange(ctx context.Context, exchange Exchange, pendings []common.ActivityRecord, timepoint uint64) map[common.ActivityID]common.ActivityStatus {
 	result := map[common.ActivityID]common.ActivityStatus{}
 	for _, activity := range pendings {
+		if activity.Action == common.ActionTransfer {
+			if status, ok := f.fetchTransferStatus(ctx, exchange, activity, timepoint); ok {
+				result[activity.ID] = status
+			}
+			continue
+		}
 		if activity.IsExchangePending() && activity.Destination == exchange.ID().String() {
 			var err error
 			var status string
@@ -643,20 +820,30 @@ func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.A
 				quote := activity.Params.Quote
 				// we ignore error of order status because it doesn't affect
 				// authdata. Analytic will ignore order status anyway.
-				status, _ = exchange.OrderStatus(orderID, base, quote)
+				status, _ = exchange.OrderStatus(ctx, orderID, base, quote)
 			case common.ActionDeposit:
 				txHash := activity.Result.Tx
 				amount := activity.Params.Amount
 				assetID := activity.Params.Asset
 
-				status, err = exchange.DepositStatus(id, txHash, assetID, amount, timepoint)
+				status, err = exchange.DepositStatus(ctx, id, txHash, assetID, amount, timepoint)
 				f.l.Infof("Got deposit status for %v: (%s), error(%v)", activity, status, err)
 			case common.ActionWithdraw:
 				amount := activity.Params.Amount
 				assetID := activity.Params.Asset
 
-				status, tx, err = exchange.WithdrawStatus(id.EID, assetID, amount, timepoint)
+				status, tx, err = exchange.WithdrawStatus(ctx, id.EID, assetID, amount, timepoint)
 				f.l.Infof("Got withdraw status for %v: (%s), error(%v)", activity, status, err)
+			case common.ActionInternalTransfer:
+				transferExchange, ok := exchange.(common.InternalTransferExchange)
+				if !ok {
+					continue
+				}
+				status, err = transferExchange.InternalTransferStatus(ctx, activity.Result.ID, timepoint)
+				f.l.Infof("Got internal transfer status for %v: (%s), error(%v)", activity, status, err)
+			case common.ActionExecution:
+				// executions are worked by core, only their lifetime is checked here
+				status = activity.ExchangeStatus
 			default:
 				continue
 			}
@@ -667,7 +854,7 @@ func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.A
 			if err1 != nil {
 				f.l.Infof("Activity %+v has invalid timestamp. Just ignore it.", activity)
 			} else {
-				if common.NowInMillis()-timepoint > maxActivityLifeTime*uint64(time.Hour)/uint64(time.Millisecond) {
+				if f.activityLifetime.Expired(activity.Action, exchange.ID().String(), timepoint, common.NowInMillis()) {
 					result[id] = common.NewActivityStatus(common.ExchangeStatusFailed, tx, blockNum, activity.MiningStatus, err)
 				} else {
 					result[id] = common.NewActivityStatus(status, tx, blockNum, activity.MiningStatus, err)
@@ -679,9 +866,9 @@ func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.A
 				f.l.Infof("Activity %+v has invalid timestamp. Just ignore it.", activity)
 			} else if activity.Destination == exchange.ID().String() &&
 				activity.ExchangeStatus == common.ExchangeStatusDone &&
-				common.NowInMillis()-timepoint > maxActivityLifeTime*uint64(time.Hour)/uint64(time.Millisecond) {
+				f.activityLifetime.Expired(activity.Action, exchange.ID().String(), timepoint, common.NowInMillis()) {
 				// the activity is still pending but its exchange status is done and it is stuck there for more than
-				// maxActivityLifeTime. This activity is considered failed.
+				// its lifetime. This activity is considered failed.
 				result[activity.ID] = common.NewActivityStatus(common.ExchangeStatusFailed, "", 0, activity.MiningStatus, nil)
 			}
 		}
@@ -689,61 +876,94 @@ func (f *Fetcher) FetchStatusFromExchange(exchange Exchange, pendings []common.A
 	return result
 }
 
-func (f *Fetcher) RunOrderbookFetcher() {
-	for {
-		f.l.Infof("waiting for signal from runner orderbook channel")
-		t := <-f.runner.GetOrderbookTicker()
-		f.l.Infof("got signal in orderbook channel with timestamp %d", common.TimeToMillis(t))
-		f.FetchOrderbook(common.TimeToMillis(t))
-		f.l.Info("fetched data from exchanges")
+// fetchTransferStatus returns status of a transfer activity. The withdrawal is tracked on the source
+// exchange until it is done, then the deposit of withdrawal tx is tracked on the destination exchange.
+func (f *Fetcher) fetchTransferStatus(ctx context.Context, exchange Exchange, activity common.ActivityRecord, timepoint uint64) (common.ActivityStatus, bool) {
+	var (
+		status = activity.ExchangeStatus
+		tx     = activity.Result.Tx
+		err    error
+	)
+	if !activity.IsExchangePending() {
+		return common.ActivityStatus{}, false
+	}
+	switch {
+	case activity.ExchangeStatus != common.ExchangeStatusPending && activity.Params.Exchange == exchange.ID():
+		var withdrawStatus string
+		withdrawStatus, tx, err = exchange.WithdrawStatus(ctx, activity.Result.ID, activity.Params.Asset, activity.Params.Amount, timepoint)
+		f.l.Infof("Got transfer withdraw status for %v: (%s), error(%v)", activity, withdrawStatus, err)
+		switch withdrawStatus {
+		case common.ExchangeStatusDone:
+			// withdrawal is done, waiting for the deposit on destination exchange
+			status = common.ExchangeStatusPending
+		case common.ExchangeStatusFailed:
+			status = common.ExchangeStatusFailed
+		}
+	case activity.ExchangeStatus == common.ExchangeStatusPending && activity.Params.DestinationExchange == exchange.ID():
+		var depositStatus string
+		depositStatus, err = exchange.DepositStatus(ctx, activity.ID, tx, activity.Params.Asset, activity.Params.Amount, timepoint)
+		f.l.Infof("Got transfer deposit status for %v: (%s), error(%v)", activity, depositStatus, err)
+		switch depositStatus {
+		case common.ExchangeStatusDone, common.ExchangeStatusFailed:
+			status = depositStatus
+		}
+	default:
+		return common.ActivityStatus{}, false
+	}
+
+	if f.activityLifetime.Expired(activity.Action, exchange.ID().String(), activity.Timestamp.Millis(), common.NowInMillis()) {
+		status = common.ExchangeStatusFailed
 	}
+	return common.NewActivityStatus(status, tx, 0, activity.MiningStatus, err), true
 }
 
-func (f *Fetcher) FetchOrderbook(timepoint uint64) {
+// FetchOrderbook stores a snapshot of the latest order books fetched from exchanges.
+func (f *Fetcher) FetchOrderbook(ctx context.Context, timepoint uint64) error {
+	if f.runner != nil {
+		f.runExchangeJobs(ctx, common.FetcherJobOrderBook, timepoint)
+	}
 	data := NewConcurrentAllPriceData()
-	// start fetching
-	wait := sync.WaitGroup{}
-	for _, exchange := range f.exchanges {
-		wait.Add(1)
-		go f.fetchPriceFromExchange(&wait, exchange, data, timepoint)
+	f.cacheMu.Lock()
+	for exchangeID, prices := range f.prices {
+		if f.stale(common.FetcherJobOrderBook, exchangeID, prices.timepoint) {
+			continue
+		}
+		for pair, exchangeData := range prices.data {
+			data.SetOnePrice(exchangeID, pair, exchangeData)
+		}
 	}
-	wait.Wait()
+	f.cacheMu.Unlock()
 	data.SetBlockNumber(f.currentBlock)
 	err := f.storage.StorePrice(data.GetData(), timepoint)
 	if err != nil {
 		f.l.Warnw("Storing data failed", "err", err)
 	}
+	return err
 }
 
-func (f *Fetcher) fetchPriceFromExchange(wg *sync.WaitGroup, exchange Exchange, data *ConcurrentAllPriceData, timepoint uint64) {
-	defer wg.Done()
+// fetchExchangeOrderbook fetches order books of the exchange as its latest prices, the prices of
+// the exchange are dropped if the fetch fails.
+func (f *Fetcher) fetchExchangeOrderbook(ctx context.Context, exchange Exchange, timepoint uint64) error {
 	exdata, err := exchange.FetchPriceData(timepoint)
+	if ctx.Err() != nil {
+		// the result is discarded as the job is timed out or stopped
+		return ctx.Err()
+	}
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
 	if err != nil {
 		f.l.Warnw("Fetching data failed", "exchange", exchange.ID().String(), "err", err)
-		return
-	}
-	for pair, exchangeData := range exdata {
-		data.SetOnePrice(exchange.ID(), pair, exchangeData)
+		delete(f.prices, exchange.ID())
+		return err
 	}
-}
-
-// RunFetchExchangeHistory starts a fetcher to get exchange trade history
-func (f *Fetcher) RunFetchExchangeHistory() {
-	for ; ; <-f.runner.GetExchangeHistoryTicker() {
-		f.l.Info("got signal in orderbook channel with exchange-history")
-		f.fetchExchangeTradeHistory()
-		f.l.Info("fetched data from exchanges")
+	if f.prices[exchange.ID()].timepoint <= timepoint {
+		f.prices[exchange.ID()] = exchangePrices{timepoint: timepoint, data: exdata}
 	}
+	return nil
 }
 
-func (f *Fetcher) fetchExchangeTradeHistory() {
-	wait := sync.WaitGroup{}
-	for _, exchange := range f.exchanges {
-		wait.Add(1)
-		go func(exchange Exchange) {
-			defer wait.Done()
-			exchange.FetchTradeHistory()
-		}(exchange)
-	}
-	wait.Wait()
+// fetchExchangeTradeHistory gets trade history of the exchange and saves it in the exchange db.
+func (f *Fetcher) fetchExchangeTradeHistory(ctx context.Context, exchange Exchange, timepoint uint64) error {
+	exchange.FetchTradeHistory()
+	return ctx.Err()
 }
@@ -1,11 +1,13 @@
 package fetcher
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/data/fetcher/httprunner"
@@ -179,3 +181,122 @@ func TestExchangeDown(t *testing.T) {
 		t.Fatalf("Snapshot did not save exchange error")
 	}
 }
+
+func TestFetcherStop(t *testing.T) {
+	runner := NewTickerRunner(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
+	f := NewFetcher(nil, nil, nil, runner, true, &common.ContractAddressConfiguration{})
+	if err := f.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- f.Stop()
+	}()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fetcher jobs are not stopped")
+	}
+}
+
+func TestFetcherRunContextDone(t *testing.T) {
+	runner := NewTickerRunner(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
+	f := NewFetcher(nil, nil, nil, runner, true, &common.ContractAddressConfiguration{})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := f.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		f.scheduler.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("fetcher jobs are not stopped when context is done")
+	}
+}
+
+func TestPersistSnapshotInvalidTransition(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "test_fetcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if rErr := os.RemoveAll(tmpDir); rErr != nil {
+			t.Error(rErr)
+		}
+	}()
+	fstorage, err := storage.NewBoltStorage(path.Join(tmpDir, "test_fetcher.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := NewTickerRunner(time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, time.Hour)
+	fetcher := NewFetcher(fstorage, fstorage, &world.TheWorld{}, runner, true, &common.ContractAddressConfiguration{})
+
+	id := common.NewActivityID(1, "withdrawid")
+	if err = fstorage.Record(common.ActionWithdraw, id, common.Binance.String(),
+		common.ActivityParams{Exchange: common.Binance, Asset: 1, Amount: 10},
+		common.ActivityResult{ID: "withdrawid"}, common.ExchangeStatusSubmitted, "", common.NowInMillis()); err != nil {
+		t.Fatal(err)
+	}
+	pendings, err := fstorage.GetPendingActivities()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a withdrawal can not be pending on exchange
+	var ebalances, estatuses, bstatuses sync.Map
+	estatuses.Store(id, common.NewActivityStatus(common.ExchangeStatusPending, "", 0, "", nil))
+	var snapshot common.AuthDataSnapshot
+	if err = fetcher.PersistSnapshot(&ebalances, nil, &estatuses, &bstatuses, pendings, &snapshot, common.NowInMillis()); err != nil {
+		t.Fatalf("Cannot persist snapshot: %s", err.Error())
+	}
+
+	if len(snapshot.PendingActivities) != 1 {
+		t.Fatalf("Expected 1 pending activity, got %d", len(snapshot.PendingActivities))
+	}
+	if status := snapshot.PendingActivities[0].ExchangeStatus; status != common.ExchangeStatusSubmitted {
+		t.Fatalf("Expected pending activity to keep its stored status, got %q", status)
+	}
+	stored, err := fstorage.GetActivity(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.ExchangeStatus != common.ExchangeStatusSubmitted {
+		t.Fatalf("Expected stored activity to keep its status, got %q", stored.ExchangeStatus)
+	}
+}
+
+// blockingExchange blocks fetching balances until the context of the request is done.
+type blockingExchange struct {
+	testTransferExchange
+}
+
+func (e blockingExchange) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
+	<-ctx.Done()
+	return common.EBalanceEntry{}, ctx.Err()
+}
+
+func TestFetchAuthDataFromExchangeTimeout(t *testing.T) {
+	f := NewFetcher(nil, nil, nil, nil, true, &common.ContractAddressConfiguration{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := f.fetchAuthDataFromExchange(ctx, blockingExchange{testTransferExchange{id: common.Binance}}, nil, common.NowInMillis())
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("Expected deadline exceeded error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fetching auth data is not cancelled when the job is timed out")
+	}
+}
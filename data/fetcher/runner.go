@@ -70,12 +70,14 @@ func (tr *TickerRunner) Start() error {
 	return nil
 }
 
+// Stop stops all tickers of the runner.
 func (tr *TickerRunner) Stop() error {
 	tr.oclock.Stop()
 	tr.aclock.Stop()
 	tr.rclock.Stop()
 	tr.bclock.Stop()
 	tr.globalDataClock.Stop()
+	tr.hClock.Stop()
 	return nil
 }
 
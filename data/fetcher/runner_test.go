@@ -43,3 +43,19 @@ func TestNewTickerRunner(t *testing.T) {
 		}
 	}
 }
+
+func TestTickerRunnerStop(t *testing.T) {
+	runner := NewTickerRunner(time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond)
+	require.NoError(t, runner.Start())
+	require.NoError(t, runner.Stop())
+	// drain ticks sent before the tickers are stopped
+	time.Sleep(time.Millisecond * 5)
+	for len(runner.GetExchangeHistoryTicker()) > 0 {
+		<-runner.GetExchangeHistoryTicker()
+	}
+	select {
+	case <-runner.GetExchangeHistoryTicker():
+		t.Fatal("exchange history ticker is not stopped")
+	case <-time.After(time.Millisecond * 5):
+	}
+}
@@ -0,0 +1,289 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+// JobFunc is a fetcher job, it should give up and return ctx.Err() once ctx is done.
+type JobFunc func(ctx context.Context, timepoint uint64) error
+
+// JobKey identifies a scheduled job, Exchange is empty for jobs which are not bound to an exchange.
+type JobKey struct {
+	Job      string
+	Exchange string
+}
+
+func (k JobKey) String() string {
+	if k.Exchange == "" {
+		return k.Job
+	}
+	return k.Job + "/" + k.Exchange
+}
+
+// errJobSkipped is returned when a job is not run as max concurrency runs are in progress.
+var errJobSkipped = errors.New("job is skipped as max concurrency runs are in progress")
+
+type scheduledJob struct {
+	key     JobKey
+	fn      JobFunc
+	trigger <-chan time.Time
+	// reset wakes the job loop up when the config is changed
+	reset chan struct{}
+
+	mu     sync.Mutex
+	config common.FetcherJobConfig
+	status common.FetcherJobStatus
+}
+
+// Scheduler runs jobs periodically, each job has its own interval, timeout, jitter and max
+// concurrency which can be changed while the scheduler is running.
+type Scheduler struct {
+	mu   sync.RWMutex
+	jobs map[JobKey]*scheduledJob
+	wg   sync.WaitGroup
+	l    *zap.SugaredLogger
+
+	randMu sync.Mutex
+	rand   *rand.Rand
+}
+
+// NewScheduler creates a scheduler without jobs.
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		jobs: make(map[JobKey]*scheduledJob),
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		l:    zap.S(),
+	}
+}
+
+// Add adds a job to the scheduler, it must be called before Run. The job runs on every value of
+// trigger if it is not nil, otherwise on its interval.
+func (s *Scheduler) Add(key JobKey, config common.FetcherJobConfig, trigger <-chan time.Time, fn JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[key] = &scheduledJob{
+		key:     key,
+		fn:      fn,
+		trigger: trigger,
+		reset:   make(chan struct{}, 1),
+		config:  config,
+		status:  common.FetcherJobStatus{Job: key.Job, Exchange: key.Exchange},
+	}
+}
+
+// Run starts the jobs, they stop when ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job *scheduledJob) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Wait waits for the jobs to stop, including the runs in progress.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// delay returns time until the next run of the job, zero if the job is not scheduled.
+func (s *Scheduler) delay(job *scheduledJob) time.Duration {
+	job.mu.Lock()
+	config := job.config
+	job.mu.Unlock()
+	if config.Interval <= 0 {
+		return 0
+	}
+	delay := time.Duration(config.Interval)
+	if config.Jitter > 0 {
+		s.randMu.Lock()
+		delay += time.Duration(s.rand.Int63n(int64(config.Jitter)))
+		s.randMu.Unlock()
+	}
+	return delay
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *scheduledJob) {
+	for {
+		var (
+			tick  = job.trigger
+			timer *time.Timer
+		)
+		if tick == nil {
+			if delay := s.delay(job); delay > 0 {
+				timer = time.NewTimer(delay)
+				tick = timer.C
+			} else {
+				s.l.Infow("fetcher job is not scheduled as its interval is not configured", "job", job.key.String())
+			}
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-job.reset:
+			if timer != nil {
+				timer.Stop()
+			}
+		case t := <-tick:
+			if _, _, err := s.start(ctx, job, common.TimeToMillis(t)); err != nil {
+				s.l.Warnw("fetcher job is skipped", "job", job.key.String(), "err", err)
+			}
+		}
+	}
+}
+
+// start starts a run of the job in background, it returns the channel receiving the result of
+// the run and the context of the run which is done on timeout.
+func (s *Scheduler) start(ctx context.Context, job *scheduledJob, timepoint uint64) (<-chan error, context.Context, error) {
+	job.mu.Lock()
+	config := job.config
+	maxConcurrency := config.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = 1
+	}
+	if job.status.Running >= maxConcurrency {
+		job.status.Skipped++
+		job.mu.Unlock()
+		return nil, nil, errJobSkipped
+	}
+	job.status.Running++
+	job.mu.Unlock()
+
+	runCtx, cancel := ctx, context.CancelFunc(func() {})
+	if config.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, time.Duration(config.Timeout))
+	}
+	result := make(chan error, 1)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		started := time.Now()
+		err := job.fn(runCtx, timepoint)
+		elapsed := time.Since(started)
+		defer func() {
+			result <- err
+		}()
+
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		job.status.Running--
+		job.status.Runs++
+		job.status.LastStart = common.TimeToMillis(started)
+		job.status.LastDuration = common.HumanDuration(elapsed)
+		if runCtx.Err() == context.DeadlineExceeded {
+			job.status.Timeouts++
+		}
+		if err != nil {
+			job.status.Failures++
+			job.status.LastError = err.Error()
+		}
+		if job.config.Interval > 0 && elapsed > time.Duration(job.config.Interval) {
+			job.status.Overruns++
+		}
+	}()
+	return result, runCtx, nil
+}
+
+func (s *Scheduler) job(key JobKey) (*scheduledJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[key]
+	if !ok {
+		return nil, fmt.Errorf("fetcher job %s is not found", key)
+	}
+	return job, nil
+}
+
+// RunNow runs the job and waits for it to finish or time out. The run counts toward the
+// concurrency and statistics of the job.
+func (s *Scheduler) RunNow(ctx context.Context, key JobKey, timepoint uint64) error {
+	job, err := s.job(key)
+	if err != nil {
+		return err
+	}
+	result, runCtx, err := s.start(ctx, job, timepoint)
+	if err != nil {
+		return err
+	}
+	select {
+	case err = <-result:
+		return err
+	case <-runCtx.Done():
+		// the run may have finished at the same time
+		select {
+		case err = <-result:
+			return err
+		default:
+			return runCtx.Err()
+		}
+	}
+}
+
+// Config returns the schedule of the job.
+func (s *Scheduler) Config(key JobKey) (common.FetcherJobConfig, error) {
+	job, err := s.job(key)
+	if err != nil {
+		return common.FetcherJobConfig{}, err
+	}
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	return job.config, nil
+}
+
+// SetConfig changes the schedule of the job, the next run is rescheduled with the new interval.
+func (s *Scheduler) SetConfig(key JobKey, config common.FetcherJobConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	job, err := s.job(key)
+	if err != nil {
+		return err
+	}
+	job.mu.Lock()
+	job.config = config
+	job.mu.Unlock()
+	select {
+	case job.reset <- struct{}{}:
+	default:
+	}
+	s.l.Infow("fetcher job schedule is changed", "job", key.String(), "config", config)
+	return nil
+}
+
+// Jobs returns schedules and statistics of all jobs, sorted by job then exchange.
+func (s *Scheduler) Jobs() []common.FetcherJobStatus {
+	s.mu.RLock()
+	result := make([]common.FetcherJobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		job.mu.Lock()
+		status := job.status
+		status.Config = job.config
+		job.mu.Unlock()
+		result = append(result, status)
+	}
+	s.mu.RUnlock()
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Job != result[j].Job {
+			return result[i].Job < result[j].Job
+		}
+		return result[i].Exchange < result[j].Exchange
+	})
+	return result
+}
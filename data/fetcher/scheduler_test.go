@@ -0,0 +1,95 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+func TestSchedulerRunNow(t *testing.T) {
+	var (
+		scheduler = NewScheduler()
+		key       = JobKey{Job: common.FetcherJobAuthData, Exchange: "huobi"}
+		release   = make(chan struct{})
+	)
+	scheduler.Add(key, common.FetcherJobConfig{
+		Interval: common.HumanDuration(50 * time.Millisecond),
+		Timeout:  common.HumanDuration(10 * time.Millisecond),
+	}, make(chan time.Time), func(ctx context.Context, timepoint uint64) error {
+		select {
+		case <-release:
+			return errors.New("failed")
+		case <-ctx.Done():
+			<-release
+			return ctx.Err()
+		}
+	})
+
+	// the run is timed out, the next one is skipped while the first is still in progress
+	assert.Equal(t, context.DeadlineExceeded, scheduler.RunNow(context.Background(), key, 1))
+	assert.Equal(t, errJobSkipped, scheduler.RunNow(context.Background(), key, 2))
+	// the timed out run takes longer than the interval
+	time.Sleep(50 * time.Millisecond)
+	release <- struct{}{}
+	scheduler.Wait()
+
+	go func() {
+		release <- struct{}{}
+	}()
+	assert.EqualError(t, scheduler.RunNow(context.Background(), key, 3), "failed")
+	scheduler.Wait()
+
+	jobs := scheduler.Jobs()
+	require.Len(t, jobs, 1)
+	status := jobs[0]
+	assert.Equal(t, "auth_data", status.Job)
+	assert.Equal(t, "huobi", status.Exchange)
+	assert.Equal(t, uint64(2), status.Runs)
+	assert.Equal(t, uint64(1), status.Skipped)
+	assert.Equal(t, uint64(1), status.Timeouts)
+	assert.Equal(t, uint64(2), status.Failures)
+	assert.Equal(t, uint64(1), status.Overruns)
+	assert.Equal(t, "failed", status.LastError)
+	assert.Equal(t, 0, status.Running)
+
+	_, err := scheduler.Config(JobKey{Job: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestSchedulerInterval(t *testing.T) {
+	var (
+		scheduler = NewScheduler()
+		key       = JobKey{Job: common.FetcherJobRate}
+		runs      int32
+	)
+	// the job is not scheduled until its interval is configured
+	scheduler.Add(key, common.FetcherJobConfig{}, nil, func(ctx context.Context, timepoint uint64) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler.Run(ctx)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&runs))
+
+	assert.Error(t, scheduler.SetConfig(key, common.FetcherJobConfig{Interval: -1}))
+	require.NoError(t, scheduler.SetConfig(key, common.FetcherJobConfig{
+		Interval: common.HumanDuration(time.Millisecond),
+		Jitter:   common.HumanDuration(time.Millisecond),
+	}))
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	scheduler.Wait()
+	assert.True(t, atomic.LoadInt32(&runs) > 1)
+
+	config, err := scheduler.Config(key)
+	require.NoError(t, err)
+	assert.Equal(t, common.HumanDuration(time.Millisecond), config.Interval)
+}
@@ -11,7 +11,7 @@ type Storage interface {
 	StoreAuthSnapshot(data *common.AuthDataSnapshot, timepoint uint64) error
 
 	GetPendingActivities() ([]common.ActivityRecord, error)
-	UpdateActivity(id common.ActivityID, act common.ActivityRecord) error
+	UpdateActivity(id common.ActivityID, act common.ActivityRecord, update common.ActivityUpdate) error
 
 	CurrentAuthDataVersion(timepoint uint64) (common.Version, error)
 	GetAuthData(common.Version) (common.AuthDataSnapshot, error)
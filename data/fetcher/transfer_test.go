@@ -0,0 +1,146 @@
+package fetcher
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type testTransferExchange struct {
+	id             common.ExchangeID
+	withdrawStatus string
+	depositStatus  string
+}
+
+func (te testTransferExchange) ID() common.ExchangeID {
+	return te.id
+}
+
+func (te testTransferExchange) FetchPriceData(timepoint uint64) (map[uint64]common.ExchangePrice, error) {
+	return nil, nil
+}
+
+func (te testTransferExchange) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
+	return common.EBalanceEntry{}, nil
+}
+
+func (te testTransferExchange) FetchTradeHistory() {}
+
+func (te testTransferExchange) OrderStatus(ctx context.Context, id string, base, quote string) (string, error) {
+	return "", nil
+}
+
+func (te testTransferExchange) DepositStatus(ctx context.Context, id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
+	return te.depositStatus, nil
+}
+
+func (te testTransferExchange) WithdrawStatus(ctx context.Context, id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
+	return te.withdrawStatus, "0xabc", nil
+}
+
+func (te testTransferExchange) TokenAddresses() (map[common.AssetID]ethereum.Address, error) {
+	return nil, nil
+}
+
+func TestFetchTransferStatus(t *testing.T) {
+	var (
+		f        = NewFetcher(nil, nil, nil, nil, true, &common.ContractAddressConfiguration{})
+		now      = common.NowInMillis()
+		binance  = testTransferExchange{id: common.Binance, withdrawStatus: common.ExchangeStatusDone}
+		huobi    = testTransferExchange{id: common.Huobi, depositStatus: common.ExchangeStatusDone}
+		activity = common.NewActivityRecord(
+			common.ActionTransfer,
+			common.NewActivityID(now, "withdrawid"),
+			common.Binance.String(),
+			common.ActivityParams{
+				Exchange:            common.Binance,
+				DestinationExchange: common.Huobi,
+				Asset:               1,
+				Amount:              10,
+			},
+			common.ActivityResult{ID: "withdrawid"},
+			common.ExchangeStatusSubmitted,
+			"",
+			common.Timestamp(strconv.FormatUint(now, 10)),
+		)
+	)
+
+	// destination exchange does not track the transfer until withdrawal is done
+	statuses := f.FetchStatusFromExchange(context.Background(), huobi, []common.ActivityRecord{activity}, now)
+	assert.Len(t, statuses, 0)
+
+	statuses = f.FetchStatusFromExchange(context.Background(), binance, []common.ActivityRecord{activity}, now)
+	require.Contains(t, statuses, activity.ID)
+	assert.Equal(t, common.ExchangeStatusPending, statuses[activity.ID].ExchangeStatus)
+	assert.Equal(t, "0xabc", statuses[activity.ID].Tx)
+
+	activity.ExchangeStatus = common.ExchangeStatusPending
+	activity.Result.Tx = "0xabc"
+	statuses = f.FetchStatusFromExchange(context.Background(), binance, []common.ActivityRecord{activity}, now)
+	assert.Len(t, statuses, 0)
+
+	statuses = f.FetchStatusFromExchange(context.Background(), huobi, []common.ActivityRecord{activity}, now)
+	require.Contains(t, statuses, activity.ID)
+	assert.Equal(t, common.ExchangeStatusDone, statuses[activity.ID].ExchangeStatus)
+
+	activity.ExchangeStatus = common.ExchangeStatusDone
+	assert.False(t, activity.IsPending())
+}
+
+type testInternalTransferExchange struct {
+	testTransferExchange
+}
+
+func (te testInternalTransferExchange) SupportInternalTransfer(from, to common.ExchangeID) bool {
+	return true
+}
+
+func (te testInternalTransferExchange) InternalTransfer(from, to common.ExchangeID, asset commonv3.Asset, amount *big.Int) (string, error) {
+	return "", nil
+}
+
+func (te testInternalTransferExchange) InternalTransferStatus(ctx context.Context, id string, timepoint uint64) (string, error) {
+	if id != "tranid" {
+		return "", nil
+	}
+	return common.ExchangeStatusDone, nil
+}
+
+func TestFetchInternalTransferStatus(t *testing.T) {
+	var (
+		f        = NewFetcher(nil, nil, nil, nil, true, &common.ContractAddressConfiguration{})
+		now      = common.NowInMillis()
+		binance  = testInternalTransferExchange{testTransferExchange{id: common.Binance}}
+		activity = common.NewActivityRecord(
+			common.ActionInternalTransfer,
+			common.NewActivityID(now, "tranid"),
+			common.Binance.String(),
+			common.ActivityParams{
+				Exchange:            common.Binance2,
+				DestinationExchange: common.Binance,
+				Asset:               1,
+				Amount:              10,
+			},
+			common.ActivityResult{ID: "tranid"},
+			common.ExchangeStatusSubmitted,
+			"",
+			common.Timestamp(strconv.FormatUint(now, 10)),
+		)
+	)
+	assert.True(t, activity.IsPending())
+
+	statuses := f.FetchStatusFromExchange(context.Background(), binance, []common.ActivityRecord{activity}, now)
+	require.Contains(t, statuses, activity.ID)
+	assert.Equal(t, common.ExchangeStatusDone, statuses[activity.ID].ExchangeStatus)
+
+	activity.ExchangeStatus = common.ExchangeStatusDone
+	assert.False(t, activity.IsPending())
+}
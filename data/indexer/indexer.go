@@ -0,0 +1,203 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+const (
+	defaultInterval  = time.Minute
+	defaultBatchSize = 1000
+	// keptBlocks is the number of indexed blocks which hashes are kept to find where a reorg
+	// starts.
+	keptBlocks = 128
+)
+
+// Chain is the blockchain trade logs of the reserve contract are indexed from. All queries of
+// an indexing step are sent to the sticky node so the head, headers and logs are of one chain.
+type Chain interface {
+	StickyNode() *blockchain.Node
+	CurrentBlockFrom(node *blockchain.Node) (uint64, error)
+	HeaderByNumber(node *blockchain.Node, number uint64) (*types.Header, error)
+	GetTradeLogs(node *blockchain.Node, fromBlock, toBlock uint64) ([]common.OnChainTrade, error)
+}
+
+// IndexedBlock is the last block of an indexing step and its hash.
+type IndexedBlock struct {
+	Number uint64
+	Hash   ethereum.Hash
+}
+
+// Storage persists indexed trades and hashes of indexed blocks.
+type Storage interface {
+	// IndexedBlocks returns the latest indexed blocks from the newest one.
+	IndexedBlocks(limit int) ([]IndexedBlock, error)
+	// StoreTrades stores trades indexed up to the block and the block in one transaction.
+	StoreTrades(trades []common.OnChainTrade, block IndexedBlock, keep int) error
+	// Rewind removes trades and indexed blocks after the block.
+	Rewind(block uint64) error
+	// GetTrades returns trades made in [fromTime, toTime].
+	GetTrades(fromTime, toTime uint64) ([]common.OnChainTrade, error)
+}
+
+// Indexer follows TradeExecute logs of the reserve contract from the start block and stores them.
+// Hashes of indexed blocks are checked before every step, trades of blocks which are reorganized
+// out of the chain are removed and indexed again.
+type Indexer struct {
+	chain   Chain
+	storage Storage
+	config  common.OnChainIndexerConfig
+	l       *zap.SugaredLogger
+}
+
+// NewIndexer creates a new Indexer instance.
+func NewIndexer(chain Chain, storage Storage, config common.OnChainIndexerConfig) *Indexer {
+	if config.Interval == 0 {
+		config.Interval = common.HumanDuration(defaultInterval)
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	return &Indexer{
+		chain:   chain,
+		storage: storage,
+		config:  config,
+		l:       zap.S(),
+	}
+}
+
+// Enabled returns true if a start block is configured.
+func (ix *Indexer) Enabled() bool {
+	return ix.config.StartBlock > 0
+}
+
+// lastValidBlock returns the last indexed block which is still in the chain, trades after it
+// are removed.
+func (ix *Indexer) lastValidBlock(node *blockchain.Node) (uint64, error) {
+	blocks, err := ix.storage.IndexedBlocks(keptBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if len(blocks) == 0 {
+		return ix.config.StartBlock - 1, nil
+	}
+	for i, block := range blocks {
+		header, err := ix.chain.HeaderByNumber(node, block.Number)
+		if err != nil {
+			return 0, err
+		}
+		if header.Hash() != block.Hash {
+			continue
+		}
+		if i > 0 {
+			ix.l.Warnw("chain is reorganized, removing trades of reorganized blocks",
+				"from_block", block.Number+1, "to_block", blocks[0].Number)
+			if err := ix.storage.Rewind(block.Number); err != nil {
+				return 0, err
+			}
+		}
+		return block.Number, nil
+	}
+	ix.l.Errorw("chain is reorganized deeper than kept blocks, indexing again from start block",
+		"oldest_kept_block", blocks[len(blocks)-1].Number)
+	if err := ix.storage.Rewind(ix.config.StartBlock - 1); err != nil {
+		return 0, err
+	}
+	return ix.config.StartBlock - 1, nil
+}
+
+// Index indexes trades of the next batch of confirmed blocks and returns the last indexed block.
+func (ix *Indexer) Index() (uint64, error) {
+	node := ix.chain.StickyNode()
+	last, err := ix.lastValidBlock(node)
+	if err != nil {
+		return 0, err
+	}
+	head, err := ix.chain.CurrentBlockFrom(node)
+	if err != nil {
+		return last, err
+	}
+	if head < ix.config.Confirmations || head-ix.config.Confirmations <= last {
+		return last, nil
+	}
+	to := head - ix.config.Confirmations
+	if to-last > ix.config.BatchSize {
+		to = last + ix.config.BatchSize
+	}
+	// the header of the last block is fetched before logs, if the chain is reorganized in
+	// between the hash does not match in the next step and the batch is indexed again.
+	toHeader, err := ix.chain.HeaderByNumber(node, to)
+	if err != nil {
+		return last, err
+	}
+	trades, err := ix.chain.GetTradeLogs(node, last+1, to)
+	if err != nil {
+		return last, err
+	}
+	headers := map[uint64]*types.Header{to: toHeader}
+	for i := range trades {
+		header, ok := headers[trades[i].BlockNumber]
+		if !ok {
+			if header, err = ix.chain.HeaderByNumber(node, trades[i].BlockNumber); err != nil {
+				return last, err
+			}
+			headers[trades[i].BlockNumber] = header
+		}
+		if header.Hash() != trades[i].BlockHash {
+			return last, fmt.Errorf("block %d is reorganized while indexing", trades[i].BlockNumber)
+		}
+		trades[i].Timestamp = header.Time * 1000
+	}
+	if err := ix.storage.StoreTrades(trades, IndexedBlock{Number: to, Hash: toHeader.Hash()}, keptBlocks); err != nil {
+		return last, err
+	}
+	ix.l.Debugw("indexed reserve trades", "from_block", last+1, "to_block", to, "trades", len(trades))
+	return to, nil
+}
+
+// Run indexes new blocks periodically, blocks are indexed without waiting for the next tick
+// while the indexer is behind. It returns when the context is done.
+func (ix *Indexer) Run(ctx context.Context) {
+	if !ix.Enabled() {
+		ix.l.Infow("on-chain trade indexer is not configured")
+		return
+	}
+	ticker := time.NewTicker(time.Duration(ix.config.Interval))
+	defer ticker.Stop()
+	for {
+		last, err := ix.Index()
+		if err != nil {
+			ix.l.Warnw("failed to index reserve trades", "err", err)
+		}
+		if err == nil && ix.behind(last) {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// behind returns true if there are confirmed blocks after the last indexed one.
+func (ix *Indexer) behind(last uint64) bool {
+	head, err := ix.chain.CurrentBlockFrom(ix.chain.StickyNode())
+	if err != nil {
+		return false
+	}
+	return head >= ix.config.Confirmations && head-ix.config.Confirmations > last
+}
@@ -0,0 +1,249 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/blockchain"
+)
+
+// testChain is a chain which blocks can be replaced from a height to simulate reorgs.
+type testChain struct {
+	headers []*types.Header
+	trades  map[uint64][]common.OnChainTrade
+	// nodes are returned as the sticky node in turn, queried records the nodes queries are
+	// sent to.
+	nodes   []*blockchain.Node
+	queried []*blockchain.Node
+}
+
+func newTestChain(head uint64) *testChain {
+	c := &testChain{trades: make(map[uint64][]common.OnChainTrade)}
+	c.reorg(0, head, 0)
+	return c
+}
+
+// reorg replaces blocks from the height with a fork and extends the chain to the head.
+func (c *testChain) reorg(from, head uint64, fork byte) {
+	c.headers = c.headers[:from]
+	for number := from; number <= head; number++ {
+		header := &types.Header{Number: new(big.Int).SetUint64(number), Time: 1000 + number, Extra: []byte{fork}}
+		if number > 0 {
+			header.ParentHash = c.headers[number-1].Hash()
+		}
+		c.headers = append(c.headers, header)
+		delete(c.trades, number)
+	}
+}
+
+func (c *testChain) addTrade(block uint64, txHash string) {
+	c.trades[block] = append(c.trades[block], common.OnChainTrade{
+		BlockNumber: block,
+		BlockHash:   c.headers[block].Hash(),
+		TxHash:      ethereum.HexToHash(txHash),
+		SrcAmount:   big.NewInt(1),
+		DestAmount:  big.NewInt(1),
+	})
+}
+
+func (c *testChain) StickyNode() *blockchain.Node {
+	if len(c.nodes) == 0 {
+		return nil
+	}
+	node := c.nodes[0]
+	c.nodes = append(c.nodes[1:], node)
+	return node
+}
+
+func (c *testChain) CurrentBlockFrom(node *blockchain.Node) (uint64, error) {
+	c.queried = append(c.queried, node)
+	return uint64(len(c.headers) - 1), nil
+}
+
+func (c *testChain) HeaderByNumber(node *blockchain.Node, number uint64) (*types.Header, error) {
+	c.queried = append(c.queried, node)
+	return c.headers[number], nil
+}
+
+func (c *testChain) GetTradeLogs(node *blockchain.Node, fromBlock, toBlock uint64) ([]common.OnChainTrade, error) {
+	c.queried = append(c.queried, node)
+	var result []common.OnChainTrade
+	for number := fromBlock; number <= toBlock; number++ {
+		result = append(result, c.trades[number]...)
+	}
+	return result, nil
+}
+
+type testStorage struct {
+	trades []common.OnChainTrade
+	blocks []IndexedBlock
+}
+
+func (s *testStorage) IndexedBlocks(limit int) ([]IndexedBlock, error) {
+	var result []IndexedBlock
+	for i := len(s.blocks) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, s.blocks[i])
+	}
+	return result, nil
+}
+
+func (s *testStorage) StoreTrades(trades []common.OnChainTrade, block IndexedBlock, keep int) error {
+	s.trades = append(s.trades, trades...)
+	s.blocks = append(s.blocks, block)
+	if len(s.blocks) > keep {
+		s.blocks = s.blocks[len(s.blocks)-keep:]
+	}
+	return nil
+}
+
+func (s *testStorage) Rewind(block uint64) error {
+	var trades []common.OnChainTrade
+	for _, trade := range s.trades {
+		if trade.BlockNumber <= block {
+			trades = append(trades, trade)
+		}
+	}
+	s.trades = trades
+	var blocks []IndexedBlock
+	for _, b := range s.blocks {
+		if b.Number <= block {
+			blocks = append(blocks, b)
+		}
+	}
+	s.blocks = blocks
+	return nil
+}
+
+func (s *testStorage) GetTrades(fromTime, toTime uint64) ([]common.OnChainTrade, error) {
+	return s.trades, nil
+}
+
+func (s *testStorage) txHashes() []string {
+	var result []string
+	for _, trade := range s.trades {
+		result = append(result, trade.TxHash.Hex()[64:])
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestIndexer(t *testing.T) {
+	chain := newTestChain(20)
+	chain.addTrade(5, "0x01")
+	chain.addTrade(12, "0x02")
+	chain.addTrade(18, "0x03")
+	chain.addTrade(20, "0x04")
+	storage := &testStorage{}
+	ix := NewIndexer(chain, storage, common.OnChainIndexerConfig{StartBlock: 10, Confirmations: 2, BatchSize: 5})
+
+	last, err := ix.Index()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(14), last)
+	assert.Equal(t, []string{"02"}, storage.txHashes())
+	assert.Equal(t, uint64(1012000), storage.trades[0].Timestamp)
+
+	last, err = ix.Index()
+	require.NoError(t, err)
+	// confirmed blocks only
+	assert.Equal(t, uint64(18), last)
+	assert.Equal(t, []string{"02", "03"}, storage.txHashes())
+
+	last, err = ix.Index()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(18), last)
+
+	// blocks from 16 are reorganized, the trade of block 18 is gone and a new one is in block 17
+	chain.reorg(16, 22, 1)
+	chain.addTrade(17, "0x05")
+	last, err = ix.Index()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(19), last)
+	assert.Equal(t, []string{"02", "05"}, storage.txHashes())
+	blocks, err := storage.IndexedBlocks(keptBlocks)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexedBlock{
+		{Number: 19, Hash: chain.headers[19].Hash()},
+		{Number: 14, Hash: chain.headers[14].Hash()},
+	}, blocks)
+}
+
+func TestIndexerLogOfReorganizedBlock(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addTrade(3, "0x01")
+	// the log is returned from a block which is reorganized after logs are fetched
+	chain.trades[3][0].BlockHash = ethereum.HexToHash("0xff")
+	storage := &testStorage{}
+	ix := NewIndexer(chain, storage, common.OnChainIndexerConfig{StartBlock: 1})
+
+	last, err := ix.Index()
+	assert.Error(t, err)
+	assert.Equal(t, uint64(0), last)
+	assert.Empty(t, storage.trades)
+	assert.Empty(t, storage.blocks)
+}
+
+func TestIndexerDeepReorg(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addTrade(3, "0x01")
+	storage := &testStorage{}
+	ix := NewIndexer(chain, storage, common.OnChainIndexerConfig{StartBlock: 1, BatchSize: 5})
+
+	_, err := ix.Index()
+	require.NoError(t, err)
+	_, err = ix.Index()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"01"}, storage.txHashes())
+
+	// all kept blocks are reorganized, indexing starts again from the start block
+	chain.reorg(2, 10, 1)
+	chain.addTrade(4, "0x02")
+	last, err := ix.Index()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), last)
+	assert.Equal(t, []string{"02"}, storage.txHashes())
+}
+
+func TestIndexerQueriesStickyNode(t *testing.T) {
+	chain := newTestChain(10)
+	chain.addTrade(3, "0x01")
+	chain.addTrade(4, "0x02")
+	chain.nodes = []*blockchain.Node{
+		blockchain.NewNode("http://node1.example.com", nil),
+		blockchain.NewNode("http://node2.example.com", nil),
+	}
+	storage := &testStorage{}
+	ix := NewIndexer(chain, storage, common.OnChainIndexerConfig{StartBlock: 1})
+
+	_, err := ix.Index()
+	require.NoError(t, err)
+	require.NotEmpty(t, chain.queried)
+	for _, node := range chain.queried {
+		assert.Equal(t, chain.queried[0], node)
+	}
+}
+
+func TestIndexerRunStops(t *testing.T) {
+	storage := &testStorage{}
+	ix := NewIndexer(newTestChain(10), storage, common.OnChainIndexerConfig{StartBlock: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		ix.Run(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("indexer is not stopped")
+	}
+}
@@ -0,0 +1,217 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/postgres"
+)
+
+const schema = `
+	CREATE TABLE IF NOT EXISTS "onchain_trades"(
+	    id              SERIAL PRIMARY KEY,
+	    block_number    BIGINT NOT NULL,
+	    block_hash      TEXT NOT NULL,
+	    tx_hash         TEXT NOT NULL,
+	    log_index       INT NOT NULL,
+	    timestamp       BIGINT NOT NULL,
+	    origin          TEXT NOT NULL,
+	    src_token       TEXT NOT NULL,
+	    src_amount      NUMERIC NOT NULL,
+	    dest_token      TEXT NOT NULL,
+	    dest_amount     NUMERIC NOT NULL,
+	    dest_address    TEXT NOT NULL,
+	    UNIQUE (tx_hash, log_index)
+	);
+	CREATE INDEX IF NOT EXISTS "onchain_trades_timestamp_idx" ON "onchain_trades"(timestamp);
+	CREATE INDEX IF NOT EXISTS "onchain_trades_block_number_idx" ON "onchain_trades"(block_number);
+	CREATE TABLE IF NOT EXISTS "onchain_indexed_blocks"(
+	    block_number    BIGINT PRIMARY KEY,
+	    block_hash      TEXT NOT NULL
+	);
+`
+
+// postgresStorage implements indexer storage in postgres
+type postgresStorage struct {
+	db    *sqlx.DB
+	stmts preparedStmt
+}
+
+type preparedStmt struct {
+	storeTradeStmt  *sqlx.NamedStmt
+	getTradesStmt   *sqlx.Stmt
+	getBlocksStmt   *sqlx.Stmt
+	storeBlockStmt  *sqlx.Stmt
+	pruneBlocksStmt *sqlx.Stmt
+}
+
+// NewPostgresStorage creates a new indexer storage with db engine = postgres
+func NewPostgresStorage(db *sqlx.DB) (Storage, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to intialize database schema err=%s", err.Error())
+	}
+	storage := &postgresStorage{
+		db: db,
+	}
+	err := storage.prepareStmts()
+	return storage, err
+}
+
+func (s *postgresStorage) prepareStmts() error {
+	var err error
+	s.stmts.storeTradeStmt, err = s.db.PrepareNamed(`INSERT INTO "onchain_trades"
+		(block_number, block_hash, tx_hash, log_index, timestamp, origin, src_token, src_amount, dest_token, dest_amount, dest_address)
+		VALUES(:block_number, :block_hash, :tx_hash, :log_index, :timestamp, :origin, :src_token, :src_amount, :dest_token, :dest_amount, :dest_address)
+		ON CONFLICT (tx_hash, log_index) DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	s.stmts.getTradesStmt, err = s.db.Preparex(`SELECT block_number, block_hash, tx_hash, log_index, timestamp, origin,
+		src_token, src_amount::TEXT, dest_token, dest_amount::TEXT, dest_address
+		FROM "onchain_trades"
+		WHERE timestamp >= $1 AND timestamp <= $2
+		ORDER BY block_number, log_index`)
+	if err != nil {
+		return err
+	}
+	s.stmts.getBlocksStmt, err = s.db.Preparex(`SELECT block_number, block_hash FROM "onchain_indexed_blocks"
+		ORDER BY block_number DESC LIMIT $1`)
+	if err != nil {
+		return err
+	}
+	s.stmts.storeBlockStmt, err = s.db.Preparex(`INSERT INTO "onchain_indexed_blocks" (block_number, block_hash)
+		VALUES($1, $2) ON CONFLICT (block_number) DO UPDATE SET block_hash = EXCLUDED.block_hash`)
+	if err != nil {
+		return err
+	}
+	s.stmts.pruneBlocksStmt, err = s.db.Preparex(`DELETE FROM "onchain_indexed_blocks" WHERE block_number NOT IN
+		(SELECT block_number FROM "onchain_indexed_blocks" ORDER BY block_number DESC LIMIT $1)`)
+	return err
+}
+
+type onChainTradeDB struct {
+	BlockNumber uint64 `db:"block_number"`
+	BlockHash   string `db:"block_hash"`
+	TxHash      string `db:"tx_hash"`
+	LogIndex    uint   `db:"log_index"`
+	Timestamp   uint64 `db:"timestamp"`
+	Origin      string `db:"origin"`
+	SrcToken    string `db:"src_token"`
+	SrcAmount   string `db:"src_amount"`
+	DestToken   string `db:"dest_token"`
+	DestAmount  string `db:"dest_amount"`
+	DestAddress string `db:"dest_address"`
+}
+
+func (t onChainTradeDB) toCommon() (common.OnChainTrade, error) {
+	srcAmount, ok := new(big.Int).SetString(t.SrcAmount, 10)
+	if !ok {
+		return common.OnChainTrade{}, fmt.Errorf("invalid src amount %s of trade %s", t.SrcAmount, t.TxHash)
+	}
+	destAmount, ok := new(big.Int).SetString(t.DestAmount, 10)
+	if !ok {
+		return common.OnChainTrade{}, fmt.Errorf("invalid dest amount %s of trade %s", t.DestAmount, t.TxHash)
+	}
+	return common.OnChainTrade{
+		BlockNumber: t.BlockNumber,
+		BlockHash:   ethereum.HexToHash(t.BlockHash),
+		TxHash:      ethereum.HexToHash(t.TxHash),
+		LogIndex:    t.LogIndex,
+		Timestamp:   t.Timestamp,
+		Origin:      ethereum.HexToAddress(t.Origin),
+		SrcToken:    ethereum.HexToAddress(t.SrcToken),
+		SrcAmount:   srcAmount,
+		DestToken:   ethereum.HexToAddress(t.DestToken),
+		DestAmount:  destAmount,
+		DestAddress: ethereum.HexToAddress(t.DestAddress),
+	}, nil
+}
+
+// IndexedBlocks implements Storage.
+func (s *postgresStorage) IndexedBlocks(limit int) ([]IndexedBlock, error) {
+	var records []struct {
+		Number uint64 `db:"block_number"`
+		Hash   string `db:"block_hash"`
+	}
+	if err := s.stmts.getBlocksStmt.Select(&records, limit); err != nil {
+		return nil, err
+	}
+	blocks := make([]IndexedBlock, 0, len(records))
+	for _, record := range records {
+		blocks = append(blocks, IndexedBlock{Number: record.Number, Hash: ethereum.HexToHash(record.Hash)})
+	}
+	return blocks, nil
+}
+
+// StoreTrades implements Storage.
+func (s *postgresStorage) StoreTrades(trades []common.OnChainTrade, block IndexedBlock, keep int) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+
+	for _, trade := range trades {
+		_, err = tx.NamedStmt(s.stmts.storeTradeStmt).Exec(onChainTradeDB{
+			BlockNumber: trade.BlockNumber,
+			BlockHash:   trade.BlockHash.Hex(),
+			TxHash:      trade.TxHash.Hex(),
+			LogIndex:    trade.LogIndex,
+			Timestamp:   trade.Timestamp,
+			Origin:      trade.Origin.Hex(),
+			SrcToken:    trade.SrcToken.Hex(),
+			SrcAmount:   trade.SrcAmount.String(),
+			DestToken:   trade.DestToken.Hex(),
+			DestAmount:  trade.DestAmount.String(),
+			DestAddress: trade.DestAddress.Hex(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if _, err = tx.Stmtx(s.stmts.storeBlockStmt).Exec(block.Number, block.Hash.Hex()); err != nil {
+		return err
+	}
+	if _, err = tx.Stmtx(s.stmts.pruneBlocksStmt).Exec(keep); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Rewind implements Storage.
+func (s *postgresStorage) Rewind(block uint64) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+
+	if _, err = tx.Exec(`DELETE FROM "onchain_trades" WHERE block_number > $1`, block); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`DELETE FROM "onchain_indexed_blocks" WHERE block_number > $1`, block); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetTrades implements Storage.
+func (s *postgresStorage) GetTrades(fromTime, toTime uint64) ([]common.OnChainTrade, error) {
+	var records []onChainTradeDB
+	if err := s.stmts.getTradesStmt.Select(&records, fromTime, toTime); err != nil {
+		return nil, err
+	}
+	trades := make([]common.OnChainTrade, 0, len(records))
+	for _, record := range records {
+		trade, err := record.toCommon()
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, trade)
+	}
+	return trades, nil
+}
@@ -0,0 +1,68 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+)
+
+func TestPostgresStorage(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+	storage, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	srcAmount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	trades := []common.OnChainTrade{
+		{
+			BlockNumber: 10,
+			BlockHash:   ethereum.HexToHash("0x0a"),
+			TxHash:      ethereum.HexToHash("0x01"),
+			LogIndex:    2,
+			Timestamp:   1000,
+			Origin:      ethereum.HexToAddress("0x11"),
+			SrcToken:    ethereum.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"),
+			SrcAmount:   srcAmount,
+			DestToken:   ethereum.HexToAddress("0x12"),
+			DestAmount:  big.NewInt(12345),
+			DestAddress: ethereum.HexToAddress("0x13"),
+		},
+	}
+	require.NoError(t, storage.StoreTrades(trades, IndexedBlock{Number: 10, Hash: ethereum.HexToHash("0x0a")}, 2))
+	second := trades[0]
+	second.BlockNumber, second.TxHash, second.Timestamp = 20, ethereum.HexToHash("0x02"), 2000
+	require.NoError(t, storage.StoreTrades([]common.OnChainTrade{second}, IndexedBlock{Number: 20, Hash: ethereum.HexToHash("0x14")}, 2))
+	// storing the same trade again is ignored
+	require.NoError(t, storage.StoreTrades([]common.OnChainTrade{second}, IndexedBlock{Number: 30, Hash: ethereum.HexToHash("0x1e")}, 2))
+
+	stored, err := storage.GetTrades(0, 1500)
+	require.NoError(t, err)
+	assert.Equal(t, trades, stored)
+	stored, err = storage.GetTrades(0, 3000)
+	require.NoError(t, err)
+	assert.Len(t, stored, 2)
+
+	// only the latest blocks are kept
+	blocks, err := storage.IndexedBlocks(10)
+	require.NoError(t, err)
+	assert.Equal(t, []IndexedBlock{
+		{Number: 30, Hash: ethereum.HexToHash("0x1e")},
+		{Number: 20, Hash: ethereum.HexToHash("0x14")},
+	}, blocks)
+
+	require.NoError(t, storage.Rewind(15))
+	stored, err = storage.GetTrades(0, 3000)
+	require.NoError(t, err)
+	assert.Equal(t, trades, stored)
+	blocks, err = storage.IndexedBlocks(10)
+	require.NoError(t, err)
+	assert.Empty(t, blocks)
+}
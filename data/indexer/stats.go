@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const ethDecimals = 18
+
+// assetStats accumulates trades of an asset, rated volumes only include trades a set rate is
+// found for.
+type assetStats struct {
+	common.OnChainAssetStats
+	ratedBuyETH      float64
+	ratedBuyTokens   float64
+	ratedBuySetValue float64
+
+	ratedSellETH      float64
+	ratedSellTokens   float64
+	ratedSellSetValue float64
+}
+
+// setRate returns the rate set to the pricing contract for the asset at the block, false is
+// returned if no rate is stored for it. Rates are sorted by block number.
+func setRate(rates []common.AllRateEntry, assetID, block uint64, buy bool) (float64, bool) {
+	i := sort.Search(len(rates), func(i int) bool {
+		return rates[i].BlockNumber > block
+	})
+	if i == 0 {
+		return 0, false
+	}
+	entry, ok := rates[i-1].Data[assetID]
+	if !ok {
+		return 0, false
+	}
+	base, compact := entry.BaseSell, entry.CompactSell
+	if buy {
+		base, compact = entry.BaseBuy, entry.CompactBuy
+	}
+	if base == nil || base.Sign() == 0 {
+		return 0, false
+	}
+	return common.BigToFloat(base, ethDecimals) * (1 + float64(compact)/1000), true
+}
+
+// Stats summarises trades with the reserve by asset. Fill rates of trades are compared with the
+// latest rates stored at or before their blocks.
+func Stats(fromTime, toTime, indexedBlock uint64, trades []common.OnChainTrade,
+	assets []v3.Asset, rates []common.AllRateEntry) common.OnChainTradeStats {
+	byAddress := make(map[ethereum.Address]v3.Asset)
+	for _, asset := range assets {
+		byAddress[asset.Address] = asset
+		for _, address := range asset.OldAddresses {
+			byAddress[address] = asset
+		}
+	}
+	rates = append([]common.AllRateEntry(nil), rates...)
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].BlockNumber < rates[j].BlockNumber
+	})
+
+	result := common.OnChainTradeStats{
+		FromTime:     fromTime,
+		ToTime:       toTime,
+		IndexedBlock: indexedBlock,
+	}
+	stats := make(map[uint64]*assetStats)
+	for _, trade := range trades {
+		buy := common.IsEthereumAddress(trade.SrcToken)
+		token, ethAmount, tokenAmount := trade.DestToken, trade.SrcAmount, trade.DestAmount
+		if !buy {
+			token, ethAmount, tokenAmount = trade.SrcToken, trade.DestAmount, trade.SrcAmount
+		}
+		asset, ok := byAddress[token]
+		if !ok || (!buy && !common.IsEthereumAddress(trade.DestToken)) {
+			result.UnknownTrades++
+			continue
+		}
+		s, ok := stats[asset.ID]
+		if !ok {
+			s = &assetStats{OnChainAssetStats: common.OnChainAssetStats{AssetID: asset.ID, Asset: asset.Symbol}}
+			stats[asset.ID] = s
+		}
+		eth := common.BigToFloat(ethAmount, ethDecimals)
+		tokens := common.BigToFloat(tokenAmount, int64(asset.Decimals))
+		s.Trades++
+		rate, rated := setRate(rates, asset.ID, trade.BlockNumber, buy)
+		if !rated {
+			s.Unrated++
+		}
+		if buy {
+			s.BuyVolume += tokens
+			s.BuyETHVolume += eth
+			s.NetFlow -= tokens
+			result.ETHNetFlow += eth
+			if rated {
+				s.ratedBuyETH += eth
+				s.ratedBuyTokens += tokens
+				s.ratedBuySetValue += eth * rate
+			}
+			continue
+		}
+		s.SellVolume += tokens
+		s.SellETHVolume += eth
+		s.NetFlow += tokens
+		result.ETHNetFlow -= eth
+		if rated {
+			s.ratedSellETH += eth
+			s.ratedSellTokens += tokens
+			s.ratedSellSetValue += tokens * rate
+		}
+	}
+
+	result.Assets = make([]common.OnChainAssetStats, 0, len(stats))
+	for _, s := range stats {
+		if s.BuyETHVolume > 0 {
+			s.AvgBuyRate = s.BuyVolume / s.BuyETHVolume
+		}
+		if s.ratedBuyETH > 0 {
+			s.AvgSetBuyRate = s.ratedBuySetValue / s.ratedBuyETH
+			s.BuyRateDeviation = s.ratedBuyTokens/s.ratedBuySetValue - 1
+		}
+		if s.SellVolume > 0 {
+			s.AvgSellRate = s.SellETHVolume / s.SellVolume
+		}
+		if s.ratedSellTokens > 0 {
+			s.AvgSetSellRate = s.ratedSellSetValue / s.ratedSellTokens
+			s.SellRateDeviation = s.ratedSellETH/s.ratedSellSetValue - 1
+		}
+		result.Assets = append(result.Assets, s.OnChainAssetStats)
+	}
+	sort.Slice(result.Assets, func(i, j int) bool {
+		return result.Assets[i].Asset < result.Assets[j].Asset
+	})
+	return result
+}
@@ -0,0 +1,70 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestStats(t *testing.T) {
+	var (
+		eth     = ethereum.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+		knc     = ethereum.HexToAddress("0x01")
+		oldKNC  = ethereum.HexToAddress("0x02")
+		unknown = ethereum.HexToAddress("0x03")
+	)
+	// amount returns the value in units of the decimals
+	amount := func(value float64, decimals int64) *big.Int {
+		f := new(big.Float).Mul(big.NewFloat(value), new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)))
+		result, _ := f.Int(nil)
+		return result
+	}
+	trade := func(block uint64, src ethereum.Address, srcAmount *big.Int, dest ethereum.Address, destAmount *big.Int) common.OnChainTrade {
+		return common.OnChainTrade{BlockNumber: block, SrcToken: src, SrcAmount: srcAmount, DestToken: dest, DestAmount: destAmount}
+	}
+	trades := []common.OnChainTrade{
+		// users buy 200 KNC for 1 ETH while 202 KNC per ETH is set
+		trade(10, eth, amount(1, 18), knc, amount(200, 6)),
+		// users sell 100 KNC for 0.49 ETH while 0.005 ETH per KNC is set
+		trade(20, oldKNC, amount(100, 6), eth, amount(0.49, 18)),
+		// no rate before the block
+		trade(5, eth, amount(1, 18), knc, amount(210, 6)),
+		trade(20, eth, amount(1, 18), unknown, amount(1, 18)),
+	}
+	assets := []v3.Asset{{ID: 1, Symbol: "KNC", Address: knc, OldAddresses: []ethereum.Address{oldKNC}, Decimals: 6}}
+	rates := []common.AllRateEntry{
+		{BlockNumber: 15, Data: map[uint64]common.RateEntry{
+			1: common.NewRateEntry(amount(200, 18), 0, amount(0.005, 18), 0, 15),
+		}},
+		{BlockNumber: 8, Data: map[uint64]common.RateEntry{
+			1: common.NewRateEntry(amount(200, 18), 10, amount(0.006, 18), 0, 8),
+		}},
+	}
+
+	stats := Stats(1, 2, 30, trades, assets, rates)
+	assert.Equal(t, uint64(30), stats.IndexedBlock)
+	assert.Equal(t, 1, stats.UnknownTrades)
+	assert.InDelta(t, 2-0.49, stats.ETHNetFlow, 1e-9)
+	require.Len(t, stats.Assets, 1)
+	s := stats.Assets[0]
+	assert.Equal(t, "KNC", s.Asset)
+	assert.Equal(t, 3, s.Trades)
+	assert.Equal(t, 1, s.Unrated)
+	assert.InDelta(t, 410, s.BuyVolume, 1e-9)
+	assert.InDelta(t, 2, s.BuyETHVolume, 1e-9)
+	assert.InDelta(t, 100, s.SellVolume, 1e-9)
+	assert.InDelta(t, 0.49, s.SellETHVolume, 1e-9)
+	assert.InDelta(t, -310, s.NetFlow, 1e-9)
+	assert.InDelta(t, 205, s.AvgBuyRate, 1e-9)
+	assert.InDelta(t, 202, s.AvgSetBuyRate, 1e-9)
+	assert.InDelta(t, 200.0/202-1, s.BuyRateDeviation, 1e-9)
+	assert.InDelta(t, 0.0049, s.AvgSellRate, 1e-9)
+	assert.InDelta(t, 0.005, s.AvgSetSellRate, 1e-9)
+	assert.InDelta(t, -0.02, s.SellRateDeviation, 1e-9)
+}
@@ -1,10 +1,12 @@
 package data
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -12,7 +14,9 @@ import (
 
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/archive"
+	"github.com/KyberNetwork/reserve-data/data/accounting"
 	"github.com/KyberNetwork/reserve-data/data/datapruner"
+	"github.com/KyberNetwork/reserve-data/data/indexer"
 	v3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 )
@@ -25,9 +29,22 @@ type ReserveData struct {
 	globalStorage     GlobalStorage
 	exchanges         []common.Exchange
 	settingStorage    storage.Interface
-	l                 *zap.SugaredLogger
+	onChainTrades     indexer.Storage
+	// controllerJobs tracks the storage controller job so shutdown can wait for it.
+	controllerJobs *sync.WaitGroup
+	l              *zap.SugaredLogger
 }
 
+const (
+	// maxOnChainStatsRange is the max time range of on-chain trade stats.
+	maxOnChainStatsRange = 7 * 24 * time.Hour
+	// onChainRateLookback is how long before the time range rates are loaded so set rates of the
+	// first trades are found.
+	onChainRateLookback = 10 * time.Minute
+	// maxInventorySnapshotsRange is the max time range of inventory snapshots returned at once.
+	maxInventorySnapshotsRange = 31 * 24 * time.Hour
+)
+
 // CurrentGoldInfoVersion get current godl info version
 func (rd ReserveData) CurrentGoldInfoVersion(timepoint uint64) (common.Version, error) {
 	return rd.globalStorage.CurrentGoldInfoVersion(timepoint)
@@ -139,6 +156,8 @@ func (rd ReserveData) GetAuthData(timepoint uint64) (common.AuthDataResponseV3,
 	pendingSetRate := []common.ActivityRecord{}
 	pendingWithdraw := []common.ActivityRecord{}
 	pendingDeposit := []common.ActivityRecord{}
+	pendingReserveWithdraw := []common.ActivityRecord{}
+	pendingTransfer := []common.ActivityRecord{}
 	for _, activity := range data.PendingActivities {
 		switch activity.Action {
 		case common.ActionSetRate:
@@ -147,11 +166,17 @@ func (rd ReserveData) GetAuthData(timepoint uint64) (common.AuthDataResponseV3,
 			pendingDeposit = append(pendingDeposit, activity)
 		case common.ActionWithdraw:
 			pendingWithdraw = append(pendingWithdraw, activity)
+		case common.ActionReserveWithdraw:
+			pendingReserveWithdraw = append(pendingReserveWithdraw, activity)
+		case common.ActionTransfer:
+			pendingTransfer = append(pendingTransfer, activity)
 		}
 	}
 	result.PendingActivities.SetRates = pendingSetRate
 	result.PendingActivities.Withdraw = pendingWithdraw
 	result.PendingActivities.Deposit = pendingDeposit
+	result.PendingActivities.ReserveWithdraw = pendingReserveWithdraw
+	result.PendingActivities.Transfer = pendingTransfer
 	// map of token
 	assets := make(map[common.AssetID]v3.Asset)
 	exchanges := make(map[string]v3.Exchange)
@@ -350,18 +375,47 @@ func (rd ReserveData) GetPendingActivities() ([]common.ActivityRecord, error) {
 	return rd.storage.GetPendingActivities()
 }
 
-//Run run fetcher
-func (rd ReserveData) Run() error {
-	return rd.fetcher.Run()
+// SearchActivities return a page of activities matching the query
+func (rd ReserveData) SearchActivities(query common.ActivityQuery) (common.ActivityPage, error) {
+	if err := query.Validate(); err != nil {
+		return common.ActivityPage{}, err
+	}
+	return rd.storage.SearchActivities(query)
+}
+
+// GetActivityTimeline return status transitions of an activity
+func (rd ReserveData) GetActivityTimeline(id common.ActivityID) ([]common.ActivityTransition, error) {
+	return rd.storage.GetActivityTimeline(id)
 }
 
-//Stop stop the fetcher
+//Run run fetcher until ctx is done
+func (rd ReserveData) Run(ctx context.Context) error {
+	return rd.fetcher.Run(ctx)
+}
+
+//Stop stop the fetcher, waiting for the jobs in progress to finish
 func (rd ReserveData) Stop() error {
 	return rd.fetcher.Stop()
 }
 
-//ControlAuthDataSize pack old data to file, push to S3 and prune outdated data
-func (rd ReserveData) ControlAuthDataSize() error {
+// GetFetcherJobs returns schedules and run statistics of fetcher jobs.
+func (rd ReserveData) GetFetcherJobs() ([]common.FetcherJobStatus, error) {
+	return rd.fetcher.Jobs()
+}
+
+// UpdateFetcherJob changes the schedule of a fetcher job while the fetcher is running.
+func (rd ReserveData) UpdateFetcherJob(job, exchange string, config common.FetcherJobConfig) error {
+	return rd.fetcher.SetJobConfig(job, exchange, config)
+}
+
+// WaitStorageController waits for the storage controller to return after the context it was run
+// with is done, an export or prune in progress is finished first.
+func (rd ReserveData) WaitStorageController() {
+	rd.controllerJobs.Wait()
+}
+
+//ControlAuthDataSize pack old data to file, push to S3 and prune outdated data until ctx is done
+func (rd ReserveData) ControlAuthDataSize(ctx context.Context) error {
 	tmpDir, err := ioutil.TempDir("", "ExpiredAuthData")
 	if err != nil {
 		return err
@@ -375,7 +429,13 @@ func (rd ReserveData) ControlAuthDataSize() error {
 
 	for {
 		rd.l.Info("DataPruner: waiting for signal from runner AuthData controller channel")
-		t := <-rd.storageController.Runner.GetAuthBucketTicker()
+		var t time.Time
+		select {
+		case <-ctx.Done():
+			rd.l.Info("DataPruner: AuthData controller is stopped")
+			return nil
+		case t = <-rd.storageController.Runner.GetAuthBucketTicker():
+		}
 		timepoint := common.TimeToMillis(t)
 		rd.l.Infow("DataPruner: got signal in AuthData controller channel", "timestamp", common.TimeToMillis(t))
 		fileName := filepath.Join(tmpDir, fmt.Sprintf("ExpiredAuthData_at_%s", time.Unix(int64(timepoint/1000), 0).UTC()))
@@ -440,19 +500,185 @@ func (rd ReserveData) GetTradeHistory(fromTime, toTime uint64) (common.AllTradeH
 	return data, nil
 }
 
-// RunStorageController run storage controller
-func (rd ReserveData) RunStorageController() error {
+// tradingPairs returns trading pairs of all exchanges.
+func (rd ReserveData) tradingPairs() ([]v3.TradingPairSymbols, error) {
+	exchanges, err := rd.settingStorage.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	var pairs []v3.TradingPairSymbols
+	for _, exchange := range exchanges {
+		exchangePairs, err := rd.settingStorage.GetTradingPairs(exchange.ID)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, exchangePairs...)
+	}
+	return pairs, nil
+}
+
+// GetPnL return realised PnL, volume and fees of trades made in [fromTime, toTime]
+func (rd ReserveData) GetPnL(fromTime, toTime uint64, groupBy string) (common.PnLReport, error) {
+	switch groupBy {
+	case "":
+		groupBy = common.PnLGroupByAsset
+	case common.PnLGroupByAsset, common.PnLGroupByExchange:
+	default:
+		return common.PnLReport{}, fmt.Errorf("invalid group_by %q", groupBy)
+	}
+	if fromTime > toTime {
+		return common.PnLReport{}, fmt.Errorf("from must not be after to")
+	}
+	history, err := rd.GetTradeHistory(fromTime, toTime)
+	if err != nil {
+		return common.PnLReport{}, err
+	}
+	pairs, err := rd.tradingPairs()
+	if err != nil {
+		return common.PnLReport{}, err
+	}
+	pairByID := make(map[uint64]v3.TradingPairSymbols, len(pairs))
+	for _, pair := range pairs {
+		pairByID[pair.ID] = pair
+	}
+
+	var trades []accounting.Trade
+	for exchangeID, exchangeHistory := range history.Data {
+		for pairID, pairHistory := range exchangeHistory {
+			pair, ok := pairByID[pairID]
+			if !ok {
+				rd.l.Warnw("trading pair of trade history not found", "exchange", exchangeID.String(), "pair", pairID)
+				continue
+			}
+			for _, trade := range pairHistory {
+				trades = append(trades, accounting.Trade{
+					Exchange:     exchangeID.String(),
+					Base:         pair.BaseSymbol,
+					Quote:        pair.QuoteSymbol,
+					TradeHistory: trade,
+				})
+			}
+		}
+	}
+	return accounting.Report(fromTime, toTime, groupBy, trades, accounting.NewMarketValuer(rd, pairs)), nil
+}
+
+// GetInventory return inventory of assets at the latest auth data before timepoint valued at
+// timepoint and compared with their targets
+func (rd ReserveData) GetInventory(timepoint uint64) (common.InventoryReport, error) {
+	authData, err := rd.GetAuthData(timepoint)
+	if err != nil {
+		return common.InventoryReport{}, err
+	}
+	assets, err := rd.settingStorage.GetAssets()
+	if err != nil {
+		return common.InventoryReport{}, err
+	}
+	pairs, err := rd.tradingPairs()
+	if err != nil {
+		return common.InventoryReport{}, err
+	}
+	return accounting.Inventory(timepoint, authData, assets, accounting.NewMarketValuer(rd, pairs)), nil
+}
+
+// GetInventorySnapshots return inventory reports stored in [fromTime, toTime]
+func (rd ReserveData) GetInventorySnapshots(fromTime, toTime uint64) ([]common.InventoryReport, error) {
+	if fromTime > toTime {
+		return nil, fmt.Errorf("from must not be after to")
+	}
+	if toTime-fromTime > uint64(maxInventorySnapshotsRange/time.Millisecond) {
+		return nil, fmt.Errorf("time range is too big, it should be <= %s", maxInventorySnapshotsRange)
+	}
+	return rd.storage.GetInventorySnapshots(fromTime, toTime)
+}
+
+// RunInventorySnapshots stores the current inventory report periodically until ctx is done.
+func (rd ReserveData) RunInventorySnapshots(ctx context.Context, config common.InventoryConfig) {
+	if config.SnapshotInterval == 0 {
+		rd.l.Infow("inventory snapshots are not configured")
+		return
+	}
+	ticker := time.NewTicker(time.Duration(config.SnapshotInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		report, err := rd.GetInventory(common.NowInMillis())
+		if err != nil {
+			rd.l.Warnw("failed to get inventory", "err", err)
+			continue
+		}
+		if err := rd.storage.StoreInventorySnapshot(report); err != nil {
+			rd.l.Warnw("failed to store inventory snapshot", "err", err)
+		}
+	}
+}
+
+// RunStorageController run storage controller until ctx is done
+func (rd ReserveData) RunStorageController(ctx context.Context) error {
 	if err := rd.storageController.Runner.Start(); err != nil {
 		rd.l.Fatalw("Storage controller runner error", "err", err)
 	}
+	rd.controllerJobs.Add(1)
 	go func() {
-		if err := rd.ControlAuthDataSize(); err != nil {
+		defer rd.controllerJobs.Done()
+		if err := rd.ControlAuthDataSize(ctx); err != nil {
 			rd.l.Errorw("Control auth data size failed", "err", err)
 		}
+		if err := rd.storageController.Runner.Stop(); err != nil {
+			rd.l.Warnw("Stopping storage controller runner failed", "err", err)
+		}
 	}()
 	return nil
 }
 
+// SetOnChainTradeStorage sets the storage of trades indexed from the reserve contract.
+func (rd *ReserveData) SetOnChainTradeStorage(onChainTrades indexer.Storage) {
+	rd.onChainTrades = onChainTrades
+}
+
+// GetOnChainTradeStats returns volume, fill rates compared with set rates and inventory flow of
+// trades with the reserve contract made in [fromTime, toTime]
+func (rd ReserveData) GetOnChainTradeStats(fromTime, toTime uint64) (common.OnChainTradeStats, error) {
+	if rd.onChainTrades == nil {
+		return common.OnChainTradeStats{}, errors.New("on-chain trade indexer is not configured")
+	}
+	if fromTime > toTime {
+		return common.OnChainTradeStats{}, fmt.Errorf("from must not be after to")
+	}
+	if toTime-fromTime > uint64(maxOnChainStatsRange/time.Millisecond) {
+		return common.OnChainTradeStats{}, fmt.Errorf("time range is too big, it should be <= %s", maxOnChainStatsRange)
+	}
+	trades, err := rd.onChainTrades.GetTrades(fromTime, toTime)
+	if err != nil {
+		return common.OnChainTradeStats{}, err
+	}
+	blocks, err := rd.onChainTrades.IndexedBlocks(1)
+	if err != nil {
+		return common.OnChainTradeStats{}, err
+	}
+	var indexedBlock uint64
+	if len(blocks) > 0 {
+		indexedBlock = blocks[0].Number
+	}
+	assets, err := rd.settingStorage.GetAssets()
+	if err != nil {
+		return common.OnChainTradeStats{}, err
+	}
+	rateFrom := uint64(0)
+	if lookback := uint64(onChainRateLookback / time.Millisecond); fromTime > lookback {
+		rateFrom = fromTime - lookback
+	}
+	rates, err := rd.storage.GetRates(rateFrom, toTime)
+	if err != nil {
+		return common.OnChainTradeStats{}, err
+	}
+	return indexer.Stats(fromTime, toTime, indexedBlock, trades, assets, rates), nil
+}
+
 //NewReserveData initiate a new reserve instance
 func NewReserveData(storage Storage,
 	fetcher Fetcher, storageControllerRunner datapruner.StorageControllerRunner,
@@ -470,6 +696,7 @@ func NewReserveData(storage Storage,
 		globalStorage:     globalStorage,
 		exchanges:         exchanges,
 		settingStorage:    settingStorage,
+		controllerJobs:    &sync.WaitGroup{},
 		l:                 zap.S(),
 	}
 }
@@ -23,4 +23,10 @@ type Storage interface {
 
 	GetAllRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
 	GetPendingActivities() ([]common.ActivityRecord, error)
+	GetActivityTimeline(id common.ActivityID) ([]common.ActivityTransition, error)
+	SearchActivities(query common.ActivityQuery) (common.ActivityPage, error)
+
+	StoreInventorySnapshot(report common.InventoryReport) error
+	// GetInventorySnapshots returns inventory reports stored in [fromTime, toTime] from the oldest one.
+	GetInventorySnapshots(fromTime, toTime uint64) ([]common.InventoryReport, error)
 }
@@ -24,6 +24,7 @@ const (
 	activityBucket                  string = "activities"
 	authDataBucket                  string = "auth_data"
 	pendingActivityBucket           string = "pending_activities"
+	activityTransitionBucket        string = "activity_transitions"
 	enableRebalance                 string = "enable_rebalance"
 	setrateControl                  string = "setrate_control"
 	maxNumberVersion                int    = 1000
@@ -35,6 +36,7 @@ const (
 	btcBucket                       string = "btc_feeds"
 	usdBucket                       string = "usd_feeds"
 	disabledFeedsBucket             string = "disabled_feeds"
+	inventorySnapshotBucket         string = "inventory_snapshots"
 
 	//btcFetcherConfiguration stores configuration for btc fetcher
 	fetcherConfigurationBucket = "btc_fetcher_configuration"
@@ -67,12 +69,14 @@ func NewBoltStorage(path string) (*BoltStorage, error) {
 			rateBucket,
 			activityBucket,
 			pendingActivityBucket,
+			activityTransitionBucket,
 			authDataBucket,
 			enableRebalance,
 			setrateControl,
 			pendingStatbleTokenParamsBucket,
 			stableTokenParamsBucket,
 			fetcherConfigurationBucket,
+			inventorySnapshotBucket,
 		}
 
 		for _, bucket := range buckets {
@@ -600,6 +604,10 @@ func (bs *BoltStorage) Record(
 		if err != nil {
 			return err
 		}
+		err = putActivityTransition(tx, id, common.NewActivityCreation(record, common.ActivitySourceCore, timepoint))
+		if err != nil {
+			return err
+		}
 		if record.IsPending() {
 			pb := tx.Bucket([]byte(pendingActivityBucket))
 			// all other pending set rates should be staled now
@@ -683,6 +691,66 @@ func (bs *BoltStorage) GetAllRecords(fromTime, toTime uint64) ([]common.Activity
 	return result, err
 }
 
+// SearchActivities returns a page of activities matching the query, query must be validated.
+// Activities are filtered while scanning the activity bucket in order of their ids.
+func (bs *BoltStorage) SearchActivities(query common.ActivityQuery) (common.ActivityPage, error) {
+	page := common.ActivityPage{Activities: []common.ActivityRecord{}}
+	cursor, err := query.CursorID()
+	if err != nil {
+		return page, err
+	}
+	asc := query.Order == common.SortAsc
+	// afterCursor returns true if id comes after cursor in the sort order
+	afterCursor := func(id common.ActivityID) bool {
+		if cursor == nil {
+			return true
+		}
+		if id.Timepoint != cursor.Timepoint {
+			return (id.Timepoint > cursor.Timepoint) == asc
+		}
+		return id.EID != cursor.EID && (id.EID > cursor.EID) == asc
+	}
+	err = bs.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(activityBucket)).Cursor()
+		first, next := c.Last, c.Prev
+		if asc {
+			first, next = c.First, c.Next
+		}
+		if cursor != nil {
+			// the page starts at the key of cursor instead of scanning from the first activity
+			cursorKey := cursor.ToBytes()
+			first = func() ([]byte, []byte) {
+				k, v := c.Seek(cursorKey[:])
+				switch {
+				case asc:
+					return k, v
+				case k == nil:
+					return c.Last()
+				case !bytes.Equal(k, cursorKey[:]):
+					return c.Prev()
+				}
+				return k, v
+			}
+		}
+		for k, v := first(); k != nil; k, v = next() {
+			record := common.ActivityRecord{}
+			if uErr := json.Unmarshal(v, &record); uErr != nil {
+				return uErr
+			}
+			if !afterCursor(record.ID) || !query.Match(record) {
+				continue
+			}
+			if len(page.Activities) == query.Limit {
+				page.NextCursor = page.Activities[query.Limit-1].ID.String()
+				return nil
+			}
+			page.Activities = append(page.Activities, record)
+		}
+		return nil
+	})
+	return page, err
+}
+
 func getFirstAndCountPendingSetrate(pendings []common.ActivityRecord, minedNonce uint64) (*common.ActivityRecord, uint64, error) {
 	var minNonce uint64 = math.MaxUint64
 	var minPrice uint64 = math.MaxUint64
@@ -772,36 +840,122 @@ func (bs *BoltStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
 	return result, err
 }
 
-//UpdateActivity update activity info
-func (bs *BoltStorage) UpdateActivity(id common.ActivityID, activity common.ActivityRecord) error {
+//UpdateActivity validates and records the status transition of activity then updates it
+func (bs *BoltStorage) UpdateActivity(id common.ActivityID, activity common.ActivityRecord, update common.ActivityUpdate) error {
 	err := bs.db.Update(func(tx *bolt.Tx) error {
-		pb := tx.Bucket([]byte(pendingActivityBucket))
+		b := tx.Bucket([]byte(activityBucket))
 		idBytes := id.ToBytes()
-		dataJSON, uErr := json.Marshal(activity)
-		if uErr != nil {
-			return uErr
-		}
-		// only update when it exists in pending activity bucket because
-		// It might be deleted if it is replaced by another activity
-		found := pb.Get(idBytes[:])
-		if found != nil {
-			uErr = pb.Put(idBytes[:], dataJSON)
+		if data := b.Get(idBytes[:]); data != nil {
+			current := common.ActivityRecord{}
+			if uErr := json.Unmarshal(data, &current); uErr != nil {
+				return uErr
+			}
+			transition, uErr := current.Transition(activity, update, common.NowInMillis())
 			if uErr != nil {
 				return uErr
 			}
-			if !activity.IsPending() {
-				uErr = pb.Delete(idBytes[:])
-				if uErr != nil {
+			if transition != nil {
+				if uErr = putActivityTransition(tx, id, *transition); uErr != nil {
 					return uErr
 				}
 			}
 		}
-		b := tx.Bucket([]byte(activityBucket))
-		return b.Put(idBytes[:], dataJSON)
+		return putActivity(tx, id, activity)
 	})
 	return err
 }
 
+// putActivity stores the activity, it is updated in pending activity bucket only if it is there as
+// it might be deleted if it is replaced by another activity.
+func putActivity(tx *bolt.Tx, id common.ActivityID, activity common.ActivityRecord) error {
+	pb := tx.Bucket([]byte(pendingActivityBucket))
+	b := tx.Bucket([]byte(activityBucket))
+	idBytes := id.ToBytes()
+	dataJSON, err := json.Marshal(activity)
+	if err != nil {
+		return err
+	}
+	if found := pb.Get(idBytes[:]); found != nil {
+		if err = pb.Put(idBytes[:], dataJSON); err != nil {
+			return err
+		}
+		if !activity.IsPending() {
+			if err = pb.Delete(idBytes[:]); err != nil {
+				return err
+			}
+		}
+	}
+	return b.Put(idBytes[:], dataJSON)
+}
+
+// ModifyActivity applies modify on the activity and updates it with its status transition in one
+// transaction.
+func (bs *BoltStorage) ModifyActivity(id common.ActivityID, update common.ActivityUpdate,
+	modify func(common.ActivityRecord) (common.ActivityRecord, error)) (common.ActivityRecord, error) {
+	var next common.ActivityRecord
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		idBytes := id.ToBytes()
+		data := tx.Bucket([]byte(activityBucket)).Get(idBytes[:])
+		if data == nil {
+			return errors.New("can not find that activity")
+		}
+		current := common.ActivityRecord{}
+		if err := json.Unmarshal(data, &current); err != nil {
+			return err
+		}
+		var err error
+		if next, err = modify(current); err != nil {
+			return err
+		}
+		transition, err := current.Transition(next, update, common.NowInMillis())
+		if err != nil {
+			return err
+		}
+		if transition != nil {
+			if err = putActivityTransition(tx, id, *transition); err != nil {
+				return err
+			}
+		}
+		return putActivity(tx, id, next)
+	})
+	return next, err
+}
+
+// putActivityTransition appends transition to the timeline of activity, key of transition is
+// the activity id followed by a sequence number.
+func putActivityTransition(tx *bolt.Tx, id common.ActivityID, transition common.ActivityTransition) error {
+	b := tx.Bucket([]byte(activityTransitionBucket))
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	dataJSON, err := json.Marshal(transition)
+	if err != nil {
+		return err
+	}
+	idBytes := id.ToBytes()
+	return b.Put(append(idBytes[:], boltutil.Uint64ToBytes(seq)...), dataJSON)
+}
+
+//GetActivityTimeline returns status transitions of activity from the oldest one
+func (bs *BoltStorage) GetActivityTimeline(id common.ActivityID) ([]common.ActivityTransition, error) {
+	result := []common.ActivityTransition{}
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(activityTransitionBucket)).Cursor()
+		idBytes := id.ToBytes()
+		prefix := idBytes[:]
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			transition := common.ActivityTransition{}
+			if err := json.Unmarshal(v, &transition); err != nil {
+				return err
+			}
+			result = append(result, transition)
+		}
+		return nil
+	})
+	return result, err
+}
+
 //HasPendingDeposit check if a deposit is pending
 func (bs *BoltStorage) HasPendingDeposit(asset commonv3.Asset, exchange common.Exchange) (bool, error) {
 	var (
@@ -827,3 +981,33 @@ func (bs *BoltStorage) HasPendingDeposit(asset commonv3.Asset, exchange common.E
 	})
 	return result, err
 }
+
+// StoreInventorySnapshot stores an inventory report by its timestamp.
+func (bs *BoltStorage) StoreInventorySnapshot(report common.InventoryReport) error {
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(inventorySnapshotBucket))
+		dataJSON, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		return b.Put(boltutil.Uint64ToBytes(report.Timestamp), dataJSON)
+	})
+}
+
+// GetInventorySnapshots returns inventory reports stored in [fromTime, toTime] from the oldest one.
+func (bs *BoltStorage) GetInventorySnapshots(fromTime, toTime uint64) ([]common.InventoryReport, error) {
+	result := []common.InventoryReport{}
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(inventorySnapshotBucket)).Cursor()
+		max := boltutil.Uint64ToBytes(toTime)
+		for k, v := c.Seek(boltutil.Uint64ToBytes(fromTime)); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+			report := common.InventoryReport{}
+			if err := json.Unmarshal(v, &report); err != nil {
+				return err
+			}
+			result = append(result, report)
+		}
+		return nil
+	})
+	return result, err
+}
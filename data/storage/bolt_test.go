@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/data/testutil"
@@ -117,3 +120,108 @@ func TestGlobalStorageBoltImplementation(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestActivityTimelineBoltStorage(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "activity_timeline")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	storage, err := NewBoltStorage(filepath.Join(tmpDir, "test_bolt.db"))
+	require.NoError(t, err)
+
+	id := common.NewActivityID(1, "withdraw")
+	other := common.NewActivityID(2, "deposit")
+	require.NoError(t, storage.Record(common.ActionWithdraw, id, "binance", common.ActivityParams{},
+		common.ActivityResult{}, common.ExchangeStatusSubmitted, "", 1))
+	require.NoError(t, storage.Record(common.ActionDeposit, other, "binance", common.ActivityParams{},
+		common.ActivityResult{}, "", common.MiningStatusSubmitted, 2))
+
+	activity, err := storage.GetActivity(id)
+	require.NoError(t, err)
+	activity.ExchangeStatus = common.ExchangeStatusDone
+	update := common.ActivityUpdate{Source: common.ActivitySourceFetcher, RawExchangeStatus: common.ExchangeStatusDone}
+	require.NoError(t, storage.UpdateActivity(id, activity, update))
+
+	activity.ExchangeStatus = common.ExchangeStatusSubmitted
+	err = storage.UpdateActivity(id, activity, update)
+	assert.True(t, errors.Is(err, common.ErrInvalidTransition))
+	stored, err := storage.GetActivity(id)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, stored.ExchangeStatus)
+
+	timeline, err := storage.GetActivityTimeline(id)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, common.ActivitySourceCore, timeline[0].Source)
+	assert.Equal(t, common.ExchangeStatusSubmitted, timeline[0].ExchangeStatus)
+	assert.Equal(t, common.ActivitySourceFetcher, timeline[1].Source)
+	assert.Equal(t, common.ExchangeStatusSubmitted, timeline[1].FromExchangeStatus)
+	assert.Equal(t, common.ExchangeStatusDone, timeline[1].ExchangeStatus)
+	assert.Equal(t, common.ExchangeStatusDone, timeline[1].RawExchangeStatus)
+}
+
+func TestSearchActivitiesBoltStorage(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "search_activities")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	storage, err := NewBoltStorage(filepath.Join(tmpDir, "test_bolt.db"))
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 5; i++ {
+		action := common.ActionTrade
+		if i%2 == 0 {
+			action = common.ActionWithdraw
+		}
+		require.NoError(t, storage.Record(action, common.NewActivityID(i, "eid"), "binance",
+			common.ActivityParams{Asset: i}, common.ActivityResult{}, common.ExchangeStatusSubmitted, "", i))
+	}
+
+	var ids []uint64
+	query := common.ActivityQuery{Actions: []string{common.ActionTrade}, Limit: 2}
+	require.NoError(t, query.Validate())
+	for {
+		page, err := storage.SearchActivities(query)
+		require.NoError(t, err)
+		for _, activity := range page.Activities {
+			ids = append(ids, activity.ID.Timepoint)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+	assert.Equal(t, []uint64{5, 3, 1}, ids)
+
+	query = common.ActivityQuery{Order: common.SortAsc, Limit: 2}
+	page, err := storage.SearchActivities(query)
+	require.NoError(t, err)
+	require.Len(t, page.Activities, 2)
+	assert.Equal(t, uint64(1), page.Activities[0].ID.Timepoint)
+	assert.Equal(t, common.NewActivityID(2, "eid").String(), page.NextCursor)
+
+	query.Cursor = page.NextCursor
+	page, err = storage.SearchActivities(query)
+	require.NoError(t, err)
+	require.Len(t, page.Activities, 2)
+	assert.Equal(t, uint64(3), page.Activities[0].ID.Timepoint)
+	assert.Equal(t, uint64(4), page.Activities[1].ID.Timepoint)
+}
+
+func TestInventorySnapshotsBoltStorage(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "inventory_snapshots")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	storage, err := NewBoltStorage(filepath.Join(tmpDir, "test_bolt.db"))
+	require.NoError(t, err)
+
+	for _, timestamp := range []uint64{3000, 1000, 2000} {
+		report := common.InventoryReport{Timestamp: timestamp, USDRate: 200, Unvalued: []string{}}
+		report.Assets = []common.InventoryAsset{{AssetID: 1, Symbol: "ETH", Total: 10, Valued: true}}
+		require.NoError(t, storage.StoreInventorySnapshot(report))
+	}
+	reports, err := storage.GetInventorySnapshots(1500, 3000)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, uint64(2000), reports[0].Timestamp)
+	assert.Equal(t, uint64(3000), reports[1].Timestamp)
+	assert.Equal(t, "ETH", reports[0].Assets[0].Symbol)
+}
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 
 	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/common/postgres"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
 
@@ -47,9 +50,37 @@ CREATE TABLE IF NOT EXISTS "activity"
 );
 CREATE INDEX IF NOT EXISTS "activity_idx" ON "activity" (timepoint, eid);
 CREATE INDEX IF NOT EXISTS "pending_idx" ON "activity" (is_pending) WHERE is_pending IS TRUE;
+CREATE INDEX IF NOT EXISTS "activity_created_idx" ON "activity" (created);
+CREATE INDEX IF NOT EXISTS "activity_action_idx" ON "activity" ((data ->> 'Action'), timepoint);
+CREATE INDEX IF NOT EXISTS "activity_destination_idx" ON "activity" ((data ->> 'Destination'), timepoint);
+CREATE INDEX IF NOT EXISTS "activity_asset_idx" ON "activity" ((data -> 'Params' ->> 'asset'));
+CREATE INDEX IF NOT EXISTS "activity_tx_idx" ON "activity" ((data -> 'Result' ->> 'tx'));
+CREATE INDEX IF NOT EXISTS "activity_order_id_idx" ON "activity" ((data -> 'Result' ->> 'id'));
+
+CREATE TABLE IF NOT EXISTS "activity_transition"
+(
+	id SERIAL PRIMARY KEY,
+	timepoint BIGINT NOT NULL,
+	eid TEXT NOT NULL,
+	created TIMESTAMPTZ NOT NULL,
+	data JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS "activity_transition_idx" ON "activity_transition" (timepoint, eid);
+
+CREATE TABLE IF NOT EXISTS "inventory_snapshot"
+(
+	id SERIAL PRIMARY KEY,
+	timestamp BIGINT NOT NULL,
+	data JSONB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS "inventory_snapshot_timestamp_idx" ON "inventory_snapshot" (timestamp);
 `
 	fetchDataTable = "fetch_data" // data fetch from exchange and blockchain
 	activityTable  = "activity"
+	// transitionTable stores every change of statuses of activities
+	transitionTable = "activity_transition"
+	// inventoryTable stores valued inventory reports
+	inventoryTable = "inventory_snapshot"
 	// data type constant
 
 )
@@ -328,6 +359,98 @@ func (ps *PostgresStorage) GetAllRecords(fromTime, toTime uint64) ([]common.Acti
 	return activities, nil
 }
 
+// SearchActivities returns a page of activities matching the query, query must be validated.
+func (ps *PostgresStorage) SearchActivities(query common.ActivityQuery) (common.ActivityPage, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	where := func(condition string, values ...interface{}) {
+		placeholders := make([]interface{}, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+	if len(query.Actions) != 0 {
+		where(`data ->> 'Action' = ANY($%d)`, pq.Array(query.Actions))
+	}
+	if query.Exchange != "" {
+		where(`data ->> 'Destination' = $%d`, query.Exchange)
+	}
+	if query.Asset != 0 {
+		condition := `data -> 'Params' ->> 'asset' = $%d OR data -> 'Params' -> 'assets' @> to_jsonb($%d::BIGINT)`
+		values := []interface{}{strconv.FormatUint(query.Asset, 10), query.Asset}
+		for exchange, symbol := range query.AssetSymbols {
+			// trades are matched by base or quote symbol of the asset on their exchange
+			condition += ` OR (data ->> 'Destination' = $%d AND $%d IN (data -> 'Params' ->> 'base', data -> 'Params' ->> 'quote'))`
+			values = append(values, exchange, symbol)
+		}
+		where("("+condition+")", values...)
+	}
+	switch query.State {
+	case common.ActivityStatePending:
+		where(`is_pending IS TRUE`)
+	case common.ActivityStateFailed:
+		where(`is_pending IS FALSE AND (data ->> 'ExchangeStatus' = $%d OR data ->> 'MiningStatus' = $%d)`,
+			common.ExchangeStatusFailed, common.MiningStatusFailed)
+	case common.ActivityStateCancelled:
+		where(`is_pending IS FALSE AND data ->> 'ExchangeStatus' = $%d AND COALESCE(data ->> 'MiningStatus', '') <> $%d`,
+			common.ExchangeStatusCancelled, common.MiningStatusFailed)
+	case common.ActivityStateDone:
+		where(`is_pending IS FALSE AND COALESCE(data ->> 'ExchangeStatus', '') NOT IN ($%d, $%d) AND COALESCE(data ->> 'MiningStatus', '') <> $%d`,
+			common.ExchangeStatusFailed, common.ExchangeStatusCancelled, common.MiningStatusFailed)
+	}
+	if query.Tx != "" {
+		where(`data -> 'Result' ->> 'tx' = $%d`, query.Tx)
+	}
+	if query.OrderID != "" {
+		where(`data -> 'Result' ->> 'id' = $%d`, query.OrderID)
+	}
+	if query.FromTime != 0 {
+		where(`created >= $%d`, common.MillisToTime(query.FromTime))
+	}
+	if query.ToTime != 0 {
+		where(`created <= $%d`, common.MillisToTime(query.ToTime))
+	}
+	cursor, err := query.CursorID()
+	if err != nil {
+		return common.ActivityPage{}, err
+	}
+	order, compare := "DESC", "<"
+	if query.Order == common.SortAsc {
+		order, compare = "ASC", ">"
+	}
+	if cursor != nil {
+		where(`(timepoint, eid) `+compare+` ($%d, $%d)`, cursor.Timepoint, cursor.EID)
+	}
+	stmt := fmt.Sprintf(`SELECT data FROM "%s"`, activityTable)
+	if len(conditions) != 0 {
+		stmt += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	// one more activity is selected to know if there is a next page
+	stmt += fmt.Sprintf(` ORDER BY timepoint %s, eid %s LIMIT %d`, order, order, query.Limit+1)
+
+	var data [][]byte
+	if err := ps.db.Select(&data, stmt, args...); err != nil {
+		return common.ActivityPage{}, err
+	}
+	page := common.ActivityPage{Activities: []common.ActivityRecord{}}
+	for i, dataByte := range data {
+		if i == query.Limit {
+			page.NextCursor = page.Activities[i-1].ID.String()
+			break
+		}
+		var activity common.ActivityRecord
+		if err := json.Unmarshal(dataByte, &activity); err != nil {
+			return common.ActivityPage{}, err
+		}
+		page.Activities = append(page.Activities, activity)
+	}
+	return page, nil
+}
+
 // GetPendingActivities return all pending activities
 func (ps *PostgresStorage) GetPendingActivities() ([]common.ActivityRecord, error) {
 	var (
@@ -348,31 +471,125 @@ func (ps *PostgresStorage) GetPendingActivities() ([]common.ActivityRecord, erro
 	return pendingActivities, nil
 }
 
-// UpdateActivity update activity to finished if it is finished
-func (ps *PostgresStorage) UpdateActivity(id common.ActivityID, act common.ActivityRecord) error {
+// UpdateActivity validates and records the status transition of activity, the activity is
+// updated if its statuses are changed or it is finished.
+func (ps *PostgresStorage) UpdateActivity(id common.ActivityID, act common.ActivityRecord, update common.ActivityUpdate) error {
 	var (
-		data []byte
+		data    []byte
+		current common.ActivityRecord
 	)
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
 	// get activity from db
-	getQuery := fmt.Sprintf(`SELECT data FROM "%s" WHERE timepoint = $1 AND eid = $2`, activityTable)
-	if err := ps.db.Get(&data, getQuery, id.Timepoint, id.EID); err != nil {
+	getQuery := fmt.Sprintf(`SELECT data FROM "%s" WHERE timepoint = $1 AND eid = $2 FOR UPDATE`, activityTable)
+	if err := tx.Get(&data, getQuery, id.Timepoint, id.EID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil
 		}
 		return err
 	}
-	// check if activity is not pending anymore update it
+	if err := json.Unmarshal(data, &current); err != nil {
+		return err
+	}
+	transition, err := current.Transition(act, update, common.NowInMillis())
+	if err != nil {
+		return err
+	}
+	// check if activity is not pending anymore or its statuses are changed then update it
+	if transition == nil && act.IsPending() {
+		return nil
+	}
 	updateQuery := fmt.Sprintf(`UPDATE "%s" SET is_pending = $1, data = $2 WHERE timepoint = $3 AND eid = $4`, activityTable)
 	dataBytes, err := json.Marshal(act)
 	if err != nil {
 		return err
 	}
-	if !act.IsPending() {
-		if _, err := ps.db.Exec(updateQuery, false, dataBytes, id.Timepoint, id.EID); err != nil {
+	if _, err := tx.Exec(updateQuery, act.IsPending(), dataBytes, id.Timepoint, id.EID); err != nil {
+		return err
+	}
+	if transition != nil {
+		if err := ps.storeTransition(tx, id, *transition); err != nil {
 			return err
 		}
 	}
-	return nil
+	return tx.Commit()
+}
+
+// ModifyActivity applies modify on the activity and updates it with its status transition in one
+// transaction, the activity is locked until it is updated so concurrent updates are not lost.
+func (ps *PostgresStorage) ModifyActivity(id common.ActivityID, update common.ActivityUpdate,
+	modify func(common.ActivityRecord) (common.ActivityRecord, error)) (common.ActivityRecord, error) {
+	var (
+		data    []byte
+		current common.ActivityRecord
+	)
+	tx, err := ps.db.Beginx()
+	if err != nil {
+		return common.ActivityRecord{}, err
+	}
+	defer postgres.RollbackUnlessCommitted(tx)
+	getQuery := fmt.Sprintf(`SELECT data FROM "%s" WHERE timepoint = $1 AND eid = $2 FOR UPDATE`, activityTable)
+	if err := tx.Get(&data, getQuery, id.Timepoint, id.EID); err != nil {
+		return common.ActivityRecord{}, err
+	}
+	if err := json.Unmarshal(data, &current); err != nil {
+		return common.ActivityRecord{}, err
+	}
+	next, err := modify(current)
+	if err != nil {
+		return common.ActivityRecord{}, err
+	}
+	transition, err := current.Transition(next, update, common.NowInMillis())
+	if err != nil {
+		return common.ActivityRecord{}, err
+	}
+	dataBytes, err := json.Marshal(next)
+	if err != nil {
+		return common.ActivityRecord{}, err
+	}
+	updateQuery := fmt.Sprintf(`UPDATE "%s" SET is_pending = $1, data = $2 WHERE timepoint = $3 AND eid = $4`, activityTable)
+	if _, err := tx.Exec(updateQuery, next.IsPending(), dataBytes, id.Timepoint, id.EID); err != nil {
+		return common.ActivityRecord{}, err
+	}
+	if transition != nil {
+		if err := ps.storeTransition(tx, id, *transition); err != nil {
+			return common.ActivityRecord{}, err
+		}
+	}
+	return next, tx.Commit()
+}
+
+func (ps *PostgresStorage) storeTransition(tx *sqlx.Tx, id common.ActivityID, transition common.ActivityTransition) error {
+	query := fmt.Sprintf(`INSERT INTO "%s" (timepoint, eid, created, data) VALUES ($1, $2, $3, $4)`, transitionTable)
+	data, err := json.Marshal(transition)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(query, id.Timepoint, id.EID, common.MillisToTime(transition.Timestamp), data)
+	return err
+}
+
+// GetActivityTimeline returns status transitions of an activity from the oldest one.
+func (ps *PostgresStorage) GetActivityTimeline(id common.ActivityID) ([]common.ActivityTransition, error) {
+	var (
+		data     [][]byte
+		timeline = []common.ActivityTransition{}
+	)
+	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE timepoint = $1 AND eid = $2 ORDER BY id`, transitionTable)
+	if err := ps.db.Select(&data, query, id.Timepoint, id.EID); err != nil {
+		return nil, err
+	}
+	for _, dataByte := range data {
+		var transition common.ActivityTransition
+		if err := json.Unmarshal(dataByte, &transition); err != nil {
+			return nil, err
+		}
+		timeline = append(timeline, transition)
+	}
+	return timeline, nil
 }
 
 // GetActivity return activity record by id
@@ -444,10 +661,18 @@ func (ps *PostgresStorage) Record(action string, id common.ActivityID, destinati
 	if err != nil {
 		return err
 	}
-	if _, err := ps.db.Exec(query, timestamp, data, true, id.Timepoint, id.EID); err != nil {
+	tx, err := ps.db.Beginx()
+	if err != nil {
 		return err
 	}
-	return nil
+	defer postgres.RollbackUnlessCommitted(tx)
+	if _, err := tx.Exec(query, timestamp, data, true, id.Timepoint, id.EID); err != nil {
+		return err
+	}
+	if err := ps.storeTransition(tx, id, common.NewActivityCreation(record, common.ActivitySourceCore, timepoint)); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // StoreGoldInfo store gold info into database
@@ -509,3 +734,34 @@ func (ps *PostgresStorage) CurrentBTCInfoVersion(timepoint uint64) (common.Versi
 func (ps *PostgresStorage) CurrentUSDInfoVersion(timepoint uint64) (common.Version, error) {
 	return ps.currentVersion(usdDataType, timepoint)
 }
+
+// StoreInventorySnapshot stores an inventory report.
+func (ps *PostgresStorage) StoreInventorySnapshot(report common.InventoryReport) error {
+	query := fmt.Sprintf(`INSERT INTO "%s" (timestamp, data) VALUES ($1, $2)`, inventoryTable)
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	_, err = ps.db.Exec(query, report.Timestamp, data)
+	return err
+}
+
+// GetInventorySnapshots returns inventory reports stored in [fromTime, toTime] from the oldest one.
+func (ps *PostgresStorage) GetInventorySnapshots(fromTime, toTime uint64) ([]common.InventoryReport, error) {
+	var (
+		data    [][]byte
+		reports = []common.InventoryReport{}
+	)
+	query := fmt.Sprintf(`SELECT data FROM "%s" WHERE timestamp >= $1 AND timestamp <= $2 ORDER BY timestamp`, inventoryTable)
+	if err := ps.db.Select(&data, query, fromTime, toTime); err != nil {
+		return nil, err
+	}
+	for _, dataByte := range data {
+		var report common.InventoryReport
+		if err := json.Unmarshal(dataByte, &report); err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
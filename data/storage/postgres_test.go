@@ -177,7 +177,7 @@ func TestActivity(t *testing.T) {
 	}
 
 	activityTest.ExchangeStatus = common.ExchangeStatusDone
-	err = ps.UpdateActivity(testID, activityTest)
+	err = ps.UpdateActivity(testID, activityTest, common.ActivityUpdate{Source: common.ActivitySourceCore})
 	assert.NoError(t, err)
 
 	hasPending, err = ps.HasPendingDeposit(commonv3.Asset{ID: 2}, common.TestExchange{})
@@ -188,6 +188,24 @@ func TestActivity(t *testing.T) {
 	activity, err := ps.GetActivity(testID)
 	assert.NoError(t, err)
 	assert.Equal(t, activityTest, activity)
+
+	timeline, err := ps.GetActivityTimeline(testID)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, common.ActivitySourceCore, timeline[1].Source)
+	assert.Equal(t, common.ExchangeStatusDone, timeline[1].ExchangeStatus)
+
+	query := common.ActivityQuery{Exchange: "binance", State: common.ActivityStateDone, Asset: 2}
+	require.NoError(t, query.Validate())
+	page, err := ps.SearchActivities(query)
+	require.NoError(t, err)
+	assert.Equal(t, []common.ActivityRecord{activityTest}, page.Activities)
+	assert.Empty(t, page.NextCursor)
+	query = common.ActivityQuery{State: common.ActivityStatePending}
+	require.NoError(t, query.Validate())
+	page, err = ps.SearchActivities(query)
+	require.NoError(t, err)
+	assert.Empty(t, page.Activities)
 }
 
 func TestAuthData(t *testing.T) {
@@ -532,3 +550,25 @@ func TestUSDData(t *testing.T) {
 	err = ps.StoreUSDInfo(usdTest)
 	assert.NoError(t, err)
 }
+
+func TestInventorySnapshots(t *testing.T) {
+	db, teardown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		require.NoError(t, teardown())
+	}()
+
+	ps, err := NewPostgresStorage(db)
+	require.NoError(t, err)
+
+	for _, timestamp := range []uint64{3000, 1000, 2000} {
+		report := common.InventoryReport{Timestamp: timestamp, USDRate: 200, Unvalued: []string{}}
+		report.Assets = []common.InventoryAsset{{AssetID: 1, Symbol: "ETH", Total: 10, Valued: true}}
+		require.NoError(t, ps.StoreInventorySnapshot(report))
+	}
+	reports, err := ps.GetInventorySnapshots(1500, 3000)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	assert.Equal(t, uint64(2000), reports[0].Timestamp)
+	assert.Equal(t, uint64(3000), reports[1].Timestamp)
+	assert.Equal(t, "ETH", reports[0].Assets[0].Symbol)
+}
@@ -1,9 +1,11 @@
 package exchange
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 	"sync"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
@@ -28,6 +30,19 @@ type Binance struct {
 	l       *zap.SugaredLogger
 	BinanceLive
 	id common.ExchangeID
+	// subAccountEmails are emails of sub accounts funds can be transferred to/from, bn.id is
+	// the master account.
+	subAccountEmails map[common.ExchangeID]string
+}
+
+// BinanceOption is the option to create Binance exchange.
+type BinanceOption func(*Binance)
+
+// WithSubAccount configures a sub account of Binance master account for internal transfers.
+func WithSubAccount(id common.ExchangeID, email string) BinanceOption {
+	return func(bn *Binance) {
+		bn.subAccountEmails[id] = email
+	}
 }
 
 // TokenAddresses return deposit addresses of token
@@ -89,15 +104,27 @@ func (bn *Binance) TokenPairs() ([]commonv3.TradingPairSymbols, error) {
 	return pairs, nil
 }
 
-// QueryOrder return current order status
-func (bn *Binance) QueryOrder(symbol string, id uint64) (done float64, remaining float64, finished bool, err error) {
-	result, err := bn.interf.OrderStatus(symbol, id)
+// QueryOrder return filled and remaining amount and status of the order
+func (bn *Binance) QueryOrder(symbol string, id uint64) (done float64, remaining float64, status string, err error) {
+	result, err := bn.interf.OrderStatus(context.Background(), symbol, id)
 	if err != nil {
-		return 0, 0, false, err
+		return 0, 0, "", err
 	}
 	done, _ = strconv.ParseFloat(result.ExecutedQty, 64)
 	total, _ := strconv.ParseFloat(result.OrigQty, 64)
-	return done, total - done, total-done < binanceEpsilon, nil
+	switch {
+	case result.Status == "CANCELED":
+		status = common.OrderStatusCancelled
+	case result.Status == "EXPIRED":
+		status = common.OrderStatusExpired
+	case result.Status == "REJECTED":
+		status = common.OrderStatusRejected
+	case result.Status == "FILLED" || total-done < binanceEpsilon:
+		status = common.OrderStatusFilled
+	default:
+		status = common.OrderStatusOpen
+	}
+	return done, total - done, status, nil
 }
 
 func (bn *Binance) Trade(tradeType string, pair commonv3.TradingPairSymbols, rate float64, amount float64) (id string, done float64, remaining float64, finished bool, err error) {
@@ -105,12 +132,12 @@ func (bn *Binance) Trade(tradeType string, pair commonv3.TradingPairSymbols, rat
 	if err != nil {
 		return "", 0, 0, false, err
 	}
-	done, remaining, finished, err = bn.QueryOrder(
+	done, remaining, status, err := bn.QueryOrder(
 		pair.BaseSymbol+pair.QuoteSymbol,
 		result.OrderID,
 	)
 	id = strconv.FormatUint(result.OrderID, 10)
-	return id, done, remaining, finished, err
+	return id, done, remaining, err == nil && status != common.OrderStatusOpen, err
 }
 
 func (bn *Binance) Withdraw(asset commonv3.Asset, amount *big.Int, address ethereum.Address) (string, error) {
@@ -220,12 +247,12 @@ func (bn *Binance) FetchPriceData(timepoint uint64) (map[uint64]common.ExchangeP
 	return result, err
 }
 
-func (bn *Binance) FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error) {
+func (bn *Binance) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
 	result := common.EBalanceEntry{}
 	result.Timestamp = common.Timestamp(fmt.Sprintf("%d", timepoint))
 	result.Valid = true
 	result.Error = ""
-	respData, err := bn.interf.GetInfo()
+	respData, err := bn.interf.GetInfo(ctx)
 	result.ReturnTime = common.GetTimestamp()
 	if err != nil {
 		result.Valid = false
@@ -284,6 +311,10 @@ func (bn *Binance) FetchOnePairTradeHistory(pair commonv3.TradingPairSymbols) ([
 		if err != nil {
 			return nil, errors.Wrapf(err, "Can not parse quantity: %v", trade.Qty)
 		}
+		commission, err := strconv.ParseFloat(trade.Commission, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can not parse commission: %v", trade.Commission)
+		}
 		historyType := "sell"
 		if trade.IsBuyer {
 			historyType = "buy"
@@ -294,7 +325,7 @@ func (bn *Binance) FetchOnePairTradeHistory(pair commonv3.TradingPairSymbols) ([
 			quantity,
 			historyType,
 			trade.Time,
-		)
+		).WithCommission(strconv.FormatUint(trade.OrderID, 10), commission, trade.CommissionAsset, trade.IsMaker)
 		result = append(result, tradeHistory)
 	}
 	return result, nil
@@ -343,10 +374,10 @@ func (bn *Binance) GetTradeHistory(fromTime, toTime uint64) (common.ExchangeTrad
 	return bn.storage.GetTradeHistory(fromTime, toTime)
 }
 
-func (bn *Binance) DepositStatus(id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
+func (bn *Binance) DepositStatus(ctx context.Context, id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
 	startTime := timepoint - 86400000
 	endTime := timepoint
-	deposits, err := bn.interf.DepositHistory(startTime, endTime)
+	deposits, err := bn.interf.DepositHistory(ctx, startTime, endTime)
 	if err != nil || !deposits.Success {
 		return "", err
 	}
@@ -363,10 +394,10 @@ func (bn *Binance) DepositStatus(id common.ActivityID, txHash string, assetID ui
 	return "", nil
 }
 
-func (bn *Binance) WithdrawStatus(id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
+func (bn *Binance) WithdrawStatus(ctx context.Context, id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
 	startTime := timepoint - 86400000
 	endTime := timepoint
-	withdraws, err := bn.interf.WithdrawHistory(startTime, endTime)
+	withdraws, err := bn.interf.WithdrawHistory(ctx, startTime, endTime)
 	if err != nil || !withdraws.Success {
 		return "", "", err
 	}
@@ -383,13 +414,130 @@ func (bn *Binance) WithdrawStatus(id string, assetID uint64, amount float64, tim
 	return "", "", nil
 }
 
-func (bn *Binance) OrderStatus(id string, base, quote string) (string, error) {
+// TransferHistory returns deposits and withdrawals made on Binance in the time range.
+func (bn *Binance) TransferHistory(fromTime, toTime uint64) ([]common.ExchangeTransfer, error) {
+	deposits, err := bn.interf.DepositHistory(context.Background(), fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+	if !deposits.Success {
+		return nil, fmt.Errorf("failed to get deposit history from binance: %s", deposits.Msg)
+	}
+	withdraws, err := bn.interf.WithdrawHistory(context.Background(), fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+	if !withdraws.Success {
+		return nil, fmt.Errorf("failed to get withdraw history from binance: %s", withdraws.Msg)
+	}
+	var transfers []common.ExchangeTransfer
+	for _, deposit := range deposits.Deposits {
+		status := common.ExchangeStatusPending
+		if deposit.Status == 1 {
+			status = common.ExchangeStatusDone
+		}
+		transfers = append(transfers, common.ExchangeTransfer{
+			Type:      common.TransferTypeDeposit,
+			TxHash:    deposit.TxID,
+			Asset:     deposit.Asset,
+			Amount:    deposit.Amount,
+			Status:    status,
+			Timestamp: deposit.InsertTime,
+		})
+	}
+	for _, withdraw := range withdraws.Withdrawals {
+		// 0: email sent, 1: cancelled, 2: awaiting approval, 3: rejected, 4: processing,
+		// 5: failure, 6: completed
+		status := common.ExchangeStatusPending
+		switch withdraw.Status {
+		case 6:
+			status = common.ExchangeStatusDone
+		case 1, 3, 5:
+			status = common.ExchangeStatusFailed
+		}
+		transfers = append(transfers, common.ExchangeTransfer{
+			Type:      common.TransferTypeWithdraw,
+			ID:        withdraw.ID,
+			TxHash:    withdraw.TxID,
+			Asset:     withdraw.Asset,
+			Amount:    withdraw.Amount,
+			Status:    status,
+			Timestamp: withdraw.ApplyTime,
+		})
+	}
+	return transfers, nil
+}
+
+// accountEmail returns email of the account, empty email is returned for master account.
+func (bn *Binance) accountEmail(id common.ExchangeID) (string, bool) {
+	if id == bn.id {
+		return "", true
+	}
+	email, ok := bn.subAccountEmails[id]
+	return email, ok
+}
+
+// SupportInternalTransfer returns true if both accounts are master or configured sub accounts.
+func (bn *Binance) SupportInternalTransfer(from, to common.ExchangeID) bool {
+	_, fromOK := bn.accountEmail(from)
+	_, toOK := bn.accountEmail(to)
+	return from != to && fromOK && toOK
+}
+
+// InternalTransfer transfers asset between master account and sub accounts.
+func (bn *Binance) InternalTransfer(from, to common.ExchangeID, asset commonv3.Asset, amount *big.Int) (string, error) {
+	if !bn.SupportInternalTransfer(from, to) {
+		return "", fmt.Errorf("internal transfer from %s to %s is not supported by %s", from.String(), to.String(), bn.id.String())
+	}
+	var symbol string
+	for _, exchange := range asset.Exchanges {
+		if exchange.ExchangeID == uint64(from) || (symbol == "" && exchange.ExchangeID == uint64(bn.id)) {
+			symbol = exchange.Symbol
+		}
+	}
+	if symbol == "" {
+		return "", fmt.Errorf("asset %d is not configured on %s", asset.ID, from.String())
+	}
+	fromEmail, _ := bn.accountEmail(from)
+	toEmail, _ := bn.accountEmail(to)
+	result, err := bn.interf.UniversalTransfer(fromEmail, toEmail, symbol, common.BigToFloat(amount, int64(asset.Decimals)))
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(result.TranID, 10), nil
+}
+
+// InternalTransferStatus returns status of internal transfer.
+func (bn *Binance) InternalTransferStatus(ctx context.Context, id string, timepoint uint64) (string, error) {
+	startTime := timepoint - 86400000
+	endTime := timepoint
+	transfers, err := bn.interf.UniversalTransferHistory(ctx, startTime, endTime)
+	if err != nil {
+		return "", err
+	}
+	for _, transfer := range transfers.Result {
+		if strconv.FormatUint(transfer.TranID, 10) != id {
+			continue
+		}
+		switch transfer.Status {
+		case "SUCCESS":
+			return common.ExchangeStatusDone, nil
+		case "FAILURE":
+			return common.ExchangeStatusFailed, nil
+		}
+		return "", nil
+	}
+	bn.l.Warnw("Binance internal transfer is not found in transfer history", "id", id, "timepoint", timepoint)
+	return "", nil
+}
+
+func (bn *Binance) OrderStatus(ctx context.Context, id string, base, quote string) (string, error) {
 	orderID, err := strconv.ParseUint(id, 10, 64)
 	if err != nil {
 		return "", fmt.Errorf("can not parse orderID (val %s) to uint", id)
 	}
 	symbol := base + quote
-	order, err := bn.interf.OrderStatus(symbol, orderID)
+	order, err := bn.interf.OrderStatus(ctx, symbol, orderID)
 	if err != nil {
 		return "", err
 	}
@@ -399,8 +547,42 @@ func (bn *Binance) OrderStatus(id string, base, quote string) (string, error) {
 	return common.ExchangeStatusDone, nil
 }
 
+// OpenOrders returns orders which are still open on Binance for all trading pairs.
+func (bn *Binance) OpenOrders() ([]common.OpenOrder, error) {
+	pairs, err := bn.TokenPairs()
+	if err != nil {
+		return nil, err
+	}
+	var result []common.OpenOrder
+	for _, pair := range pairs {
+		orders, err := bn.interf.OpenOrdersForOnePair(pair)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open orders of %s%s, err=%v", pair.BaseSymbol, pair.QuoteSymbol, err)
+		}
+		for _, order := range orders {
+			price, _ := strconv.ParseFloat(order.Price, 64)
+			amount, _ := strconv.ParseFloat(order.OrigQty, 64)
+			executed, _ := strconv.ParseFloat(order.ExecutedQty, 64)
+			result = append(result, common.OpenOrder{
+				ID:             strconv.FormatUint(order.OrderID, 10),
+				Exchange:       bn.id,
+				PairID:         pair.ID,
+				Base:           pair.BaseSymbol,
+				Quote:          pair.QuoteSymbol,
+				Side:           strings.ToLower(order.Side),
+				Price:          price,
+				Amount:         amount,
+				ExecutedAmount: executed,
+				Time:           order.Time,
+			})
+		}
+	}
+	return result, nil
+}
+
 // NewBinance init new binance instance
-func NewBinance(id common.ExchangeID, interf BinanceInterface, storage BinanceStorage, sr storage.Interface) (*Binance, error) {
+func NewBinance(id common.ExchangeID, interf BinanceInterface, storage BinanceStorage, sr storage.Interface,
+	options ...BinanceOption) (*Binance, error) {
 	binance := &Binance{
 		interf:  interf,
 		storage: storage,
@@ -408,8 +590,12 @@ func NewBinance(id common.ExchangeID, interf BinanceInterface, storage BinanceSt
 		BinanceLive: BinanceLive{
 			interf: interf,
 		},
-		id: id,
-		l:  zap.S(),
+		id:               id,
+		l:                zap.S(),
+		subAccountEmails: make(map[common.ExchangeID]string),
+	}
+	for _, option := range options {
+		option(binance)
 	}
 	return binance, nil
 }
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
@@ -25,6 +27,7 @@ import (
 // interf for calling api in different env
 // timedelta to make sure calling api in time
 type Endpoint struct {
+	signerMu   sync.RWMutex
 	signer     Signer
 	interf     Interface
 	timeDelta  int64
@@ -33,6 +36,19 @@ type Endpoint struct {
 	client     *http.Client
 }
 
+// SetSigner replaces the API key of the endpoint, requests in progress keep the old key.
+func (ep *Endpoint) SetSigner(signer Signer) {
+	ep.signerMu.Lock()
+	defer ep.signerMu.Unlock()
+	ep.signer = signer
+}
+
+func (ep *Endpoint) getSigner() Signer {
+	ep.signerMu.RLock()
+	defer ep.signerMu.RUnlock()
+	return ep.signer
+}
+
 func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool, timepoint uint64) {
 	if req.Method == "POST" || req.Method == "PUT" || req.Method == "DELETE" {
 		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
@@ -42,10 +58,11 @@ func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool, timepoint ui
 	if signNeeded {
 		q := req.URL.Query()
 		sig := url.Values{}
-		req.Header.Set("X-MBX-APIKEY", ep.signer.GetKey())
+		signer := ep.getSigner()
+		req.Header.Set("X-MBX-APIKEY", signer.GetKey())
 		q.Set("timestamp", fmt.Sprintf("%d", int64(timepoint)+ep.timeDelta-1000))
 		q.Set("recvWindow", "5000")
-		sig.Set("signature", ep.signer.Sign(q.Encode()))
+		sig.Set("signature", signer.Sign(q.Encode()))
 		// Using separated values map for signature to ensure it is at the end
 		// of the query. This is required for /wapi apis from binance without
 		// any damn documentation about it!!!
@@ -55,6 +72,13 @@ func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool, timepoint ui
 
 // GetResponse call to binance endpoint and get response
 func (ep *Endpoint) GetResponse(
+	method string, url string,
+	params map[string]string, signNeeded bool, timepoint uint64) ([]byte, error) {
+	return ep.GetResponseWithContext(context.Background(), method, url, params, signNeeded, timepoint)
+}
+
+// GetResponseWithContext is GetResponse whose request is cancelled once ctx is done.
+func (ep *Endpoint) GetResponseWithContext(ctx context.Context,
 	method string, url string,
 	params map[string]string, signNeeded bool, timepoint uint64) ([]byte, error) {
 	var (
@@ -62,7 +86,7 @@ func (ep *Endpoint) GetResponse(
 		respBody []byte
 	)
 
-	req, err := http.NewRequest(method, url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -220,9 +244,9 @@ func (ep *Endpoint) GetAccountTradeHistory(
 	return result, err
 }
 
-func (ep *Endpoint) WithdrawHistory(startTime, endTime uint64) (exchange.Binawithdrawals, error) {
+func (ep *Endpoint) WithdrawHistory(ctx context.Context, startTime, endTime uint64) (exchange.Binawithdrawals, error) {
 	result := exchange.Binawithdrawals{}
-	respBody, err := ep.GetResponse(
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/wapi/v3/withdrawHistory.html",
 		map[string]string{
@@ -243,9 +267,9 @@ func (ep *Endpoint) WithdrawHistory(startTime, endTime uint64) (exchange.Binawit
 	return result, err
 }
 
-func (ep *Endpoint) DepositHistory(startTime, endTime uint64) (exchange.Binadeposits, error) {
+func (ep *Endpoint) DepositHistory(ctx context.Context, startTime, endTime uint64) (exchange.Binadeposits, error) {
 	result := exchange.Binadeposits{}
-	respBody, err := ep.GetResponse(
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/wapi/v3/depositHistory.html",
 		map[string]string{
@@ -289,9 +313,9 @@ func (ep *Endpoint) CancelOrder(symbol string, id uint64) (exchange.Binacancel,
 	return result, err
 }
 
-func (ep *Endpoint) OrderStatus(symbol string, id uint64) (exchange.Binaorder, error) {
+func (ep *Endpoint) OrderStatus(ctx context.Context, symbol string, id uint64) (exchange.Binaorder, error) {
 	result := exchange.Binaorder{}
-	respBody, err := ep.GetResponse(
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/api/v3/order",
 		map[string]string{
@@ -344,9 +368,60 @@ func (ep *Endpoint) Withdraw(asset commonv3.Asset, amount *big.Int, address ethe
 	return "", fmt.Errorf("withdraw rejected by Binnace: %v", err)
 }
 
-func (ep *Endpoint) GetInfo() (exchange.Binainfo, error) {
-	result := exchange.Binainfo{}
+// UniversalTransfer transfers asset between master account and sub accounts instantly, empty
+// email means master account. It must be called with API key of master account.
+func (ep *Endpoint) UniversalTransfer(fromEmail, toEmail, symbol string, amount float64) (exchange.BinaUniversalTransfer, error) {
+	result := exchange.BinaUniversalTransfer{}
+	params := map[string]string{
+		"fromAccountType": "SPOT",
+		"toAccountType":   "SPOT",
+		"asset":           symbol,
+		"amount":          strconv.FormatFloat(amount, 'f', -1, 64),
+	}
+	if fromEmail != "" {
+		params["fromEmail"] = fromEmail
+	}
+	if toEmail != "" {
+		params["toEmail"] = toEmail
+	}
 	respBody, err := ep.GetResponse(
+		"POST",
+		ep.interf.AuthenticatedEndpoint()+"/sapi/v1/sub-account/universalTransfer",
+		params,
+		true,
+		common.NowInMillis(),
+	)
+	if err != nil {
+		return result, fmt.Errorf("universal transfer rejected by Binance: %v", err)
+	}
+	if err = json.Unmarshal(respBody, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// UniversalTransferHistory returns sub-account universal transfers in time range.
+func (ep *Endpoint) UniversalTransferHistory(ctx context.Context, startTime, endTime uint64) (exchange.BinaUniversalTransferHistory, error) {
+	result := exchange.BinaUniversalTransferHistory{}
+	respBody, err := ep.GetResponseWithContext(ctx,
+		"GET",
+		ep.interf.AuthenticatedEndpoint()+"/sapi/v1/sub-account/universalTransfer",
+		map[string]string{
+			"startTime": fmt.Sprintf("%d", startTime),
+			"endTime":   fmt.Sprintf("%d", endTime),
+		},
+		true,
+		common.NowInMillis(),
+	)
+	if err == nil {
+		err = json.Unmarshal(respBody, &result)
+	}
+	return result, err
+}
+
+func (ep *Endpoint) GetInfo(ctx context.Context) (exchange.Binainfo, error) {
+	result := exchange.Binainfo{}
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/api/v3/account",
 		map[string]string{},
@@ -456,9 +531,9 @@ func (ep *Endpoint) UpdateTimeDelta() error {
 }
 
 //NewBinanceEndpoint return new endpoint instance for using binance
-func NewBinanceEndpoint(signer Signer, interf Interface, dpl deployment.Deployment, client *http.Client) *Endpoint {
+func NewBinanceEndpoint(exchangeID common.ExchangeID, signer Signer, interf Interface, dpl deployment.Deployment, client *http.Client) *Endpoint {
 	l := zap.S()
-	endpoint := &Endpoint{signer: signer, interf: interf, l: l, client: client}
+	endpoint := &Endpoint{exchangeID: exchangeID, signer: signer, interf: interf, l: l, client: client}
 	switch dpl {
 	case deployment.Simulation:
 		l.Info("Simulate environment, no updateTime called...")
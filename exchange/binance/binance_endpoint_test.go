@@ -0,0 +1,104 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/cmd/deployment"
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/exchange"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+// universalTransferServer is a local mock of Binance sub-account universal transfer endpoints.
+type universalTransferServer struct {
+	mu        sync.Mutex
+	transfers []exchange.BinaUniversalTransferRecord
+}
+
+func (s *universalTransferServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path != "/sapi/v1/sub-account/universalTransfer" || r.URL.Query().Get("signature") == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	q := r.URL.Query()
+	var resp interface{}
+	switch r.Method {
+	case http.MethodPost:
+		record := exchange.BinaUniversalTransferRecord{
+			TranID:          uint64(len(s.transfers) + 1),
+			FromEmail:       q.Get("fromEmail"),
+			ToEmail:         q.Get("toEmail"),
+			Asset:           q.Get("asset"),
+			Amount:          q.Get("amount"),
+			FromAccountType: q.Get("fromAccountType"),
+			ToAccountType:   q.Get("toAccountType"),
+			Status:          "SUCCESS",
+		}
+		s.transfers = append(s.transfers, record)
+		resp = exchange.BinaUniversalTransfer{TranID: record.TranID}
+	case http.MethodGet:
+		resp = exchange.BinaUniversalTransferHistory{Result: s.transfers, TotalCount: len(s.transfers)}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func TestInternalTransfer(t *testing.T) {
+	mock := &universalTransferServer{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	endpoint := NewBinanceEndpoint(common.Binance, NewSigner("key", "secret"), NewRealInterface(server.URL), deployment.Simulation, server.Client())
+	bn, err := exchange.NewBinance(common.Binance, endpoint, nil, nil,
+		exchange.WithSubAccount(common.Binance2, "sub@example.com"))
+	require.NoError(t, err)
+
+	assert.True(t, bn.SupportInternalTransfer(common.Binance, common.Binance2))
+	assert.True(t, bn.SupportInternalTransfer(common.Binance2, common.Binance))
+	assert.False(t, bn.SupportInternalTransfer(common.Binance, common.Huobi))
+	assert.False(t, bn.SupportInternalTransfer(common.Binance, common.Binance))
+
+	asset := commonv3.Asset{
+		ID:       1,
+		Decimals: 18,
+		Exchanges: []commonv3.AssetExchange{
+			{ExchangeID: uint64(common.Binance), Symbol: "KNC"},
+		},
+	}
+	id, err := bn.InternalTransfer(common.Binance2, common.Binance, asset, common.EthToWei(1.5))
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	require.Len(t, mock.transfers, 1)
+	transfer := mock.transfers[0]
+	assert.Equal(t, "sub@example.com", transfer.FromEmail)
+	assert.Equal(t, "", transfer.ToEmail)
+	assert.Equal(t, "KNC", transfer.Asset)
+	assert.Equal(t, "1.5", transfer.Amount)
+	assert.Equal(t, "SPOT", transfer.FromAccountType)
+
+	status, err := bn.InternalTransferStatus(context.Background(), id, common.NowInMillis())
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, status)
+
+	status, err = bn.InternalTransferStatus(context.Background(), "100", common.NowInMillis())
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+
+	_, err = bn.InternalTransfer(common.Binance, common.Huobi, asset, common.EthToWei(1))
+	require.Error(t, err)
+}
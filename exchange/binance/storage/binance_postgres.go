@@ -23,6 +23,10 @@ const (
 		    type			TEXT NOT NULL,
 		    time			BIGINT
 		);
+		ALTER TABLE "binance_trade_history" ADD COLUMN IF NOT EXISTS order_id TEXT NOT NULL DEFAULT '';
+		ALTER TABLE "binance_trade_history" ADD COLUMN IF NOT EXISTS fee FLOAT NOT NULL DEFAULT 0;
+		ALTER TABLE "binance_trade_history" ADD COLUMN IF NOT EXISTS fee_asset TEXT NOT NULL DEFAULT '';
+		ALTER TABLE "binance_trade_history" ADD COLUMN IF NOT EXISTS maker BOOL NOT NULL DEFAULT FALSE;
 	`
 )
 
@@ -53,18 +57,18 @@ func NewPostgresStorage(db *sqlx.DB) (exchange.BinanceStorage, error) {
 func (s *postgresStorage) prepareStmts() error {
 	var err error
 	s.stmts.storeHistoryStmt, err = s.db.PrepareNamed(`INSERT INTO "binance_trade_history"
-		(pair_id, trade_id, price, qty, type, time)
-		VALUES(:pair_id, :trade_id, :price, :qty, :type, :time)`)
+		(pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker)
+		VALUES(:pair_id, :trade_id, :price, :qty, :type, :time, :order_id, :fee, :fee_asset, :maker)`)
 	if err != nil {
 		return err
 	}
-	s.stmts.getHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time 
+	s.stmts.getHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker 
 		FROM "binance_trade_history"
 		WHERE time >= $1 AND time <= $2`)
 	if err != nil {
 		return err
 	}
-	s.stmts.getLastIDHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time FROM "binance_trade_history"
+	s.stmts.getLastIDHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker FROM "binance_trade_history"
 											WHERE pair_id = $1 
 											ORDER BY time DESC, trade_id DESC;`)
 	if err != nil {
@@ -80,6 +84,11 @@ type exchangeTradeHistoryDB struct {
 	Qty     float64 `db:"qty"`
 	Type    string  `db:"type"`
 	Time    uint64  `db:"time"`
+
+	OrderID  string  `db:"order_id"`
+	Fee      float64 `db:"fee"`
+	FeeAsset string  `db:"fee_asset"`
+	Maker    bool    `db:"maker"`
 }
 
 // StoreTradeHistory implements exchange.BinanceStorage and store trade history
@@ -100,6 +109,11 @@ func (s *postgresStorage) StoreTradeHistory(data common.ExchangeTradeHistory) er
 				Qty:     history.Qty,
 				Type:    history.Type,
 				Time:    history.Timestamp,
+
+				OrderID:  history.OrderID,
+				Fee:      history.Fee,
+				FeeAsset: history.FeeAsset,
+				Maker:    history.Maker,
 			})
 			if err != nil {
 				return err
@@ -128,6 +142,10 @@ func (s *postgresStorage) GetTradeHistory(fromTime, toTime uint64) (common.Excha
 			Qty:       r.Qty,
 			Type:      r.Type,
 			Timestamp: r.Time,
+			OrderID:   r.OrderID,
+			Fee:       r.Fee,
+			FeeAsset:  r.FeeAsset,
+			Maker:     r.Maker,
 		})
 		result[r.PairID] = tradeHistory
 	}
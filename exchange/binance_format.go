@@ -88,6 +88,30 @@ type Binawithdraw struct {
 	ID      string `json:"id"`
 }
 
+// BinaUniversalTransfer is the response of sub-account universal transfer
+type BinaUniversalTransfer struct {
+	TranID uint64 `json:"tranId"`
+}
+
+// BinaUniversalTransferRecord is a record of sub-account universal transfer history
+type BinaUniversalTransferRecord struct {
+	TranID          uint64 `json:"tranId"`
+	FromEmail       string `json:"fromEmail"`
+	ToEmail         string `json:"toEmail"`
+	Asset           string `json:"asset"`
+	Amount          string `json:"amount"`
+	FromAccountType string `json:"fromAccountType"`
+	ToAccountType   string `json:"toAccountType"`
+	Status          string `json:"status"`
+	CreateTimeStamp uint64 `json:"createTimeStamp"`
+}
+
+// BinaUniversalTransferHistory is the response of sub-account universal transfer history
+type BinaUniversalTransferHistory struct {
+	Result     []BinaUniversalTransferRecord `json:"result"`
+	TotalCount int                           `json:"totalCount"`
+}
+
 type Binaorder struct {
 	Code          int    `json:"code"`
 	Msg           string `json:"msg"`
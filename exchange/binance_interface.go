@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"math/big"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
@@ -14,7 +15,7 @@ type BinanceInterface interface {
 
 	OpenOrdersForOnePair(pair commonv3.TradingPairSymbols) (Binaorders, error)
 
-	GetInfo() (Binainfo, error)
+	GetInfo(ctx context.Context) (Binainfo, error)
 
 	GetExchangeInfo() (BinanceExchangeInfo, error)
 
@@ -34,9 +35,15 @@ type BinanceInterface interface {
 
 	CancelOrder(symbol string, id uint64) (Binacancel, error)
 
-	DepositHistory(startTime, endTime uint64) (Binadeposits, error)
+	DepositHistory(ctx context.Context, startTime, endTime uint64) (Binadeposits, error)
 
-	WithdrawHistory(startTime, endTime uint64) (Binawithdrawals, error)
+	WithdrawHistory(ctx context.Context, startTime, endTime uint64) (Binawithdrawals, error)
 
-	OrderStatus(symbol string, id uint64) (Binaorder, error)
+	OrderStatus(ctx context.Context, symbol string, id uint64) (Binaorder, error)
+
+	// UniversalTransfer transfers asset between master account and sub accounts,
+	// empty email means master account.
+	UniversalTransfer(fromEmail, toEmail, symbol string, amount float64) (BinaUniversalTransfer, error)
+
+	UniversalTransferHistory(ctx context.Context, startTime, endTime uint64) (BinaUniversalTransferHistory, error)
 }
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -31,7 +32,7 @@ func (bi *binanceTestInterface) OpenOrdersForOnePair(pair commonv3.TradingPairSy
 	panic("implement me")
 }
 
-func (bi *binanceTestInterface) GetInfo() (Binainfo, error) {
+func (bi *binanceTestInterface) GetInfo(ctx context.Context) (Binainfo, error) {
 	panic("implement me")
 }
 
@@ -65,14 +66,22 @@ func (bi *binanceTestInterface) CancelOrder(symbol string, id uint64) (Binacance
 	panic("implement me")
 }
 
-func (bi *binanceTestInterface) DepositHistory(startTime, endTime uint64) (Binadeposits, error) {
+func (bi *binanceTestInterface) DepositHistory(ctx context.Context, startTime, endTime uint64) (Binadeposits, error) {
 	panic("implement me")
 }
 
-func (bi *binanceTestInterface) WithdrawHistory(startTime, endTime uint64) (Binawithdrawals, error) {
+func (bi *binanceTestInterface) WithdrawHistory(ctx context.Context, startTime, endTime uint64) (Binawithdrawals, error) {
 	panic("implement me")
 }
 
-func (bi *binanceTestInterface) OrderStatus(symbol string, id uint64) (Binaorder, error) {
+func (bi *binanceTestInterface) OrderStatus(ctx context.Context, symbol string, id uint64) (Binaorder, error) {
+	panic("implement me")
+}
+
+func (bi *binanceTestInterface) UniversalTransfer(fromEmail, toEmail, symbol string, amount float64) (BinaUniversalTransfer, error) {
+	panic("implement me")
+}
+
+func (bi *binanceTestInterface) UniversalTransferHistory(ctx context.Context, startTime, endTime uint64) (BinaUniversalTransferHistory, error) {
 	panic("implement me")
 }
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -169,7 +170,7 @@ func (c *Coinbase) FetchOnePairData(
 	data.Store(pair.ID, result)
 }
 
-func (c *Coinbase) FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error) {
+func (c *Coinbase) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
 	return common.EBalanceEntry{}, nil // we return empty without error here so fetch won't warning
 }
 
@@ -177,15 +178,15 @@ func (c *Coinbase) FetchTradeHistory() {
 	// panic("implement me")
 }
 
-func (c *Coinbase) OrderStatus(id string, base, quote string) (string, error) {
+func (c *Coinbase) OrderStatus(ctx context.Context, id string, base, quote string) (string, error) {
 	return "", ErrNotSupport
 }
 
-func (c *Coinbase) DepositStatus(id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
+func (c *Coinbase) DepositStatus(ctx context.Context, id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
 	return "", ErrNotSupport
 }
 
-func (c *Coinbase) WithdrawStatus(id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
+func (c *Coinbase) WithdrawStatus(ctx context.Context, id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
 	return "", "", ErrNotSupport
 }
 
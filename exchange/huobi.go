@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -16,17 +17,20 @@ import (
 	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/blockchain"
 	huobiblockchain "github.com/KyberNetwork/reserve-data/exchange/huobi/blockchain"
-	huobihttp "github.com/KyberNetwork/reserve-data/exchange/huobi/http"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
 )
 
 const (
 	huobiEpsilon float64 = 0.0000000001 // 10e-10
+	// huobiTransferHistorySize is the largest number of deposits or withdrawals Huobi returns
+	// in a page.
+	huobiTransferHistorySize = 500
 )
 
 // Huobi is instance for Huobi exchange
 type Huobi struct {
+	id         common.ExchangeID
 	interf     HuobiInterface
 	blockchain HuobiBlockchain
 	storage    HuobiStorage
@@ -37,7 +41,7 @@ type Huobi struct {
 
 // TokenAddresses return deposit of all token supported by Huobi
 func (h *Huobi) TokenAddresses() (map[common.AssetID]ethereum.Address, error) {
-	result, err := h.sr.GetDepositAddresses(uint64(common.Huobi))
+	result, err := h.sr.GetDepositAddresses(uint64(h.id))
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +63,7 @@ func (h *Huobi) RealDepositAddress(tokenID string, asset commonv3.Asset) (ethere
 		} else {
 			h.l.Warnw("Get Huobi live deposit address for token failed: the replied address is empty. Check the currently available address instead", "tokenID", tokenID)
 		}
-		addrs, uErr := h.sr.GetDepositAddresses(uint64(common.Huobi))
+		addrs, uErr := h.sr.GetDepositAddresses(uint64(h.id))
 		if uErr != nil {
 			return ethereum.Address{}, uErr
 		}
@@ -78,7 +82,7 @@ func (h *Huobi) RealDepositAddress(tokenID string, asset commonv3.Asset) (ethere
 func (h *Huobi) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 	var exhSymbol string
 	for _, exchange := range asset.Exchanges {
-		if exchange.ExchangeID == uint64(common.Huobi) {
+		if exchange.ExchangeID == uint64(h.id) {
 			exhSymbol = exchange.Symbol
 		}
 	}
@@ -93,33 +97,41 @@ func (h *Huobi) Address(asset commonv3.Asset) (ethereum.Address, bool) {
 
 // TokenPairs return all token pair support by Huobi
 func (h *Huobi) TokenPairs() ([]commonv3.TradingPairSymbols, error) {
-	pairs, err := h.sr.GetTradingPairs(uint64(common.Huobi))
+	pairs, err := h.sr.GetTradingPairs(uint64(h.id))
 	if err != nil {
 		return nil, err
 	}
 	return pairs, nil
 }
 
-// QueryOrder return order status
-func (h *Huobi) QueryOrder(symbol string, id uint64) (done float64, remaining float64, finished bool, err error) {
-	result, err := h.interf.OrderStatus(symbol, id)
+// QueryOrder return filled and remaining amount and status of the order
+func (h *Huobi) QueryOrder(symbol string, id uint64) (done float64, remaining float64, status string, err error) {
+	result, err := h.interf.OrderStatus(context.Background(), symbol, id)
 	if err != nil {
-		return 0, 0, false, err
+		return 0, 0, "", err
 	}
 	if result.Data.ExecutedQty != "" {
 		done, err = strconv.ParseFloat(result.Data.ExecutedQty, 64)
 		if err != nil {
-			return 0, 0, false, err
+			return 0, 0, "", err
 		}
 	}
 	var total float64
 	if result.Data.OrigQty != "" {
 		total, err = strconv.ParseFloat(result.Data.OrigQty, 64)
 		if err != nil {
-			return 0, 0, false, err
+			return 0, 0, "", err
 		}
 	}
-	return done, total - done, total-done < huobiEpsilon, nil
+	switch {
+	case result.Data.State == "canceled" || result.Data.State == "partial-canceled":
+		status = common.OrderStatusCancelled
+	case result.Data.State == "filled" || total-done < huobiEpsilon:
+		status = common.OrderStatusFilled
+	default:
+		status = common.OrderStatusOpen
+	}
+	return done, total - done, status, nil
 }
 
 // Trade on Huobi
@@ -136,14 +148,14 @@ func (h *Huobi) Trade(tradeType string, pair commonv3.TradingPairSymbols, rate f
 			return "", 0, 0, false, err
 		}
 	}
-	done, remaining, finished, err = h.QueryOrder(
+	done, remaining, status, err := h.QueryOrder(
 		pair.BaseSymbol+pair.QuoteSymbol,
 		orderID,
 	)
 	if err != nil {
 		h.l.Warnw("Huobi Query order error", "err", err)
 	}
-	return result.OrderID, done, remaining, finished, err
+	return result.OrderID, done, remaining, err == nil && status != common.OrderStatusOpen, err
 }
 
 //Withdraw return withdraw id from huobi
@@ -255,12 +267,12 @@ func (h *Huobi) FetchPriceData(timepoint uint64) (map[uint64]common.ExchangePric
 }
 
 // FetchEBalanceData return account balance
-func (h *Huobi) FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error) {
+func (h *Huobi) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
 	result := common.EBalanceEntry{}
 	result.Timestamp = common.Timestamp(fmt.Sprintf("%d", timepoint))
 	result.Valid = true
 	result.Error = ""
-	respData, err := h.interf.GetInfo()
+	respData, err := h.interf.GetInfo(ctx)
 	result.ReturnTime = common.GetTimestamp()
 	if err != nil {
 		result.Valid = false
@@ -286,7 +298,7 @@ func (h *Huobi) FetchEBalanceData(timepoint uint64) (common.EBalanceEntry, error
 				tokenSymbol := strings.ToUpper(b.Currency)
 				for _, asset := range assets {
 					for _, exchg := range asset.Exchanges {
-						if exchg.ExchangeID == uint64(common.Huobi) && exchg.Symbol == tokenSymbol {
+						if exchg.ExchangeID == uint64(h.id) && exchg.Symbol == tokenSymbol {
 							balance, _ := strconv.ParseFloat(b.Balance, 64)
 							if b.Type == "trade" {
 								result.AvailableBalance[common.AssetID(asset.ID)] = balance
@@ -319,8 +331,12 @@ func (h *Huobi) FetchOnePairTradeHistory(pair commonv3.TradingPairSymbols) ([]co
 		if err != nil {
 			return nil, errors.Wrapf(err, "Can not parse price error: %v", trade.Amount)
 		}
+		fee, err := strconv.ParseFloat(trade.Fee, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Can not parse fee: %v", trade.Fee)
+		}
 		historyType := tradeTypeSell
-		if trade.Type == "buy-limit" {
+		if strings.HasPrefix(trade.Type, tradeTypeBuy) {
 			historyType = tradeTypeBuy
 		}
 		tradeHistory := common.NewTradeHistory(
@@ -329,7 +345,7 @@ func (h *Huobi) FetchOnePairTradeHistory(pair commonv3.TradingPairSymbols) ([]co
 			quantity,
 			historyType,
 			trade.Timestamp,
-		)
+		).WithCommission(strconv.FormatUint(trade.OrderID, 10), fee, strings.ToUpper(trade.FeeCurrency), trade.Role == "maker")
 		result = append(result, tradeHistory)
 	}
 	return result, nil
@@ -410,6 +426,24 @@ func (h *Huobi) PendingIntermediateTxs() (map[common.ActivityID]common.TXEntry,
 	return result, nil
 }
 
+// HuobiPool is the huobi accounts served by the huobi HTTP server.
+type HuobiPool []*Huobi
+
+// PendingIntermediateTxs returns intermediate pending txs of all the accounts.
+func (ha HuobiPool) PendingIntermediateTxs() (map[common.ActivityID]common.TXEntry, error) {
+	result := make(map[common.ActivityID]common.TXEntry)
+	for _, h := range ha {
+		pendings, err := h.PendingIntermediateTxs()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pending intermediate txs of %s: %v", h.id.String(), err)
+		}
+		for id, tx := range pendings {
+			result[id] = tx
+		}
+	}
+	return result, nil
+}
+
 // FindTx2InPending find the second transaction from pending list
 func (h *Huobi) FindTx2InPending(id common.ActivityID) (common.TXEntry, bool) {
 	pendings, err := h.storage.GetPendingIntermediateTXs()
@@ -437,7 +471,7 @@ func (h *Huobi) FindTx2(id common.ActivityID) (tx2 common.TXEntry, found bool) {
 	return tx2, found
 }
 
-func (h *Huobi) exchangeDepositStatus(id common.ActivityID, tx2Entry common.TXEntry, assetID uint64, sentAmount float64) (string, error) {
+func (h *Huobi) exchangeDepositStatus(ctx context.Context, id common.ActivityID, tx2Entry common.TXEntry, assetID uint64, sentAmount float64) (string, error) {
 	assets, err := h.sr.GetAssets()
 	if err != nil {
 		h.l.Warnw("Huobi ERROR: Can not get list of assets from setting", "err", err)
@@ -445,7 +479,7 @@ func (h *Huobi) exchangeDepositStatus(id common.ActivityID, tx2Entry common.TXEn
 	}
 
 	// make sure the size is enough for storing all deposit history
-	deposits, err := h.interf.DepositHistory(len(assets) * 2)
+	deposits, err := h.interf.DepositHistory(ctx, len(assets)*2, 0)
 	if err != nil || deposits.Status != "ok" {
 		h.l.Warnw("Huobi Getting deposit history from huobi failed", "err", err, "status", deposits)
 		return "", nil
@@ -499,7 +533,7 @@ func (h *Huobi) process1stTx(id common.ActivityID, tx1Hash string, assetID uint6
 
 		var exhSymbol string
 		for _, exchg := range asset.Exchanges {
-			if exchg.ExchangeID == uint64(common.Huobi) {
+			if exchg.ExchangeID == uint64(h.id) {
 				exhSymbol = exchg.Symbol
 			}
 		}
@@ -534,7 +568,7 @@ func (h *Huobi) process1stTx(id common.ActivityID, tx1Hash string, assetID uint6
 }
 
 // DepositStatus return status of a deposit
-func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID uint64, sentAmount float64, timepoint uint64) (string, error) {
+func (h *Huobi) DepositStatus(ctx context.Context, id common.ActivityID, tx1Hash string, assetID uint64, sentAmount float64, timepoint uint64) (string, error) {
 	var data common.TXEntry
 	tx2Entry, found := h.FindTx2(id)
 	//if not found, meaning there is no tx2 yet, process 1st Tx and send 2nd Tx.
@@ -561,7 +595,7 @@ func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID uint
 			h.l.Warnw("Huobi Trying to store intermediate tx to huobi storage, error. Ignore it and try later", "err", uErr)
 			return "", nil
 		}
-		return h.exchangeDepositStatus(id, tx2Entry, assetID, sentAmount)
+		return h.exchangeDepositStatus(ctx, id, tx2Entry, assetID, sentAmount)
 	case common.MiningStatusFailed:
 		data = common.NewTXEntry(
 			tx2Entry.Hash,
@@ -602,7 +636,7 @@ func (h *Huobi) DepositStatus(id common.ActivityID, tx1Hash string, assetID uint
 }
 
 //WithdrawStatus return withdraw status from huobi
-func (h *Huobi) WithdrawStatus(
+func (h *Huobi) WithdrawStatus(ctx context.Context,
 	id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
 	withdrawID, _ := strconv.ParseUint(id, 10, 64)
 	assets, err := h.sr.GetAssets()
@@ -610,7 +644,7 @@ func (h *Huobi) WithdrawStatus(
 		return "", "", fmt.Errorf("huobi Can't get list of assets from setting (%s)", err)
 	}
 	// make sure the size is enough for storing all huobi withdrawal history
-	withdraws, err := h.interf.WithdrawHistory(len(assets) * 2)
+	withdraws, err := h.interf.WithdrawHistory(ctx, len(assets)*2, 0)
 	if err != nil {
 		return "", "", fmt.Errorf("can't get withdraw history from huobi: %s", err.Error())
 	}
@@ -629,14 +663,131 @@ func (h *Huobi) WithdrawStatus(
 	return "", "", errors.New("huobi Withdrawal doesn't exist. This shouldn't happen unless tx returned from withdrawal from huobi and activity ID are not consistently designed")
 }
 
+// huobiTxHash returns the tx hash with 0x prefix, Huobi returns some hashes without it.
+func huobiTxHash(hash string) string {
+	if hash == "" || strings.HasPrefix(hash, "0x") {
+		return hash
+	}
+	return "0x" + hash
+}
+
+// depositsSince returns deposits on Huobi, newest first, paging back from the latest one until a
+// page has a deposit created before fromTime or there is no older deposit.
+func (h *Huobi) depositsSince(ctx context.Context, fromTime uint64) ([]HuobiDeposit, error) {
+	var (
+		deposits []HuobiDeposit
+		from     uint64
+	)
+	for {
+		page, err := h.interf.DepositHistory(ctx, huobiTransferHistorySize, from)
+		if err != nil {
+			return nil, err
+		}
+		deposits = append(deposits, page.Data...)
+		if len(page.Data) < huobiTransferHistorySize {
+			return deposits, nil
+		}
+		// a page is ordered by id descending
+		oldest := page.Data[len(page.Data)-1]
+		if oldest.CreatedAt < fromTime || oldest.ID <= 1 {
+			return deposits, nil
+		}
+		from = oldest.ID - 1
+	}
+}
+
+// withdrawsSince returns withdrawals on Huobi, newest first, paging back from the latest one until
+// a page has a withdrawal created before fromTime or there is no older withdrawal.
+func (h *Huobi) withdrawsSince(ctx context.Context, fromTime uint64) ([]HuobiWithdrawHistory, error) {
+	var (
+		withdraws []HuobiWithdrawHistory
+		from      uint64
+	)
+	for {
+		page, err := h.interf.WithdrawHistory(ctx, huobiTransferHistorySize, from)
+		if err != nil {
+			return nil, err
+		}
+		withdraws = append(withdraws, page.Data...)
+		if len(page.Data) < huobiTransferHistorySize {
+			return withdraws, nil
+		}
+		// a page is ordered by id descending
+		oldest := page.Data[len(page.Data)-1]
+		if oldest.CreatedAt < fromTime || oldest.ID <= 1 {
+			return withdraws, nil
+		}
+		from = oldest.ID - 1
+	}
+}
+
+// TransferHistory returns deposits and withdrawals made on Huobi in the time range.
+func (h *Huobi) TransferHistory(fromTime, toTime uint64) ([]common.ExchangeTransfer, error) {
+	deposits, err := h.depositsSince(context.Background(), fromTime)
+	if err != nil {
+		return nil, err
+	}
+	withdraws, err := h.withdrawsSince(context.Background(), fromTime)
+	if err != nil {
+		return nil, err
+	}
+	inRange := func(timestamp uint64) bool {
+		return timestamp >= fromTime && timestamp <= toTime
+	}
+	var transfers []common.ExchangeTransfer
+	for _, deposit := range deposits {
+		if !inRange(deposit.CreatedAt) {
+			continue
+		}
+		status := common.ExchangeStatusPending
+		switch deposit.State {
+		case "safe", "confirmed":
+			status = common.ExchangeStatusDone
+		case "orphan":
+			status = common.ExchangeStatusFailed
+		}
+		transfers = append(transfers, common.ExchangeTransfer{
+			Type:      common.TransferTypeDeposit,
+			ID:        strconv.FormatUint(deposit.ID, 10),
+			TxHash:    huobiTxHash(deposit.TxHash),
+			Asset:     strings.ToUpper(deposit.Currency),
+			Amount:    deposit.Amount,
+			Status:    status,
+			Timestamp: deposit.CreatedAt,
+		})
+	}
+	for _, withdraw := range withdraws {
+		if !inRange(withdraw.CreatedAt) {
+			continue
+		}
+		status := common.ExchangeStatusPending
+		switch withdraw.State {
+		case "confirmed":
+			status = common.ExchangeStatusDone
+		case "canceled", "reject", "wallet-reject", "confirm-error", "repealed":
+			status = common.ExchangeStatusFailed
+		}
+		transfers = append(transfers, common.ExchangeTransfer{
+			Type:      common.TransferTypeWithdraw,
+			ID:        strconv.FormatUint(withdraw.ID, 10),
+			TxHash:    huobiTxHash(withdraw.TxHash),
+			Asset:     strings.ToUpper(withdraw.Currency),
+			Amount:    withdraw.Amount,
+			Status:    status,
+			Timestamp: withdraw.CreatedAt,
+		})
+	}
+	return transfers, nil
+}
+
 // OrderStatus return order status from Huobi
-func (h *Huobi) OrderStatus(id string, base, quote string) (string, error) {
+func (h *Huobi) OrderStatus(ctx context.Context, id string, base, quote string) (string, error) {
 	orderID, err := strconv.ParseUint(id, 10, 64)
 	if err != nil {
 		return "", err
 	}
 	symbol := base + quote
-	order, err := h.interf.OrderStatus(symbol, orderID)
+	order, err := h.interf.OrderStatus(ctx, symbol, orderID)
 	if err != nil {
 		return "", err
 	}
@@ -646,13 +797,49 @@ func (h *Huobi) OrderStatus(id string, base, quote string) (string, error) {
 	return common.ExchangeStatusDone, nil
 }
 
+// OpenOrders returns orders which are still open on Huobi for all trading pairs.
+func (h *Huobi) OpenOrders() ([]common.OpenOrder, error) {
+	pairs, err := h.TokenPairs()
+	if err != nil {
+		return nil, err
+	}
+	var result []common.OpenOrder
+	for _, pair := range pairs {
+		orders, err := h.interf.OpenOrdersForOnePair(pair)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open orders of %s%s, err=%v", pair.BaseSymbol, pair.QuoteSymbol, err)
+		}
+		for _, order := range orders.Data {
+			price, _ := strconv.ParseFloat(order.Price, 64)
+			amount, _ := strconv.ParseFloat(order.OrigQty, 64)
+			executed, _ := strconv.ParseFloat(order.ExecutedQty, 64)
+			// order type is in format of side-type, e.g buy-limit
+			side := strings.SplitN(order.Type, "-", 2)[0]
+			result = append(result, common.OpenOrder{
+				ID:             strconv.FormatUint(order.OrderID, 10),
+				Exchange:       h.id,
+				PairID:         pair.ID,
+				Base:           pair.BaseSymbol,
+				Quote:          pair.QuoteSymbol,
+				Side:           side,
+				Price:          price,
+				Amount:         amount,
+				ExecutedAmount: executed,
+				Time:           order.CreatedAt,
+			})
+		}
+	}
+	return result, nil
+}
+
 // ID return exchange ID
 func (h *Huobi) ID() common.ExchangeID {
-	return common.Huobi
+	return h.id
 }
 
 //NewHuobi creates new Huobi exchange instance
 func NewHuobi(
+	id common.ExchangeID,
 	interf HuobiInterface,
 	blockchain *blockchain.BaseBlockchain,
 	signer blockchain.Signer,
@@ -661,12 +848,13 @@ func NewHuobi(
 	sr storage.SettingReader,
 ) (*Huobi, error) {
 
-	bc, err := huobiblockchain.NewBlockchain(blockchain, signer, nonce)
+	bc, err := huobiblockchain.NewBlockchain(blockchain, id, signer, nonce)
 	if err != nil {
 		return nil, err
 	}
 
 	huobiObj := Huobi{
+		id:         id,
 		interf:     interf,
 		blockchain: bc,
 		storage:    storage,
@@ -676,7 +864,5 @@ func NewHuobi(
 		},
 		l: zap.S(),
 	}
-	huobiServer := huobihttp.NewHuobiHTTPServer(&huobiObj)
-	go huobiServer.Run()
 	return &huobiObj, nil
 }
@@ -1,8 +1,10 @@
 package blockchain
 
 import (
+	"fmt"
 	"math/big"
 
+	"github.com/KyberNetwork/reserve-data/common"
 	"github.com/KyberNetwork/reserve-data/common/blockchain"
 	ethereum "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -10,16 +12,26 @@ import (
 
 const HuobiOP string = "huobi_op"
 
+// OperatorName returns the name of the intermediator operator of the Huobi account, the built-in
+// account uses HuobiOP.
+func OperatorName(id common.ExchangeID) string {
+	if id == common.Huobi {
+		return HuobiOP
+	}
+	return fmt.Sprintf("%s_%d", HuobiOP, id)
+}
+
 type Blockchain struct {
 	*blockchain.BaseBlockchain
+	operator string
 }
 
 func (b *Blockchain) GetIntermediatorAddr() ethereum.Address {
-	return b.OperatorAddresses()[HuobiOP]
+	return b.OperatorAddresses()[b.operator]
 }
 
 func (b *Blockchain) SendTokenFromAccountToExchange(amount *big.Int, exchangeAddress ethereum.Address, tokenAddress ethereum.Address) (*types.Transaction, error) {
-	opts, err := b.GetTxOpts(HuobiOP, nil, nil, nil)
+	opts, err := b.GetTxOpts(b.operator, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -27,11 +39,11 @@ func (b *Blockchain) SendTokenFromAccountToExchange(amount *big.Int, exchangeAdd
 	if err != nil {
 		return nil, err
 	}
-	return b.SignAndBroadcast(tx, HuobiOP)
+	return b.SignAndBroadcast(tx, b.operator)
 }
 
 func (b *Blockchain) SendETHFromAccountToExchange(amount *big.Int, exchangeAddress ethereum.Address) (*types.Transaction, error) {
-	opts, err := b.GetTxOpts(HuobiOP, nil, nil, amount)
+	opts, err := b.GetTxOpts(b.operator, nil, nil, amount)
 	if err != nil {
 		return nil, err
 	}
@@ -39,16 +51,18 @@ func (b *Blockchain) SendETHFromAccountToExchange(amount *big.Int, exchangeAddre
 	if err != nil {
 		return nil, err
 	}
-	return b.SignAndBroadcast(tx, HuobiOP)
+	return b.SignAndBroadcast(tx, b.operator)
 }
 
+// NewBlockchain registers the intermediator of the Huobi account as operator of base.
 func NewBlockchain(
-	base *blockchain.BaseBlockchain,
+	base *blockchain.BaseBlockchain, id common.ExchangeID,
 	signer blockchain.Signer, nonce blockchain.NonceCorpus) (*Blockchain, error) {
-
-	base.MustRegisterOperator(HuobiOP, blockchain.NewOperator(signer, nonce))
+	operator := OperatorName(id)
+	base.MustRegisterOperator(operator, blockchain.NewOperator(signer, nonce))
 
 	return &Blockchain{
 		BaseBlockchain: base,
+		operator:       operator,
 	}, nil
 }
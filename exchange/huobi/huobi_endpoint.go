@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
@@ -23,13 +25,28 @@ import (
 
 //Endpoint endpoint object
 type Endpoint struct {
-	signer Signer
-	interf Interface
-	l      *zap.SugaredLogger
-	client *http.Client
+	exchangeID common.ExchangeID
+	signerMu   sync.RWMutex
+	signer     Signer
+	interf     Interface
+	l          *zap.SugaredLogger
+	client     *http.Client
 }
 
-func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool) {
+// SetSigner replaces the API key of the endpoint, requests in progress keep the old key.
+func (ep *Endpoint) SetSigner(signer Signer) {
+	ep.signerMu.Lock()
+	defer ep.signerMu.Unlock()
+	ep.signer = signer
+}
+
+func (ep *Endpoint) getSigner() Signer {
+	ep.signerMu.RLock()
+	defer ep.signerMu.RUnlock()
+	return ep.signer
+}
+
+func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool, signer Signer) {
 	if req.Method == "POST" || req.Method == "PUT" || req.Method == "DELETE" {
 		req.Header.Add("Content-Type", "application/json")
 	} else {
@@ -44,7 +61,7 @@ func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool) {
 		hostname := req.URL.Hostname()
 		path := req.URL.Path
 		payload := strings.Join([]string{method, hostname, path, auth}, "\n")
-		sig.Set("Signature", ep.signer.Sign(payload))
+		sig.Set("Signature", signer.Sign(payload))
 		req.URL.RawQuery = q.Encode() + "&" + sig.Encode()
 	}
 }
@@ -53,12 +70,19 @@ func (ep *Endpoint) fillRequest(req *http.Request, signNeeded bool) {
 func (ep *Endpoint) GetResponse(
 	method string, reqURL string,
 	params map[string]string, signNeeded bool) ([]byte, error) {
+	return ep.GetResponseWithContext(context.Background(), method, reqURL, params, signNeeded)
+}
+
+// GetResponseWithContext is GetResponse whose request is cancelled once ctx is done.
+func (ep *Endpoint) GetResponseWithContext(ctx context.Context,
+	method string, reqURL string,
+	params map[string]string, signNeeded bool) ([]byte, error) {
 
 	reqBody, err := json.Marshal(params)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(method, reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,11 +92,13 @@ func (ep *Endpoint) GetResponse(
 	req.Header.Add("Accept", "application/json")
 
 	q := req.URL.Query()
+	// the key and the signature must be of the same signer while it is replaced
+	signer := ep.getSigner()
 	if signNeeded {
 		timestamp := time.Now().UTC().Format("2006-01-02T15:04:05")
 		params["SignatureMethod"] = "HmacSHA256"
 		params["SignatureVersion"] = "2"
-		params["AccessKeyId"] = ep.signer.GetKey()
+		params["AccessKeyId"] = signer.GetKey()
 		params["Timestamp"] = timestamp
 		params["op"] = "auth"
 	}
@@ -85,7 +111,7 @@ func (ep *Endpoint) GetResponse(
 		q.Add(k, params[k])
 	}
 	req.URL.RawQuery = q.Encode()
-	ep.fillRequest(req, signNeeded)
+	ep.fillRequest(req, signNeeded, signer)
 	var respBody []byte
 	resp, err := ep.client.Do(req)
 	if err != nil {
@@ -108,9 +134,9 @@ func (ep *Endpoint) GetResponse(
 }
 
 //GetAccounts Get account list for later use
-func (ep *Endpoint) GetAccounts() (exchange.HuobiAccounts, error) {
+func (ep *Endpoint) GetAccounts(ctx context.Context) (exchange.HuobiAccounts, error) {
 	result := exchange.HuobiAccounts{}
-	resp, err := ep.GetResponse(
+	resp, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.PublicEndpoint()+"/v1/account/accounts",
 		map[string]string{},
@@ -147,7 +173,7 @@ func (ep *Endpoint) Trade(tradeType string, pair commonv3.TradingPairSymbols, ra
 	result := exchange.HuobiTrade{}
 	symbol := strings.ToLower(pair.BaseSymbol) + strings.ToLower(pair.QuoteSymbol)
 	orderType := tradeType + "-limit"
-	accounts, err := ep.GetAccounts()
+	accounts, err := ep.GetAccounts(context.Background())
 	if err != nil {
 		return result, err
 	}
@@ -180,17 +206,22 @@ func (ep *Endpoint) Trade(tradeType string, pair commonv3.TradingPairSymbols, ra
 	return result, nil
 }
 
-//WithdrawHistory return withdraw history from huobi
-func (ep *Endpoint) WithdrawHistory(size int) (exchange.HuobiWithdraws, error) {
+//WithdrawHistory return withdraw history from huobi, newest first from the withdrawal with id from,
+// zero is the latest one.
+func (ep *Endpoint) WithdrawHistory(ctx context.Context, size int, from uint64) (exchange.HuobiWithdraws, error) {
 	result := exchange.HuobiWithdraws{}
-	respBody, err := ep.GetResponse(
+	params := map[string]string{
+		"size":   strconv.Itoa(size),
+		"type":   "withdraw",
+		"direct": "next",
+	}
+	if from != 0 {
+		params["from"] = strconv.FormatUint(from, 10)
+	}
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/v1/query/deposit-withdraw",
-		map[string]string{
-			"size":   strconv.Itoa(size),
-			"type":   "withdraw",
-			"direct": "next",
-		},
+		params,
 		true,
 	)
 	if err == nil {
@@ -204,17 +235,22 @@ func (ep *Endpoint) WithdrawHistory(size int) (exchange.HuobiWithdraws, error) {
 	return result, err
 }
 
-//DepositHistory get deposit history from huobi
-func (ep *Endpoint) DepositHistory(size int) (exchange.HuobiDeposits, error) {
+//DepositHistory get deposit history from huobi, newest first from the deposit with id from,
+// zero is the latest one.
+func (ep *Endpoint) DepositHistory(ctx context.Context, size int, from uint64) (exchange.HuobiDeposits, error) {
 	result := exchange.HuobiDeposits{}
-	respBody, err := ep.GetResponse(
+	params := map[string]string{
+		"size":   strconv.Itoa(size),
+		"type":   "deposit",
+		"direct": "next",
+	}
+	if from != 0 {
+		params["from"] = strconv.FormatUint(from, 10)
+	}
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/v1/query/deposit-withdraw",
-		map[string]string{
-			"size":   strconv.Itoa(size),
-			"type":   "deposit",
-			"direct": "next",
-		},
+		params,
 		true,
 	)
 	if err == nil {
@@ -251,9 +287,9 @@ func (ep *Endpoint) CancelOrder(symbol string, id uint64) (exchange.HuobiCancel,
 	return result, err
 }
 
-func (ep *Endpoint) OrderStatus(symbol string, id uint64) (exchange.HuobiOrder, error) {
+func (ep *Endpoint) OrderStatus(ctx context.Context, symbol string, id uint64) (exchange.HuobiOrder, error) {
 	result := exchange.HuobiOrder{}
-	respBody, err := ep.GetResponse(
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/v1/order/orders/"+strconv.FormatUint(id, 10),
 		map[string]string{
@@ -273,10 +309,41 @@ func (ep *Endpoint) OrderStatus(symbol string, id uint64) (exchange.HuobiOrder,
 	return result, err
 }
 
+// OpenOrdersForOnePair returns orders which are still open on Huobi for a trading pair.
+func (ep *Endpoint) OpenOrdersForOnePair(pair commonv3.TradingPairSymbols) (exchange.HuobiOpenOrders, error) {
+	result := exchange.HuobiOpenOrders{}
+	accounts, err := ep.GetAccounts(context.Background())
+	if err != nil {
+		return result, err
+	}
+	if len(accounts.Data) == 0 {
+		return result, errors.New("cannot get Huobi account")
+	}
+	respBody, err := ep.GetResponse(
+		"GET",
+		ep.interf.AuthenticatedEndpoint()+"/v1/order/openOrders",
+		map[string]string{
+			"account-id": strconv.FormatUint(accounts.Data[0].ID, 10),
+			"symbol":     strings.ToLower(pair.BaseSymbol + pair.QuoteSymbol),
+		},
+		true,
+	)
+	if err != nil {
+		return result, err
+	}
+	if err = json.Unmarshal(respBody, &result); err != nil {
+		return result, err
+	}
+	if result.Status != "ok" {
+		err = fmt.Errorf("getting open orders from Huobi failed: %s", result.Reason)
+	}
+	return result, err
+}
+
 func (ep *Endpoint) Withdraw(asset commonv3.Asset, amount *big.Int, address ethereum.Address) (string, error) {
 	var symbol string
 	for _, exchg := range asset.Exchanges {
-		if exchg.ExchangeID == uint64(common.Huobi) {
+		if exchg.ExchangeID == uint64(ep.exchangeID) {
 			symbol = exchg.Symbol
 		}
 	}
@@ -307,16 +374,16 @@ func (ep *Endpoint) Withdraw(asset commonv3.Asset, amount *big.Int, address ethe
 
 }
 
-func (ep *Endpoint) GetInfo() (exchange.HuobiInfo, error) {
+func (ep *Endpoint) GetInfo(ctx context.Context) (exchange.HuobiInfo, error) {
 	result := exchange.HuobiInfo{}
-	accounts, err := ep.GetAccounts()
+	accounts, err := ep.GetAccounts(ctx)
 	if err != nil {
 		return result, err
 	}
 	if len(accounts.Data) == 0 {
 		return result, errors.New("cannot get Huobi account")
 	}
-	respBody, err := ep.GetResponse(
+	respBody, err := ep.GetResponseWithContext(ctx,
 		"GET",
 		ep.interf.AuthenticatedEndpoint()+"/v1/account/accounts/"+strconv.FormatUint(accounts.Data[0].ID, 10)+"/balance",
 		map[string]string{},
@@ -334,10 +401,9 @@ func (ep *Endpoint) GetAccountTradeHistory(
 	symbol := strings.ToUpper(fmt.Sprintf("%s%s", baseSymbol, quoteSymbol))
 	respBody, err := ep.GetResponse(
 		"GET",
-		ep.interf.AuthenticatedEndpoint()+"/v1/order/orders",
+		ep.interf.AuthenticatedEndpoint()+"/v1/order/matchresults",
 		map[string]string{
 			"symbol": strings.ToLower(symbol),
-			"states": "filled",
 		},
 		true,
 	)
@@ -384,6 +450,6 @@ func (ep *Endpoint) GetExchangeInfo() (exchange.HuobiExchangeInfo, error) {
 }
 
 //NewHuobiEndpoint return new endpoint instance
-func NewHuobiEndpoint(signer Signer, interf Interface, client *http.Client) *Endpoint {
-	return &Endpoint{signer: signer, interf: interf, l: zap.S(), client: client}
+func NewHuobiEndpoint(exchangeID common.ExchangeID, signer Signer, interf Interface, client *http.Client) *Endpoint {
+	return &Endpoint{exchangeID: exchangeID, signer: signer, interf: interf, l: zap.S(), client: client}
 }
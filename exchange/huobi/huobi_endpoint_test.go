@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/KyberNetwork/reserve-data/common"
 )
 
 const (
@@ -19,7 +21,7 @@ func TestGetDepositAddress(t *testing.T) {
 	secret := "" // enter only once for test
 	signer := NewSigner(key, secret)
 	interf := NewRealInterface(huobiEndpoint)
-	ep := NewHuobiEndpoint(signer, interf, &http.Client{Timeout: time.Second * 30})
+	ep := NewHuobiEndpoint(common.Huobi, signer, interf, &http.Client{Timeout: time.Second * 30})
 
 	depositAddress, err := ep.GetDepositAddress("ETH")
 	assert.NoError(t, err)
@@ -37,6 +37,29 @@ const schema = `
 		    type			TEXT NOT NULL,
 		    time			BIGINT
 		);
+	ALTER TABLE "huobi_trade_history" ADD COLUMN IF NOT EXISTS order_id TEXT NOT NULL DEFAULT '';
+	ALTER TABLE "huobi_trade_history" ADD COLUMN IF NOT EXISTS fee FLOAT NOT NULL DEFAULT 0;
+	ALTER TABLE "huobi_trade_history" ADD COLUMN IF NOT EXISTS fee_asset TEXT NOT NULL DEFAULT '';
+	ALTER TABLE "huobi_trade_history" ADD COLUMN IF NOT EXISTS maker BOOL NOT NULL DEFAULT FALSE;
+
+	-- records stored before several huobi accounts were supported belong to the built-in account.
+	ALTER TABLE "huobi_intermediate_tx" ADD COLUMN IF NOT EXISTS exchange_id BIGINT NOT NULL DEFAULT 2;
+	ALTER TABLE "huobi_pending_intermediate_tx" ADD COLUMN IF NOT EXISTS exchange_id BIGINT NOT NULL DEFAULT 2;
+	ALTER TABLE "huobi_trade_history" ADD COLUMN IF NOT EXISTS exchange_id BIGINT NOT NULL DEFAULT 2;
+
+	-- trade_id held order ids before fills were fetched, they are moved to order_id and fills
+	-- stored on every fetch are removed before trade_id is made unique per pair.
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT NULL FROM pg_indexes WHERE indexname = 'huobi_trade_history_pair_id_trade_id_key') THEN
+			UPDATE "huobi_trade_history" SET order_id = trade_id, trade_id = 'order-' || trade_id
+				WHERE order_id = '';
+			DELETE FROM "huobi_trade_history" a USING "huobi_trade_history" b
+				WHERE a.pair_id = b.pair_id AND a.trade_id = b.trade_id AND a.id > b.id;
+			CREATE UNIQUE INDEX "huobi_trade_history_pair_id_trade_id_key" ON "huobi_trade_history" (pair_id, trade_id);
+		END IF;
+	END
+	$$;
 	
 	CREATE OR REPLACE FUNCTION new_intermediate_tx(_timepoint huobi_intermediate_tx.timepoint%TYPE,
 													_eid huobi_intermediate_tx.eid%TYPE,
@@ -44,15 +67,16 @@ const schema = `
 	RETURNS VOID
 	AS $$
 	DECLARE
+		_exchange_id huobi_pending_intermediate_tx.exchange_id%TYPE;
 	BEGIN
-		PERFORM NULL FROM huobi_pending_intermediate_tx 
+		SELECT exchange_id INTO _exchange_id FROM huobi_pending_intermediate_tx 
 			WHERE timepoint= _timepoint AND eid =_eid;
 		IF NOT FOUND THEN 
 			RAISE EXCEPTION 'pending tx not found' USING ERRCODE = 'assert_failure';
 		END IF ;
 		
-		INSERT INTO huobi_intermediate_tx(timepoint, eid, data) 
-			VALUES (_timepoint, _eid, _data);
+		INSERT INTO huobi_intermediate_tx(timepoint, eid, data, exchange_id) 
+			VALUES (_timepoint, _eid, _data, _exchange_id);
 		
 		DELETE FROM huobi_pending_intermediate_tx 
 	    	WHERE timepoint = _timepoint AND eid = _eid;
@@ -62,8 +86,9 @@ const schema = `
 
 // postgresStorage implements huobi storage in postgres
 type postgresStorage struct {
-	db    *sqlx.DB
-	stmts preparedStmt
+	db         *sqlx.DB
+	exchangeID common.ExchangeID
+	stmts      preparedStmt
 }
 
 type preparedStmt struct {
@@ -77,13 +102,15 @@ type preparedStmt struct {
 	getIntermediateTxStmt   *sqlx.Stmt
 }
 
-// NewPostgresStorage creates a new obj exchange.HuobiStorage by db engine=postgres
-func NewPostgresStorage(db *sqlx.DB) (exchange.HuobiStorage, error) {
+// NewPostgresStorage creates a new obj exchange.HuobiStorage by db engine=postgres, the records
+// are stored for the huobi account exchangeID.
+func NewPostgresStorage(db *sqlx.DB, exchangeID common.ExchangeID) (exchange.HuobiStorage, error) {
 	if _, err := db.Exec(schema); err != nil {
 		return nil, fmt.Errorf("failed to intialize database schema err=%s", err.Error())
 	}
 	storage := &postgresStorage{
-		db: db,
+		db:         db,
+		exchangeID: exchangeID,
 	}
 	err := storage.initStmts()
 	return storage, err
@@ -93,18 +120,19 @@ func (s *postgresStorage) initStmts() error {
 	var err error
 	// history stmts
 	s.stmts.storeHistoryStmt, err = s.db.PrepareNamed(`INSERT INTO "huobi_trade_history"
-		(pair_id, trade_id, price, qty, type, time)
-		VALUES(:pair_id, :trade_id, :price, :qty, :type, :time)`)
+		(pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker, exchange_id)
+		VALUES(:pair_id, :trade_id, :price, :qty, :type, :time, :order_id, :fee, :fee_asset, :maker, :exchange_id)
+		ON CONFLICT (pair_id, trade_id) DO NOTHING`)
 	if err != nil {
 		return err
 	}
-	s.stmts.getHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time 
+	s.stmts.getHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker 
 		FROM "huobi_trade_history"
-		WHERE time >= $1 AND time <= $2`)
+		WHERE time >= $1 AND time <= $2 AND exchange_id = $3`)
 	if err != nil {
 		return err
 	}
-	s.stmts.getLastIDHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time FROM "huobi_trade_history"
+	s.stmts.getLastIDHistoryStmt, err = s.db.Preparex(`SELECT pair_id, trade_id, price, qty, type, time, order_id, fee, fee_asset, maker FROM "huobi_trade_history"
 											WHERE pair_id = $1 
 											ORDER BY time DESC, trade_id DESC;`)
 	if err != nil {
@@ -112,13 +140,13 @@ func (s *postgresStorage) initStmts() error {
 	}
 	// pending stmts
 	s.stmts.storePendingTxStmt, err = s.db.Preparex(`INSERT INTO "huobi_pending_intermediate_tx"
-		(timepoint, eid, data)
-		VALUES ($1, $2, $3);`)
+		(timepoint, eid, data, exchange_id)
+		VALUES ($1, $2, $3, $4);`)
 	if err != nil {
 		return err
 	}
 	s.stmts.getPendingTxStmt, err = s.db.Preparex(`SELECT timepoint, eid, data 
-		FROM "huobi_pending_intermediate_tx";`)
+		FROM "huobi_pending_intermediate_tx" WHERE exchange_id = $1;`)
 	if err != nil {
 		return err
 	}
@@ -142,6 +170,13 @@ type exchangeTradeHistoryDB struct {
 	Qty     float64 `db:"qty"`
 	Type    string  `db:"type"`
 	Time    uint64  `db:"time"`
+
+	OrderID  string  `db:"order_id"`
+	Fee      float64 `db:"fee"`
+	FeeAsset string  `db:"fee_asset"`
+	Maker    bool    `db:"maker"`
+
+	ExchangeID uint64 `db:"exchange_id"`
 }
 
 type TxDB struct {
@@ -150,7 +185,8 @@ type TxDB struct {
 	Data      []byte `db:"data"`
 }
 
-// StoreTradeHistory implements exchange.HuobiStorage and store trade history
+// StoreTradeHistory implements exchange.HuobiStorage and store trade history, fills which are
+// already stored are skipped as every fetch returns the recent fills.
 func (s *postgresStorage) StoreTradeHistory(data common.ExchangeTradeHistory) error {
 	// TODO: change this code when jmoiron/sqlx releases bulk request feature
 	tx, err := s.db.Beginx()
@@ -168,6 +204,13 @@ func (s *postgresStorage) StoreTradeHistory(data common.ExchangeTradeHistory) er
 				Qty:     history.Qty,
 				Type:    history.Type,
 				Time:    history.Timestamp,
+
+				OrderID:  history.OrderID,
+				Fee:      history.Fee,
+				FeeAsset: history.FeeAsset,
+				Maker:    history.Maker,
+
+				ExchangeID: uint64(s.exchangeID),
 			})
 			if err != nil {
 				return err
@@ -184,7 +227,7 @@ func (s *postgresStorage) StoreTradeHistory(data common.ExchangeTradeHistory) er
 func (s *postgresStorage) GetTradeHistory(fromTime, toTime uint64) (common.ExchangeTradeHistory, error) {
 	var result = make(common.ExchangeTradeHistory)
 	var records []exchangeTradeHistoryDB
-	err := s.stmts.getHistoryStmt.Select(&records, fromTime, toTime)
+	err := s.stmts.getHistoryStmt.Select(&records, fromTime, toTime, uint64(s.exchangeID))
 	if err != nil {
 		return result, err
 	}
@@ -196,6 +239,10 @@ func (s *postgresStorage) GetTradeHistory(fromTime, toTime uint64) (common.Excha
 			Qty:       r.Qty,
 			Type:      r.Type,
 			Timestamp: r.Time,
+			OrderID:   r.OrderID,
+			Fee:       r.Fee,
+			FeeAsset:  r.FeeAsset,
+			Maker:     r.Maker,
 		})
 		result[r.PairID] = tradeHistory
 	}
@@ -232,7 +279,7 @@ func (s *postgresStorage) StorePendingIntermediateTx(id common.ActivityID, data
 	if err != nil {
 		return err
 	}
-	_, err = s.stmts.storePendingTxStmt.Exec(id.Timepoint, id.EID, dataJSON)
+	_, err = s.stmts.storePendingTxStmt.Exec(id.Timepoint, id.EID, dataJSON, uint64(s.exchangeID))
 	return err
 }
 
@@ -252,7 +299,7 @@ func (s *postgresStorage) GetIntermedatorTx(id common.ActivityID) (common.TXEntr
 func (s *postgresStorage) GetPendingIntermediateTXs() (map[common.ActivityID]common.TXEntry, error) {
 	var records []TxDB
 	var result = make(map[common.ActivityID]common.TXEntry)
-	err := s.stmts.getPendingTxStmt.Select(&records)
+	err := s.stmts.getPendingTxStmt.Select(&records, uint64(s.exchangeID))
 	if err != nil {
 		return nil, err
 	}
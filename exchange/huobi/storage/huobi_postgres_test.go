@@ -20,7 +20,7 @@ func TestPostgresStorage_TradeHistory(t *testing.T) {
 	defer func() {
 		assert.NoError(t, tearDown())
 	}()
-	storage, err = NewPostgresStorage(db)
+	storage, err = NewPostgresStorage(db, common.Huobi)
 	require.NoError(t, err)
 	//Mock exchange history
 	exchangeTradeHistory := common.ExchangeTradeHistory{
@@ -35,7 +35,11 @@ func TestPostgresStorage_TradeHistory(t *testing.T) {
 		},
 	}
 
-	// store trade history
+	// store trade history, the same fills are returned by every fetch
+	err = storage.StoreTradeHistory(exchangeTradeHistory)
+	if err != nil {
+		t.Fatal(err)
+	}
 	err = storage.StoreTradeHistory(exchangeTradeHistory)
 	if err != nil {
 		t.Fatal(err)
@@ -62,7 +66,7 @@ func TestPostgresStorage_DepositActivity(t *testing.T) {
 	defer func() {
 		assert.NoError(t, tearDown())
 	}()
-	storage, err = NewPostgresStorage(db)
+	storage, err = NewPostgresStorage(db, common.Huobi)
 	require.NoError(t, err)
 
 	// Mock intermediate deposit transaction and transaction id
@@ -115,6 +119,13 @@ func TestPostgresStorage_DepositActivity(t *testing.T) {
 		t.Fatalf("Huobi store pending wrong pending tx. Expected %+v, got %+v", txEntry, pendingTx)
 	}
 
+	// pending txs of the other huobi accounts are not returned
+	otherStorage, err := NewPostgresStorage(db, common.ExchangeID(10))
+	require.NoError(t, err)
+	pendingIntermediateTxs, err = otherStorage.GetPendingIntermediateTXs()
+	require.NoError(t, err)
+	assert.Len(t, pendingIntermediateTxs, 0)
+
 	// stored intermediate tx
 	err = storage.StoreIntermediateTx(txID, txEntry)
 	if err != nil {
@@ -55,13 +55,14 @@ type HuobiDeposits struct {
 }
 
 type HuobiDeposit struct {
-	ID       uint64  `json:"id"`
-	TxID     uint64  `json:"transaction-id"`
-	Currency string  `json:"currency"`
-	Amount   float64 `json:"amount"`
-	State    string  `json:"state"`
-	TxHash   string  `json:"tx-hash"`
-	Address  string  `json:"address"`
+	ID        uint64  `json:"id"`
+	TxID      uint64  `json:"transaction-id"`
+	Currency  string  `json:"currency"`
+	Amount    float64 `json:"amount"`
+	State     string  `json:"state"`
+	TxHash    string  `json:"tx-hash"`
+	Address   string  `json:"address"`
+	CreatedAt uint64  `json:"created-at"`
 }
 
 type HuobiWithdraws struct {
@@ -71,13 +72,14 @@ type HuobiWithdraws struct {
 }
 
 type HuobiWithdrawHistory struct {
-	ID       uint64  `json:"id"`
-	TxID     uint64  `json:"transaction-id"`
-	Currency string  `json:"currency"`
-	Amount   float64 `json:"amount"`
-	State    string  `json:"state"`
-	TxHash   string  `json:"tx-hash"`
-	Address  string  `json:"address"`
+	ID        uint64  `json:"id"`
+	TxID      uint64  `json:"transaction-id"`
+	Currency  string  `json:"currency"`
+	Amount    float64 `json:"amount"`
+	State     string  `json:"state"`
+	TxHash    string  `json:"tx-hash"`
+	Address   string  `json:"address"`
+	CreatedAt uint64  `json:"created-at"`
 }
 
 type HuobiWithdraw struct {
@@ -102,6 +104,23 @@ type HuobiOrder struct {
 	Reason string `json:"err-msg"`
 }
 
+// HuobiOpenOrders is the response of Huobi open orders API
+type HuobiOpenOrders struct {
+	Status string `json:"status"`
+	Data   []struct {
+		OrderID     uint64 `json:"id"`
+		Symbol      string `json:"symbol"`
+		AccountID   uint64 `json:"account-id"`
+		OrigQty     string `json:"amount"`
+		Price       string `json:"price"`
+		CreatedAt   uint64 `json:"created-at"`
+		Type        string `json:"type"`
+		State       string `json:"state"`
+		ExecutedQty string `json:"filled-amount"`
+	} `json:"data"`
+	Reason string `json:"err-msg"`
+}
+
 // HuobiDepositAddress return deposit address
 type HuobiDepositAddress struct {
 	Code    int    `json:"code"`
@@ -125,15 +144,19 @@ type HuobiAccounts struct {
 	Reason string `json:"err-msg"`
 }
 
+// HuobiTradeHistory is the response of match results (fills) of account orders
 type HuobiTradeHistory struct {
 	Status string `json:"status"`
 	Data   []struct {
-		ID         uint64 `json:"id"`
-		Symbol     string `json:"symbol"`
-		Amount     string `json:"amount"`
-		Price      string `json:"price"`
-		Timestamp  uint64 `json:"created-at"`
-		Type       string `json:"type"`
-		FinishedAt uint64 `json:"finished-at"`
+		ID          uint64 `json:"id"`
+		OrderID     uint64 `json:"order-id"`
+		Symbol      string `json:"symbol"`
+		Amount      string `json:"filled-amount"`
+		Price       string `json:"price"`
+		Timestamp   uint64 `json:"created-at"`
+		Type        string `json:"type"`
+		Fee         string `json:"filled-fees"`
+		FeeCurrency string `json:"fee-currency"`
+		Role        string `json:"role"`
 	} `json:"data"`
 }
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"math/big"
 
 	ethereum "github.com/ethereum/go-ethereum/common"
@@ -12,7 +13,7 @@ import (
 type HuobiInterface interface {
 	GetDepthOnePair(baseID, quoteID string) (HuobiDepth, error)
 
-	GetInfo() (HuobiInfo, error)
+	GetInfo(ctx context.Context) (HuobiInfo, error)
 
 	GetExchangeInfo() (HuobiExchangeInfo, error)
 
@@ -32,9 +33,11 @@ type HuobiInterface interface {
 
 	CancelOrder(symbol string, id uint64) (HuobiCancel, error)
 
-	DepositHistory(size int) (HuobiDeposits, error)
+	DepositHistory(ctx context.Context, size int, from uint64) (HuobiDeposits, error)
 
-	WithdrawHistory(size int) (HuobiWithdraws, error)
+	WithdrawHistory(ctx context.Context, size int, from uint64) (HuobiWithdraws, error)
 
-	OrderStatus(symbol string, id uint64) (HuobiOrder, error)
+	OrderStatus(ctx context.Context, symbol string, id uint64) (HuobiOrder, error)
+
+	OpenOrdersForOnePair(pair commonv3.TradingPairSymbols) (HuobiOpenOrders, error)
 }
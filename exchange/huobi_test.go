@@ -0,0 +1,200 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+	"github.com/KyberNetwork/reserve-data/reservesetting/storage"
+)
+
+type huobiTestInterface struct {
+	HuobiInterface
+	// deposits and withdraws are ordered by id descending as Huobi returns them
+	deposits  []HuobiDeposit
+	withdraws []HuobiWithdrawHistory
+	requests  int
+}
+
+func (hi *huobiTestInterface) GetDepositAddress(token string) (HuobiDepositAddress, error) {
+	return HuobiDepositAddress{}, errors.New("live deposit address is not available")
+}
+
+func (hi *huobiTestInterface) DepositHistory(ctx context.Context, size int, from uint64) (HuobiDeposits, error) {
+	hi.requests++
+	var page []HuobiDeposit
+	for _, deposit := range hi.deposits {
+		if (from == 0 || deposit.ID <= from) && len(page) < size {
+			page = append(page, deposit)
+		}
+	}
+	return HuobiDeposits{Status: "ok", Data: page}, nil
+}
+
+func (hi *huobiTestInterface) WithdrawHistory(ctx context.Context, size int, from uint64) (HuobiWithdraws, error) {
+	hi.requests++
+	var page []HuobiWithdrawHistory
+	for _, withdraw := range hi.withdraws {
+		if (from == 0 || withdraw.ID <= from) && len(page) < size {
+			page = append(page, withdraw)
+		}
+	}
+	return HuobiWithdraws{Status: "ok", Data: page}, nil
+}
+
+// huobiTestBlockchain records the exchange addresses 2nd transactions are sent to.
+type huobiTestBlockchain struct {
+	statuses map[ethereum.Hash]string
+	sent     []ethereum.Address
+}
+
+func (bc *huobiTestBlockchain) SendETHFromAccountToExchange(amount *big.Int, exchangeAddress ethereum.Address) (*types.Transaction, error) {
+	return bc.SendTokenFromAccountToExchange(amount, exchangeAddress, ethereum.Address{})
+}
+
+func (bc *huobiTestBlockchain) SendTokenFromAccountToExchange(amount *big.Int, exchangeAddress ethereum.Address, tokenAddress ethereum.Address) (*types.Transaction, error) {
+	tx := types.NewTransaction(uint64(len(bc.sent)), exchangeAddress, amount, 100000, big.NewInt(1), nil)
+	bc.sent = append(bc.sent, exchangeAddress)
+	return tx, nil
+}
+
+func (bc *huobiTestBlockchain) TxStatus(hash ethereum.Hash) (string, uint64, error) {
+	return bc.statuses[hash], 0, nil
+}
+
+func (bc *huobiTestBlockchain) GetIntermediatorAddr() ethereum.Address {
+	return ethereum.HexToAddress("0x3333333333333333333333333333333333333333")
+}
+
+type huobiTestStorage struct {
+	HuobiStorage
+	pending map[common.ActivityID]common.TXEntry
+	done    map[common.ActivityID]common.TXEntry
+}
+
+func (s *huobiTestStorage) StoreIntermediateTx(id common.ActivityID, data common.TXEntry) error {
+	s.done[id] = data
+	delete(s.pending, id)
+	return nil
+}
+
+func (s *huobiTestStorage) StorePendingIntermediateTx(id common.ActivityID, data common.TXEntry) error {
+	s.pending[id] = data
+	return nil
+}
+
+func (s *huobiTestStorage) GetIntermedatorTx(id common.ActivityID) (common.TXEntry, error) {
+	tx, ok := s.done[id]
+	if !ok {
+		return common.TXEntry{}, errors.New("not found")
+	}
+	return tx, nil
+}
+
+func (s *huobiTestStorage) GetPendingIntermediateTXs() (map[common.ActivityID]common.TXEntry, error) {
+	return s.pending, nil
+}
+
+type huobiTestSetting struct {
+	storage.SettingReader
+	asset          commonv3.Asset
+	depositAddress ethereum.Address
+}
+
+func (s huobiTestSetting) GetAsset(id uint64) (commonv3.Asset, error) {
+	return s.asset, nil
+}
+
+func (s huobiTestSetting) GetAssets() ([]commonv3.Asset, error) {
+	return []commonv3.Asset{s.asset}, nil
+}
+
+func (s huobiTestSetting) GetDepositAddresses(exchangeID uint64) (map[common.AssetID]ethereum.Address, error) {
+	return map[common.AssetID]ethereum.Address{common.AssetID(s.asset.ID): s.depositAddress}, nil
+}
+
+func TestHuobiTransferDepositStatus(t *testing.T) {
+	var (
+		interf  = &huobiTestInterface{}
+		bc      = &huobiTestBlockchain{statuses: make(map[ethereum.Hash]string)}
+		storage = &huobiTestStorage{
+			pending: make(map[common.ActivityID]common.TXEntry),
+			done:    make(map[common.ActivityID]common.TXEntry),
+		}
+		setting = huobiTestSetting{
+			asset: commonv3.Asset{
+				ID:        2,
+				Symbol:    "KNC",
+				Exchanges: []commonv3.AssetExchange{{ExchangeID: uint64(common.Huobi), Symbol: "KNC"}},
+			},
+			depositAddress: ethereum.HexToAddress("0x4444444444444444444444444444444444444444"),
+		}
+		h = &Huobi{id: common.Huobi, interf: interf, blockchain: bc, storage: storage, sr: setting, l: zap.S()}
+		// a transfer from Binance is withdrawn to the intermediator with tx1
+		id  = common.NewActivityID(1568358532000, "withdrawid")
+		tx1 = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	)
+
+	address, supported := h.Address(setting.asset)
+	require.True(t, supported)
+	assert.Equal(t, bc.GetIntermediatorAddr(), address)
+
+	// the withdrawal is not mined yet
+	status, err := h.DepositStatus(context.Background(), id, tx1, 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+	assert.Empty(t, bc.sent)
+
+	// the intermediator forwards the withdrawal to Huobi once it is mined
+	bc.statuses[ethereum.HexToHash(tx1)] = common.MiningStatusMined
+	status, err = h.DepositStatus(context.Background(), id, tx1, 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+	require.Equal(t, []ethereum.Address{setting.depositAddress}, bc.sent)
+	require.Contains(t, storage.pending, id)
+	tx2 := storage.pending[id].Hash
+
+	// the 2nd tx is mined but Huobi has not confirmed the deposit yet
+	bc.statuses[ethereum.HexToHash(tx2)] = common.MiningStatusMined
+	status, err = h.DepositStatus(context.Background(), id, tx1, 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+
+	interf.deposits = []HuobiDeposit{{Currency: "knc", State: "safe", TxHash: tx2[2:]}}
+	status, err = h.DepositStatus(context.Background(), id, tx1, 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, status)
+	assert.Len(t, bc.sent, 1)
+	assert.Contains(t, storage.done, id)
+}
+
+func TestHuobiTransferHistoryPages(t *testing.T) {
+	interf := &huobiTestInterface{}
+	for id := uint64(1200); id > 0; id-- {
+		interf.deposits = append(interf.deposits, HuobiDeposit{ID: id, Currency: "knc", State: "safe", CreatedAt: id * 1000})
+	}
+	interf.withdraws = []HuobiWithdrawHistory{{ID: 1, Currency: "knc", State: "confirmed", CreatedAt: 700000}}
+	h := &Huobi{id: common.Huobi, interf: interf, l: zap.S()}
+
+	transfers, err := h.TransferHistory(600000, 1200000)
+	require.NoError(t, err)
+	var deposits int
+	for _, transfer := range transfers {
+		if transfer.Type == common.TransferTypeDeposit {
+			deposits++
+		}
+	}
+	// deposits older than the latest page are read, paging stops once the window start is covered
+	assert.Equal(t, 601, deposits)
+	assert.Len(t, transfers, 602)
+	assert.Equal(t, 3, interf.requests)
+}
@@ -0,0 +1,525 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+const (
+	defaultSpread   = 0.002
+	defaultLevels   = 10
+	defaultQuantity = 100
+)
+
+// ErrInjectedFailure is returned by requests rejected by failure injection.
+var ErrInjectedFailure = errors.New("simulated exchange failure")
+
+// settingStorage is the part of setting storage used by simulated exchange.
+type settingStorage interface {
+	GetTradingPairs(exchangeID uint64) ([]commonv3.TradingPairSymbols, error)
+	GetAssets() ([]commonv3.Asset, error)
+}
+
+// accountOrder is an order placed by core.
+type accountOrder struct {
+	*order
+	pair      commonv3.TradingPairSymbols
+	time      uint64
+	cancelled bool
+}
+
+// transfer is a deposit or withdrawal which is done after its ready time.
+type transfer struct {
+	asset   common.AssetID
+	amount  float64
+	tx      string
+	ready   uint64
+	failed  bool
+	settled bool
+}
+
+// Exchange is an in-memory exchange with balances and price-time priority matching engine,
+// order books are filled with synthetic liquidity around a randomly moving mid price.
+type Exchange struct {
+	id  common.ExchangeID
+	cfg common.SimulatedExchangeConfig
+	sr  settingStorage
+	l   *zap.SugaredLogger
+	now func() uint64
+
+	mu          sync.Mutex
+	rand        *rand.Rand
+	books       map[uint64]*orderBook
+	mids        map[uint64]float64
+	orders      map[uint64]*accountOrder
+	lastID      uint64
+	available   map[common.AssetID]float64
+	locked      map[common.AssetID]float64
+	withdrawals map[string]*transfer
+	deposits    map[string]*transfer
+	history     common.ExchangeTradeHistory
+}
+
+// NewExchange creates a simulated exchange with id.
+func NewExchange(id common.ExchangeID, cfg common.SimulatedExchangeConfig, seed int64, sr settingStorage) *Exchange {
+	available := make(map[common.AssetID]float64)
+	for asset, balance := range cfg.Balances {
+		available[asset] = balance
+	}
+	return &Exchange{
+		id:          id,
+		cfg:         cfg,
+		sr:          sr,
+		l:           zap.S(),
+		now:         common.NowInMillis,
+		rand:        rand.New(rand.NewSource(seed + int64(id))),
+		books:       make(map[uint64]*orderBook),
+		mids:        make(map[uint64]float64),
+		orders:      make(map[uint64]*accountOrder),
+		available:   available,
+		locked:      make(map[common.AssetID]float64),
+		withdrawals: make(map[string]*transfer),
+		deposits:    make(map[string]*transfer),
+		history:     make(common.ExchangeTradeHistory),
+	}
+}
+
+// ID returns id of the exchange.
+func (e *Exchange) ID() common.ExchangeID {
+	return e.id
+}
+
+// MarshalText returns name of the exchange.
+func (e *Exchange) MarshalText() (text []byte, err error) {
+	return []byte(e.id.String()), nil
+}
+
+// Address returns a deterministic deposit address of the asset.
+func (e *Exchange) Address(asset commonv3.Asset) (ethereum.Address, bool) {
+	return e.address(common.AssetID(asset.ID)), true
+}
+
+func (e *Exchange) address(asset common.AssetID) ethereum.Address {
+	return ethereum.BytesToAddress(crypto.Keccak256([]byte(fmt.Sprintf("%s-%d", e.id.String(), asset))))
+}
+
+// TokenAddresses returns deposit addresses of all assets listed on the exchange.
+func (e *Exchange) TokenAddresses() (map[common.AssetID]ethereum.Address, error) {
+	assets, err := e.sr.GetAssets()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[common.AssetID]ethereum.Address)
+	for _, asset := range assets {
+		for _, ae := range asset.Exchanges {
+			if ae.ExchangeID == uint64(e.id) {
+				result[common.AssetID(asset.ID)] = e.address(common.AssetID(asset.ID))
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetLiveExchangeInfos returns precision and limits of the trading pairs as they are configured.
+func (e *Exchange) GetLiveExchangeInfos(pairs []commonv3.TradingPairSymbols) (common.ExchangeInfo, error) {
+	result := make(common.ExchangeInfo)
+	for _, pair := range pairs {
+		result[pair.ID] = common.ExchangePrecisionLimit{
+			Precision: common.TokenPairPrecision{
+				Amount: int(pair.AmountPrecision),
+				Price:  int(pair.PricePrecision),
+			},
+			AmountLimit: common.TokenPairAmountLimit{
+				Min: pair.AmountLimitMin,
+				Max: pair.AmountLimitMax,
+			},
+			PriceLimit: common.TokenPairPriceLimit{
+				Min: pair.PriceLimitMin,
+				Max: pair.PriceLimitMax,
+			},
+			MinNotional: pair.MinNotional,
+		}
+	}
+	return result, nil
+}
+
+// injectFailure returns true if the request should fail, caller must hold the lock.
+func (e *Exchange) injectFailure(rate float64) bool {
+	return rate > 0 && e.rand.Float64() < rate
+}
+
+// book returns order book of the pair, a new book is filled with synthetic liquidity.
+// Caller must hold the lock.
+func (e *Exchange) book(pairID uint64) *orderBook {
+	book, ok := e.books[pairID]
+	if !ok {
+		book = &orderBook{}
+		e.books[pairID] = book
+		if synthetic, ok := e.cfg.Books[pairID]; ok && synthetic.MidPrice > 0 {
+			e.mids[pairID] = synthetic.MidPrice
+			e.refreshBook(pairID)
+		}
+	}
+	return book
+}
+
+// refreshBook replaces synthetic liquidity of the pair around its current mid price. Synthetic
+// orders crossing resting orders of the account fill them. Caller must hold the lock.
+func (e *Exchange) refreshBook(pairID uint64) {
+	var (
+		book      = e.books[pairID]
+		synthetic = e.cfg.Books[pairID]
+		mid       = e.mids[pairID]
+		spread    = synthetic.Spread
+		levels    = synthetic.Levels
+		quantity  = synthetic.Quantity
+	)
+	if spread <= 0 {
+		spread = defaultSpread
+	}
+	if levels <= 0 {
+		levels = defaultLevels
+	}
+	if quantity <= 0 {
+		quantity = defaultQuantity
+	}
+
+	book.removeOwner(ownerSynthetic)
+	for i := 0; i < levels; i++ {
+		offset := spread/2 + float64(i)*spread
+		for _, o := range []*order{
+			{owner: ownerSynthetic, side: "buy", price: mid * (1 - offset), amount: quantity},
+			{owner: ownerSynthetic, side: "sell", price: mid * (1 + offset), amount: quantity},
+		} {
+			e.settle(book.submit(o))
+		}
+	}
+}
+
+// settle updates balances of the account for fills, caller must hold the lock.
+func (e *Exchange) settle(fills []fill) {
+	for _, f := range fills {
+		for _, o := range []*order{f.maker, f.taker} {
+			if o.owner != ownerAccount {
+				continue
+			}
+			ao := e.orders[o.id]
+			base, quote := common.AssetID(ao.pair.Base), common.AssetID(ao.pair.Quote)
+			var fee float64
+			feeAsset := ao.pair.BaseSymbol
+			if o.side == "buy" {
+				fee = f.quantity * e.cfg.TradingFee
+				e.locked[quote] -= f.quantity * o.price
+				e.available[quote] += f.quantity * (o.price - f.price)
+				e.available[base] += f.quantity - fee
+			} else {
+				fee = f.quantity * f.price * e.cfg.TradingFee
+				feeAsset = ao.pair.QuoteSymbol
+				e.locked[base] -= f.quantity
+				e.available[quote] += f.quantity*f.price - fee
+			}
+			id := strconv.FormatUint(o.id, 10)
+			e.history[ao.pair.ID] = append(e.history[ao.pair.ID], common.NewTradeHistory(
+				id, f.price, f.quantity, o.side, e.now()).WithCommission(id, fee, feeAsset, o == f.maker))
+		}
+	}
+}
+
+// Trade places a limit order which is matched immediately against the order book, the remaining
+// amount rests on the book.
+func (e *Exchange) Trade(tradeType string, pair commonv3.TradingPairSymbols, rate, amount float64) (string, float64, float64, bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if tradeType != "buy" && tradeType != "sell" {
+		return "", 0, 0, false, fmt.Errorf("invalid trade type %s", tradeType)
+	}
+	if rate <= 0 || amount <= 0 {
+		return "", 0, 0, false, fmt.Errorf("invalid rate %f or amount %f", rate, amount)
+	}
+	if e.injectFailure(e.cfg.FailureRate) {
+		return "", 0, 0, false, ErrInjectedFailure
+	}
+
+	asset, required := common.AssetID(pair.Quote), amount*rate
+	if tradeType == "sell" {
+		asset, required = common.AssetID(pair.Base), amount
+	}
+	if e.available[asset] < required-epsilon {
+		return "", 0, 0, false, fmt.Errorf("insufficient balance of asset %d: available %f, required %f",
+			asset, e.available[asset], required)
+	}
+	e.available[asset] -= required
+	e.locked[asset] += required
+
+	e.lastID++
+	o := &accountOrder{
+		order: &order{id: e.lastID, owner: ownerAccount, side: tradeType, price: rate, amount: amount},
+		pair:  pair,
+		time:  e.now(),
+	}
+	e.orders[o.id] = o
+	e.settle(e.book(pair.ID).submit(o.order))
+	return strconv.FormatUint(o.id, 10), o.filled, o.remaining(), o.remaining() < epsilon, nil
+}
+
+// findOrder returns order of the account by id, caller must hold the lock.
+func (e *Exchange) findOrder(id string) (*accountOrder, error) {
+	orderID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("can not parse orderID (val %s) to uint", id)
+	}
+	o, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("order %s does not exist", id)
+	}
+	return o, nil
+}
+
+// CancelOrder takes an open order off the book and unlocks its remaining amount.
+func (e *Exchange) CancelOrder(id, base, quote string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.findOrder(id)
+	if err != nil {
+		return err
+	}
+	if o.cancelled || !e.books[o.pair.ID].remove(o.order) {
+		return fmt.Errorf("order %s is not open", id)
+	}
+	o.cancelled = true
+	asset, unlocked := common.AssetID(o.pair.Quote), o.remaining()*o.price
+	if o.side == "sell" {
+		asset, unlocked = common.AssetID(o.pair.Base), o.remaining()
+	}
+	e.locked[asset] -= unlocked
+	e.available[asset] += unlocked
+	return nil
+}
+
+// OrderStatus returns done if the order is filled or cancelled.
+func (e *Exchange) OrderStatus(ctx context.Context, id string, base, quote string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.findOrder(id)
+	if err != nil {
+		return "", err
+	}
+	if o.cancelled || o.remaining() < epsilon {
+		return common.ExchangeStatusDone, nil
+	}
+	return "", nil
+}
+
+// QueryOrder returns filled and remaining amount and status of the order.
+func (e *Exchange) QueryOrder(symbol string, id uint64) (float64, float64, string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	o, err := e.findOrder(strconv.FormatUint(id, 10))
+	if err != nil {
+		return 0, 0, "", err
+	}
+	status := common.OrderStatusOpen
+	switch {
+	case o.cancelled:
+		status = common.OrderStatusCancelled
+	case o.remaining() < epsilon:
+		status = common.OrderStatusFilled
+	}
+	return o.filled, o.remaining(), status, nil
+}
+
+// OpenOrders returns orders of the account which are still on the book.
+func (e *Exchange) OpenOrders() ([]common.OpenOrder, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var result []common.OpenOrder
+	for _, o := range e.orders {
+		if o.cancelled || o.remaining() < epsilon {
+			continue
+		}
+		result = append(result, common.OpenOrder{
+			ID:             strconv.FormatUint(o.id, 10),
+			Exchange:       e.id,
+			PairID:         o.pair.ID,
+			Base:           o.pair.BaseSymbol,
+			Quote:          o.pair.QuoteSymbol,
+			Side:           o.side,
+			Price:          o.price,
+			Amount:         o.amount,
+			ExecutedAmount: o.filled,
+			Time:           o.time,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Time < result[j].Time || (result[i].Time == result[j].Time && result[i].ID < result[j].ID)
+	})
+	return result, nil
+}
+
+// Withdraw takes amount of asset from available balance, the withdrawal is done after withdraw delay.
+func (e *Exchange) Withdraw(asset commonv3.Asset, amount *big.Int, address ethereum.Address) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.injectFailure(e.cfg.FailureRate) {
+		return "", ErrInjectedFailure
+	}
+	var (
+		assetID = common.AssetID(asset.ID)
+		value   = common.BigToFloat(amount, int64(asset.Decimals))
+	)
+	if e.available[assetID] < value-epsilon {
+		return "", fmt.Errorf("insufficient balance of asset %d: available %f, required %f",
+			assetID, e.available[assetID], value)
+	}
+	e.available[assetID] -= value
+
+	e.lastID++
+	id := strconv.FormatUint(e.lastID, 10)
+	e.withdrawals[id] = &transfer{
+		asset:  assetID,
+		amount: value,
+		tx:     crypto.Keccak256Hash([]byte(fmt.Sprintf("%s-withdraw-%s-%s", e.id.String(), id, address.Hex()))).Hex(),
+		ready:  e.now() + uint64(time.Duration(e.cfg.WithdrawDelay)/time.Millisecond),
+		failed: e.injectFailure(e.cfg.TransferFailureRate),
+	}
+	return id, nil
+}
+
+// WithdrawStatus returns done and tx hash of the withdrawal when it is ready, a failed withdrawal
+// is refunded.
+func (e *Exchange) WithdrawStatus(ctx context.Context, id string, assetID uint64, amount float64, timepoint uint64) (string, string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	withdrawal, ok := e.withdrawals[id]
+	if !ok {
+		e.l.Warnw("simulated withdrawal does not exist", "exchange", e.id.String(), "id", id)
+		return "", "", nil
+	}
+	if e.now() < withdrawal.ready {
+		return "", "", nil
+	}
+	if withdrawal.failed {
+		if !withdrawal.settled {
+			withdrawal.settled = true
+			e.available[withdrawal.asset] += withdrawal.amount
+		}
+		return common.ExchangeStatusFailed, "", nil
+	}
+	return common.ExchangeStatusDone, withdrawal.tx, nil
+}
+
+// DepositStatus tracks a deposit by its tx hash from the first time it is queried, the deposit is
+// credited after deposit delay.
+func (e *Exchange) DepositStatus(ctx context.Context, id common.ActivityID, txHash string, assetID uint64, amount float64, timepoint uint64) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key := txHash
+	if key == "" {
+		key = id.String()
+	}
+	deposit, ok := e.deposits[key]
+	if !ok {
+		deposit = &transfer{
+			asset:  common.AssetID(assetID),
+			amount: amount,
+			tx:     txHash,
+			ready:  e.now() + uint64(time.Duration(e.cfg.DepositDelay)/time.Millisecond),
+			failed: e.injectFailure(e.cfg.TransferFailureRate),
+		}
+		e.deposits[key] = deposit
+	}
+	if e.now() < deposit.ready {
+		return "", nil
+	}
+	if deposit.failed {
+		return common.ExchangeStatusFailed, nil
+	}
+	if !deposit.settled {
+		deposit.settled = true
+		e.available[deposit.asset] += deposit.amount
+	}
+	return common.ExchangeStatusDone, nil
+}
+
+// FetchPriceData moves mid price of synthetic books randomly and returns order books of all
+// trading pairs listed on the exchange.
+func (e *Exchange) FetchPriceData(timepoint uint64) (map[uint64]common.ExchangePrice, error) {
+	pairs, err := e.sr.GetTradingPairs(uint64(e.id))
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	result := make(map[uint64]common.ExchangePrice)
+	for _, pair := range pairs {
+		book, ok := e.books[pair.ID]
+		if !ok {
+			book = e.book(pair.ID)
+		} else if synthetic, ok := e.cfg.Books[pair.ID]; ok && synthetic.MidPrice > 0 {
+			e.mids[pair.ID] *= 1 + synthetic.Volatility*e.rand.NormFloat64()
+			e.refreshBook(pair.ID)
+		}
+		bids, asks := book.depth()
+		result[pair.ID] = common.ExchangePrice{
+			Valid:      true,
+			Timestamp:  common.Timestamp(strconv.FormatUint(timepoint, 10)),
+			Bids:       bids,
+			Asks:       asks,
+			ReturnTime: common.GetTimestamp(),
+		}
+	}
+	return result, nil
+}
+
+// FetchEBalanceData returns available and locked balances of the account.
+func (e *Exchange) FetchEBalanceData(ctx context.Context, timepoint uint64) (common.EBalanceEntry, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	result := common.EBalanceEntry{
+		Valid:            true,
+		Timestamp:        common.Timestamp(strconv.FormatUint(timepoint, 10)),
+		ReturnTime:       common.GetTimestamp(),
+		AvailableBalance: make(map[common.AssetID]float64),
+		LockedBalance:    make(map[common.AssetID]float64),
+		DepositBalance:   make(map[common.AssetID]float64),
+		Status:           true,
+	}
+	for asset, balance := range e.available {
+		result.AvailableBalance[asset] = balance
+		result.LockedBalance[asset] = e.locked[asset]
+		result.DepositBalance[asset] = 0
+	}
+	return result, nil
+}
+
+// FetchTradeHistory does nothing as fills are kept in memory.
+func (e *Exchange) FetchTradeHistory() {}
+
+// GetTradeHistory returns fills of the account between fromTime and toTime.
+func (e *Exchange) GetTradeHistory(fromTime, toTime uint64) (common.ExchangeTradeHistory, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	result := make(common.ExchangeTradeHistory)
+	for pairID, trades := range e.history {
+		for _, trade := range trades {
+			if trade.Timestamp >= fromTime && trade.Timestamp <= toTime {
+				result[pairID] = append(result[pairID], trade)
+			}
+		}
+	}
+	return result, nil
+}
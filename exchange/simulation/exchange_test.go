@@ -0,0 +1,213 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/data/fetcher"
+	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+var (
+	_ common.Exchange          = &Exchange{}
+	_ common.OpenOrderExchange = &Exchange{}
+	_ fetcher.Exchange         = &Exchange{}
+)
+
+var testPair = commonv3.TradingPairSymbols{
+	TradingPair: commonv3.TradingPair{ID: 1, Base: 2, Quote: 1, AmountPrecision: 2},
+	BaseSymbol:  "KNC",
+	QuoteSymbol: "ETH",
+}
+
+var testAsset = commonv3.Asset{
+	ID:       2,
+	Decimals: 18,
+	Exchanges: []commonv3.AssetExchange{
+		{ExchangeID: uint64(common.Binance), Symbol: "KNC"},
+	},
+}
+
+type testSettingStorage struct{}
+
+func (testSettingStorage) GetTradingPairs(exchangeID uint64) ([]commonv3.TradingPairSymbols, error) {
+	return []commonv3.TradingPairSymbols{testPair}, nil
+}
+
+func (testSettingStorage) GetAssets() ([]commonv3.Asset, error) {
+	return []commonv3.Asset{testAsset}, nil
+}
+
+type testClock struct {
+	now uint64
+}
+
+func (c *testClock) Now() uint64 {
+	return c.now
+}
+
+func newTestExchange(cfg common.SimulatedExchangeConfig) (*Exchange, *testClock) {
+	clock := &testClock{now: 1000}
+	e := NewExchange(common.Binance, cfg, 1, testSettingStorage{})
+	e.now = clock.Now
+	return e, clock
+}
+
+func TestSimulatedTrade(t *testing.T) {
+	e, _ := newTestExchange(common.SimulatedExchangeConfig{
+		Balances: map[common.AssetID]float64{1: 10, 2: 1000},
+		Books: map[uint64]common.SyntheticBook{
+			1: {MidPrice: 0.01, Spread: 0.02, Levels: 2, Quantity: 100},
+		},
+	})
+
+	prices, err := e.FetchPriceData(1000)
+	require.NoError(t, err)
+	require.True(t, prices[1].Valid)
+	require.Len(t, prices[1].Asks, 2)
+	assert.InDelta(t, 0.0101, prices[1].Asks[0].Rate, 1e-12)
+	assert.InDelta(t, 0.0099, prices[1].Bids[0].Rate, 1e-12)
+
+	// buy takes both levels up to the limit price, each at the price of the level
+	id, done, remaining, finished, err := e.Trade("buy", testPair, 0.0103, 150)
+	require.NoError(t, err)
+	assert.Equal(t, float64(150), done)
+	assert.Equal(t, float64(0), remaining)
+	assert.True(t, finished)
+	status, err := e.OrderStatus(context.Background(), id, "KNC", "ETH")
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, status)
+
+	balances, err := e.FetchEBalanceData(context.Background(), 1000)
+	require.NoError(t, err)
+	assert.InDelta(t, 10-100*0.0101-50*0.0103, balances.AvailableBalance[1], 1e-9)
+	assert.InDelta(t, 1150, balances.AvailableBalance[2], 1e-9)
+	assert.InDelta(t, 0, balances.LockedBalance[1], 1e-9)
+
+	_, _, _, _, err = e.Trade("buy", testPair, 0.01, 1000)
+	require.Error(t, err, "insufficient balance")
+
+	history, err := e.GetTradeHistory(0, 2000)
+	require.NoError(t, err)
+	assert.Len(t, history[1], 2)
+}
+
+func TestSimulatedRestingOrder(t *testing.T) {
+	e, _ := newTestExchange(common.SimulatedExchangeConfig{
+		Balances: map[common.AssetID]float64{2: 100},
+		Books: map[uint64]common.SyntheticBook{
+			1: {MidPrice: 0.01, Spread: 0.02, Levels: 1, Quantity: 10},
+		},
+	})
+
+	id, done, remaining, finished, err := e.Trade("sell", testPair, 0.05, 20)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), done)
+	assert.Equal(t, float64(20), remaining)
+	assert.False(t, finished)
+	orders, err := e.OpenOrders()
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, id, orders[0].ID)
+
+	balances, err := e.FetchEBalanceData(context.Background(), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, float64(80), balances.AvailableBalance[2])
+	assert.Equal(t, float64(20), balances.LockedBalance[2])
+
+	// synthetic bid crossing the resting order after mid price moves fills it at its price
+	e.mids[1] = 0.06
+	_, err = e.FetchPriceData(1000)
+	require.NoError(t, err)
+	filled, left, status, err := e.QueryOrder("KNCETH", 1)
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), filled)
+	assert.Equal(t, float64(10), left)
+	assert.Equal(t, common.OrderStatusOpen, status)
+
+	require.NoError(t, e.CancelOrder(id, "KNC", "ETH"))
+	require.Error(t, e.CancelOrder(id, "KNC", "ETH"))
+	_, _, status, err = e.QueryOrder("KNCETH", 1)
+	require.NoError(t, err)
+	assert.Equal(t, common.OrderStatusCancelled, status)
+	balances, err = e.FetchEBalanceData(context.Background(), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, float64(90), balances.AvailableBalance[2])
+	assert.Equal(t, float64(0), balances.LockedBalance[2])
+	assert.InDelta(t, 0.5, balances.AvailableBalance[1], 1e-9)
+}
+
+func TestSimulatedTransfer(t *testing.T) {
+	e, clock := newTestExchange(common.SimulatedExchangeConfig{
+		Balances:      map[common.AssetID]float64{2: 100},
+		DepositDelay:  common.HumanDuration(time.Minute),
+		WithdrawDelay: common.HumanDuration(2 * time.Minute),
+	})
+
+	address, supported := e.Address(testAsset)
+	assert.True(t, supported)
+	addresses, err := e.TokenAddresses()
+	require.NoError(t, err)
+	assert.Equal(t, address, addresses[2])
+
+	_, err = e.Withdraw(testAsset, common.EthToWei(200), ethereum.Address{})
+	require.Error(t, err)
+	id, err := e.Withdraw(testAsset, common.EthToWei(40), ethereum.Address{})
+	require.NoError(t, err)
+	status, tx, err := e.WithdrawStatus(context.Background(), id, 2, 40, clock.now)
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+	assert.Equal(t, "", tx)
+	clock.now += 2 * 60 * 1000
+	status, tx, err = e.WithdrawStatus(context.Background(), id, 2, 40, clock.now)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusDone, status)
+	assert.NotEmpty(t, tx)
+
+	// the withdrawal is deposited back after deposit delay
+	status, err = e.DepositStatus(context.Background(), common.ActivityID{}, tx, 2, 40, clock.now)
+	require.NoError(t, err)
+	assert.Equal(t, "", status)
+	clock.now += 60 * 1000
+	for i := 0; i < 2; i++ {
+		status, err = e.DepositStatus(context.Background(), common.ActivityID{}, tx, 2, 40, clock.now)
+		require.NoError(t, err)
+		assert.Equal(t, common.ExchangeStatusDone, status)
+	}
+	balances, err := e.FetchEBalanceData(context.Background(), clock.now)
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), balances.AvailableBalance[2])
+}
+
+func TestSimulatedFailureInjection(t *testing.T) {
+	e, _ := newTestExchange(common.SimulatedExchangeConfig{
+		Balances:            map[common.AssetID]float64{2: 100},
+		FailureRate:         1,
+		TransferFailureRate: 1,
+	})
+	_, _, _, _, err := e.Trade("sell", testPair, 0.01, 1)
+	assert.Equal(t, ErrInjectedFailure, err)
+	_, err = e.Withdraw(testAsset, common.EthToWei(1), ethereum.Address{})
+	assert.Equal(t, ErrInjectedFailure, err)
+
+	e.cfg.FailureRate = 0
+	id, err := e.Withdraw(testAsset, common.EthToWei(10), ethereum.Address{})
+	require.NoError(t, err)
+	status, _, err := e.WithdrawStatus(context.Background(), id, 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusFailed, status)
+	status, err = e.DepositStatus(context.Background(), common.ActivityID{}, "0x1", 2, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, common.ExchangeStatusFailed, status)
+
+	// failed withdrawal is refunded
+	balances, err := e.FetchEBalanceData(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), balances.AvailableBalance[2])
+}
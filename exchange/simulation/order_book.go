@@ -0,0 +1,148 @@
+package simulation
+
+import (
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+const epsilon = 0.0000000001 // 10e-10
+
+const (
+	ownerSynthetic = iota
+	ownerAccount
+)
+
+// order is an order resting on or submitted to an order book.
+type order struct {
+	id     uint64
+	owner  int
+	side   string
+	price  float64
+	amount float64
+	filled float64
+	// seq is the arrival sequence of the order on the book, used for time priority.
+	seq uint64
+}
+
+func (o *order) remaining() float64 {
+	return o.amount - o.filled
+}
+
+func (o *order) crosses(resting *order) bool {
+	if o.side == "buy" {
+		return o.price >= resting.price
+	}
+	return o.price <= resting.price
+}
+
+// fill is a match between an incoming order and a resting order at the price of the resting order.
+type fill struct {
+	maker    *order
+	taker    *order
+	price    float64
+	quantity float64
+}
+
+// orderBook is a price-time priority matching engine of a trading pair.
+type orderBook struct {
+	// bids are sorted by highest price first, then by earliest arrival.
+	bids []*order
+	// asks are sorted by lowest price first, then by earliest arrival.
+	asks []*order
+	seq  uint64
+}
+
+// submit matches the incoming order against resting orders of the other side from the best price,
+// the remaining amount rests on the book. Orders of the same owner never match each other.
+func (b *orderBook) submit(o *order) []fill {
+	b.seq++
+	o.seq = b.seq
+
+	resting := &b.asks
+	if o.side == "sell" {
+		resting = &b.bids
+	}
+	var (
+		fills []fill
+		kept  = make([]*order, 0, len(*resting))
+	)
+	for _, r := range *resting {
+		if o.remaining() < epsilon || r.owner == o.owner || !o.crosses(r) {
+			kept = append(kept, r)
+			continue
+		}
+		quantity := o.remaining()
+		if r.remaining() < quantity {
+			quantity = r.remaining()
+		}
+		o.filled += quantity
+		r.filled += quantity
+		fills = append(fills, fill{maker: r, taker: o, price: r.price, quantity: quantity})
+		if r.remaining() >= epsilon {
+			kept = append(kept, r)
+		}
+	}
+	*resting = kept
+
+	if o.remaining() >= epsilon {
+		b.insert(o)
+	}
+	return fills
+}
+
+func (b *orderBook) insert(o *order) {
+	side := &b.bids
+	better := func(r *order) bool { return o.price > r.price }
+	if o.side == "sell" {
+		side = &b.asks
+		better = func(r *order) bool { return o.price < r.price }
+	}
+	i := 0
+	for i < len(*side) && !better((*side)[i]) {
+		i++
+	}
+	*side = append(*side, nil)
+	copy((*side)[i+1:], (*side)[i:])
+	(*side)[i] = o
+}
+
+// remove takes the order off the book, returns false if the order is not resting on the book.
+func (b *orderBook) remove(o *order) bool {
+	for _, side := range []*[]*order{&b.bids, &b.asks} {
+		for i, r := range *side {
+			if r == o {
+				*side = append((*side)[:i], (*side)[i+1:]...)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeOwner takes all orders of owner off the book.
+func (b *orderBook) removeOwner(owner int) {
+	for _, side := range []*[]*order{&b.bids, &b.asks} {
+		kept := (*side)[:0]
+		for _, r := range *side {
+			if r.owner != owner {
+				kept = append(kept, r)
+			}
+		}
+		*side = kept
+	}
+}
+
+// depth aggregates remaining amount of resting orders by price level.
+func (b *orderBook) depth() (bids, asks []common.PriceEntry) {
+	aggregate := func(orders []*order) []common.PriceEntry {
+		var entries []common.PriceEntry
+		for _, o := range orders {
+			if n := len(entries); n > 0 && entries[n-1].Rate == o.price {
+				entries[n-1].Quantity += o.remaining()
+				continue
+			}
+			entries = append(entries, common.NewPriceEntry(o.remaining(), o.price))
+		}
+		return entries
+	}
+	return aggregate(b.bids), aggregate(b.asks)
+}
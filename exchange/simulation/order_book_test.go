@@ -0,0 +1,41 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common"
+)
+
+func TestOrderBookPriceTimePriority(t *testing.T) {
+	book := &orderBook{}
+	first := &order{id: 1, owner: ownerSynthetic, side: "sell", price: 101, amount: 5}
+	second := &order{id: 2, owner: ownerSynthetic, side: "sell", price: 100, amount: 5}
+	third := &order{id: 3, owner: ownerSynthetic, side: "sell", price: 100, amount: 5}
+	for _, o := range []*order{first, second, third} {
+		assert.Empty(t, book.submit(o))
+	}
+	bids, asks := book.depth()
+	assert.Empty(t, bids)
+	assert.Equal(t, []common.PriceEntry{{Quantity: 10, Rate: 100}, {Quantity: 5, Rate: 101}}, asks)
+
+	// best price first, earlier order first on the same price, remainder rests on the book
+	taker := &order{id: 4, owner: ownerAccount, side: "buy", price: 101, amount: 17}
+	fills := book.submit(taker)
+	require.Len(t, fills, 3)
+	assert.Equal(t, second, fills[0].maker)
+	assert.Equal(t, third, fills[1].maker)
+	assert.Equal(t, first, fills[2].maker)
+	assert.Equal(t, float64(101), fills[2].price)
+	assert.Equal(t, float64(2), taker.remaining())
+	bids, asks = book.depth()
+	assert.Equal(t, []common.PriceEntry{{Quantity: 2, Rate: 101}}, bids)
+	assert.Empty(t, asks)
+
+	// orders of the same owner never match
+	assert.Empty(t, book.submit(&order{id: 5, owner: ownerAccount, side: "sell", price: 100, amount: 1}))
+	assert.True(t, book.remove(taker))
+	assert.False(t, book.remove(taker))
+}
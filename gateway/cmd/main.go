@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
-	"github.com/gin-contrib/httpsign"
 	"github.com/gin-gonic/gin"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
@@ -15,24 +19,35 @@ import (
 	"github.com/KyberNetwork/httpsign-utils/authenticator"
 	"github.com/KyberNetwork/reserve-data/cmd/mode"
 	"github.com/KyberNetwork/reserve-data/gateway/http"
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
 	libapp "github.com/KyberNetwork/reserve-data/lib/app"
 	"github.com/KyberNetwork/reserve-data/lib/httputil"
 )
 
 const (
-	readAccessKeyFlag      = "read-access-key"
-	readSecretKeyFlag      = "read-secret-key"
-	writeAccessKeyFlag     = "write-access-key"
-	writeSecretKeyFlag     = "write-secret-key"
-	confirmAccessKeyFlag   = "confirm-access-key"
-	confirmSecretKeyFlag   = "confirm-secret-key"
-	rebalanceAccessKeyFlag = "rebalance-access-key"
-	rebalanceSecretKeyFlag = "rebalance-secret-key"
+	readAccessKeyFlag       = "read-access-key"
+	readSecretKeyFlag       = "read-secret-key"
+	writeAccessKeyFlag      = "write-access-key"
+	writeSecretKeyFlag      = "write-secret-key"
+	confirmAccessKeyFlag    = "confirm-access-key"
+	confirmSecretKeyFlag    = "confirm-secret-key"
+	rebalanceAccessKeyFlag  = "rebalance-access-key"
+	rebalanceSecretKeyFlag  = "rebalance-secret-key"
+	superAdminAccessKeyFlag = "super-admin-access-key"
+	superAdminSecretKeyFlag = "super-admin-secret-key"
 
 	coreEndpointFlag    = "core-endpoint"
 	settingEndpointFlag = "setting-endpoint"
 
 	noAuthFlag = "no-auth"
+
+	replayWindowFlag    = "replay-window"
+	replayCacheSizeFlag = "replay-cache-size"
+	rateLimitFlag       = "rate-limit"
+	ipAllowlistFlag     = "ip-allowlist"
+
+	policyFileFlag           = "policy-file"
+	policyReloadIntervalFlag = "policy-reload-interval"
 )
 
 var (
@@ -85,6 +100,16 @@ func main() {
 			Usage:  "secret key to access rebalance paths",
 			EnvVar: "REBALANCE_SECRET_KEY",
 		},
+		cli.StringSliceFlag{
+			Name:   superAdminAccessKeyFlag,
+			Usage:  "access key for managing gateway roles and keys",
+			EnvVar: "SUPER_ADMIN_ACCESS_KEY",
+		},
+		cli.StringSliceFlag{
+			Name:   superAdminSecretKeyFlag,
+			Usage:  "secret key for managing gateway roles and keys",
+			EnvVar: "SUPER_ADMIN_SECRET_KEY",
+		},
 		cli.StringFlag{
 			Name:   coreEndpointFlag,
 			Usage:  "core endpoint url",
@@ -102,6 +127,39 @@ func main() {
 			Usage:  "no authenticate",
 			EnvVar: "NO_AUTH",
 		},
+		cli.DurationFlag{
+			Name:   replayWindowFlag,
+			Usage:  "accepted time gap between request nonce and server time, signed requests are remembered to reject replays",
+			EnvVar: "REPLAY_WINDOW",
+			Value:  30 * time.Second,
+		},
+		cli.IntFlag{
+			Name:   replayCacheSizeFlag,
+			Usage:  "max number of remembered requests, requests are rejected when it is reached",
+			EnvVar: "REPLAY_CACHE_SIZE",
+			Value:  100000,
+		},
+		cli.StringSliceFlag{
+			Name:   rateLimitFlag,
+			Usage:  "rate limit of each key of a role in format role:requests-per-second:burst",
+			EnvVar: "RATE_LIMIT",
+		},
+		cli.StringSliceFlag{
+			Name:   ipAllowlistFlag,
+			Usage:  "allowed address of a key in format access-key@cidr, keys without allowed addresses can be used from anywhere",
+			EnvVar: "IP_ALLOWLIST",
+		},
+		cli.StringFlag{
+			Name:   policyFileFlag,
+			Usage:  "JSON file of roles and keys managed by admin API in addition to the ones of flags, admin API is disabled if it is not set",
+			EnvVar: "POLICY_FILE",
+		},
+		cli.DurationFlag{
+			Name:   policyReloadIntervalFlag,
+			Usage:  "interval to reload policy file",
+			EnvVar: "POLICY_RELOAD_INTERVAL",
+			Value:  10 * time.Second,
+		},
 	)
 
 	app.Flags = append(app.Flags, httputil.NewHTTPCliFlags(httputil.GatewayPort)...)
@@ -129,18 +187,54 @@ func getKeyList(c *cli.Context, accessKeyFlag, secretKeyFlag string) ([]authenti
 	return keyPairs, nil
 }
 
+func getRateLimits(c *cli.Context) (map[string]permission.Limit, error) {
+	limits := make(map[string]permission.Limit)
+	for _, value := range c.StringSlice(rateLimitFlag) {
+		parts := strings.Split(value, ":")
+		if len(parts) != 3 {
+			return nil, errors.Errorf("invalid rate limit %s, expected role:requests-per-second:burst", value)
+		}
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rate of rate limit %s", value)
+		}
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid burst of rate limit %s", value)
+		}
+		limits[parts[0]] = permission.Limit{Rate: rate, Burst: burst}
+	}
+	return limits, nil
+}
+
+func getIPAllowlist(c *cli.Context) (map[permission.KeyID][]string, error) {
+	allowlist := make(map[permission.KeyID][]string)
+	for _, value := range c.StringSlice(ipAllowlistFlag) {
+		i := strings.LastIndex(value, "@")
+		if i <= 0 || i == len(value)-1 {
+			return nil, errors.Errorf("invalid ip allowlist %s, expected access-key@cidr", value)
+		}
+		keyID := permission.KeyID(value[:i])
+		allowlist[keyID] = append(allowlist[keyID], value[i+1:])
+	}
+	return allowlist, nil
+}
+
 func run(c *cli.Context) error {
 	var (
-		keyPairs, readKeys, writeKeys, confirmKeys, rebalanceKeys []authenticator.KeyPair
-		err                                                       error
-		auth                                                      *httpsign.Authenticator
-		perm                                                      gin.HandlerFunc
+		readKeys, writeKeys, confirmKeys, rebalanceKeys, superAdminKeys []authenticator.KeyPair
+		err                                                             error
+		auth, perm                                                      gin.HandlerFunc
+		guards                                                          []gin.HandlerFunc
 	)
 	logger, err := libapp.NewLogger(c)
 	if err != nil {
 		return err
 	}
 	defer libapp.NewFlusher(logger)()
+	// stop is closed on shutdown to stop background jobs of the gateway
+	stop := make(chan struct{})
+	defer close(stop)
 	if err := validation.Validate(c.String(coreEndpointFlag),
 		validation.Required,
 		is.URL); err != nil {
@@ -153,28 +247,32 @@ func run(c *cli.Context) error {
 		return errors.Wrapf(err, "app names API URL error: %s", c.String(settingEndpointFlag))
 	}
 
+	options := []http.Option{
+		http.WithCoreEndpoint(c.String(coreEndpointFlag)),
+		http.WithSettingEndpoint(c.String(settingEndpointFlag)),
+	}
 	noAuth := c.Bool(noAuthFlag)
 	if !noAuth {
 		readKeys, err = getKeyList(c, readAccessKeyFlag, readSecretKeyFlag)
 		if err != nil {
 			return errors.Wrap(err, "failed to get read keys")
 		}
-		keyPairs = append(keyPairs, readKeys...)
 		writeKeys, err = getKeyList(c, writeAccessKeyFlag, writeSecretKeyFlag)
 		if err != nil {
 			return errors.Wrap(err, "failed to get write keys")
 		}
-		keyPairs = append(keyPairs, writeKeys...)
 		confirmKeys, err = getKeyList(c, confirmAccessKeyFlag, confirmSecretKeyFlag)
 		if err != nil {
 			return errors.Wrap(err, "failed to get confirm keys")
 		}
-		keyPairs = append(keyPairs, confirmKeys...)
 		rebalanceKeys, err = getKeyList(c, rebalanceAccessKeyFlag, rebalanceSecretKeyFlag)
 		if err != nil {
 			return errors.Wrap(err, "failed to get rebalance keys")
 		}
-		keyPairs = append(keyPairs, rebalanceKeys...)
+		superAdminKeys, err = getKeyList(c, superAdminAccessKeyFlag, superAdminSecretKeyFlag)
+		if err != nil {
+			return errors.Wrap(err, "failed to get super admin keys")
+		}
 
 		if err := validation.Validate(c.String(writeAccessKeyFlag), validation.Required); err != nil {
 			return errors.Wrap(err, "write access key error")
@@ -183,26 +281,70 @@ func run(c *cli.Context) error {
 		if err := validation.Validate(c.String(writeSecretKeyFlag), validation.Required); err != nil {
 			return errors.Wrap(err, "secret key error")
 		}
-		auth, err = authenticator.NewAuthenticator(keyPairs...)
-		if err != nil {
-			return errors.Wrap(err, "authentication object creation error")
+		var store permission.Store
+		if path := c.String(policyFileFlag); path != "" {
+			store = permission.NewFileStore(path)
 		}
-		perm, err = http.NewPermissioner(readKeys, writeKeys, confirmKeys, rebalanceKeys)
+		manager, err := permission.NewManager(
+			http.NewStaticPolicy(readKeys, writeKeys, confirmKeys, rebalanceKeys, superAdminKeys),
+			store,
+		)
 		if err != nil {
 			return errors.Wrap(err, "permission object creation error")
 		}
+		auth = manager.Authenticated()
+		perm = manager.Permission()
+		if store != nil {
+			go manager.Watch(c.Duration(policyReloadIntervalFlag), stop)
+			options = append(options, http.WithPolicyAdmin(manager))
+		}
+
+		replayGuard := permission.NewReplayGuard(c.Duration(replayWindowFlag), c.Int(replayCacheSizeFlag))
+		allowlist, err := getIPAllowlist(c)
+		if err != nil {
+			return err
+		}
+		ipAllowlist, err := permission.NewIPAllowlist(allowlist)
+		if err != nil {
+			return errors.Wrap(err, "ip allowlist creation error")
+		}
+		limits, err := getRateLimits(c)
+		if err != nil {
+			return err
+		}
+		rateLimiter := permission.NewRateLimiter(nil)
+		manager.OnReload(func(p permission.Policy) {
+			rateLimiter.SetLimits(permission.RoleLimits(p, limits))
+		})
+		guards = append(guards,
+			replayGuard.Middleware(),
+			ipAllowlist.Middleware(),
+			rateLimiter.Middleware(),
+		)
 	}
 
 	svr, err := http.NewServer(httputil.NewHTTPAddressFromContext(c),
 		auth,
 		perm,
+		guards,
 		noAuth,
 		logger,
-		http.WithCoreEndpoint(c.String(coreEndpointFlag)),
-		http.WithSettingEndpoint(c.String(settingEndpointFlag)),
+		options...,
 	)
 	if err != nil {
 		return errors.Wrap(err, "create new server error")
 	}
-	return svr.Start()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- svr.Start()
+	}()
+	select {
+	case sig := <-signals:
+		logger.Sugar().Infow("shutting down", "signal", sig.String())
+		return nil
+	case err = <-errCh:
+		return err
+	}
 }
@@ -7,7 +7,6 @@ import (
 
 	libhttputil "github.com/KyberNetwork/reserve-data/lib/httputil"
 	"github.com/gin-contrib/cors"
-	"github.com/gin-contrib/httpsign"
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -31,11 +30,13 @@ func newReverseProxyMW(target string) (gin.HandlerFunc, error) {
 	}, nil
 }
 
-// NewServer creates new instance of gateway HTTP server.
+// NewServer creates new instance of gateway HTTP server, guards run after the signature of
+// request is verified and before permission is checked.
 // TODO: add logger
 func NewServer(addr string,
-	auth *httpsign.Authenticator,
+	auth gin.HandlerFunc,
 	perm gin.HandlerFunc,
+	guards []gin.HandlerFunc,
 	noAuth bool,
 	logger *zap.Logger,
 	options ...Option,
@@ -49,7 +50,8 @@ func NewServer(addr string,
 	r.Use(ginzap.Ginzap(logger, time.RFC3339, true))
 	r.Use(cors.New(corsConfig))
 	if !noAuth {
-		r.Use(auth.Authenticated())
+		r.Use(auth)
+		r.Use(guards...)
 		r.Use(perm)
 	}
 
@@ -1,104 +1,127 @@
 package http
 
 import (
-	"fmt"
+	"github.com/KyberNetwork/httpsign-utils/authenticator"
 
 	"github.com/KyberNetwork/reserve-data/gateway/permission"
-	"github.com/casbin/casbin"
-	"github.com/gin-gonic/gin"
-	scas "github.com/qiangmzsx/string-adapter"
-
-	"github.com/KyberNetwork/httpsign-utils/authenticator"
 )
 
-func addKeyReadPolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET`, key)
-}
-
-func addKeyWritePolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/setting-change-update-exchange, POST
-p, %[1]s, /v3/setting-change-target, POST
-p, %[1]s, /v3/setting-change-pwis, POST
-p, %[1]s, /v3/setting-change-rbquadratic, POST
-p, %[1]s, /v3/setting-change-main, POST
-p, %[1]s, /v3/setting-change-stable, POST
-p, %[1]s, /v3/setting-change-feed-configuration, POST
-p, %[1]s, /v3/update-exchange-status/:id, PUT
-p, %[1]s, /v3/update-feed-status/:name, PUT`, key)
-}
-
-func addKeyConfirmPolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/setting-change-update-exchange/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-target/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-pwis/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-rbquadratic/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-main/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-stable/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/setting-change-feed-configuration/:id, (PUT)|(DELETE)
-p, %[1]s, /v3/hold-rebalance, POST
-p, %[1]s, /v3/enable-rebalance, POST
-p, %[1]s, /v3/hold-set-rate, POST
-p, %[1]s, /v3/enable-set-rate, POST`, key)
-}
+// Built-in roles of gateway, keys of each role are configured by command line flags.
+const (
+	ReadRole       = "read"
+	WriteRole      = "write"
+	ConfirmRole    = "confirm"
+	RebalanceRole  = "rebalance"
+	SuperAdminRole = "super-admin"
+)
 
-func addKeyRebalancePolicy(key string) string {
-	return fmt.Sprintf(`
-p, %[1]s, /*, GET
-p, %[1]s, /v3/price-factor, POST
-p, %[1]s, /v3/cancelorder, POST
-p, %[1]s, /v3/cancel-all-orders, POST
-p, %[1]s, /v3/deposit, POST
-p, %[1]s, /v3/withdraw, POST
-p, %[1]s, /v3/trade, POST
-p, %[1]s, /v3/setrates, POST`, key)
+// builtinRoles are the roles which are not managed by admin API.
+var builtinRoles = []permission.Role{
+	{
+		Name: ReadRole,
+		Rules: []permission.Rule{
+			{Path: "/*", Method: "GET"},
+		},
+	},
+	{
+		Name: WriteRole,
+		Rules: []permission.Rule{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/setting-change-update-exchange", Method: "POST"},
+			{Path: "/v3/setting-change-target", Method: "POST"},
+			{Path: "/v3/setting-change-pwis", Method: "POST"},
+			{Path: "/v3/setting-change-rbquadratic", Method: "POST"},
+			{Path: "/v3/setting-change-main", Method: "POST"},
+			{Path: "/v3/setting-change-stable", Method: "POST"},
+			{Path: "/v3/setting-change-feed-configuration", Method: "POST"},
+			{Path: "/v3/setting-change-risk-limit", Method: "POST"},
+			{Path: "/v3/setting-change-withdraw-address", Method: "POST"},
+			{Path: "/v3/update-exchange-status/:id", Method: "PUT"},
+			{Path: "/v3/exchange", Method: "POST"},
+			{Path: "/v3/update-feed-status/:name", Method: "PUT"},
+			{Path: "/v3/fetcher/jobs", Method: "PUT"},
+		},
+	},
+	{
+		Name: ConfirmRole,
+		Rules: []permission.Rule{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/setting-change-update-exchange/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-target/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-pwis/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-rbquadratic/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-main/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-stable/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-feed-configuration/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-risk-limit/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/setting-change-withdraw-address/:id", Method: "(PUT)|(DELETE)"},
+			{Path: "/v3/hold-rebalance", Method: "POST"},
+			{Path: "/v3/enable-rebalance", Method: "POST"},
+			{Path: "/v3/hold-set-rate", Method: "POST"},
+			{Path: "/v3/enable-set-rate", Method: "POST"},
+		},
+	},
+	{
+		Name: RebalanceRole,
+		Rules: []permission.Rule{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/price-factor", Method: "POST"},
+			{Path: "/v3/cancelorder", Method: "POST"},
+			{Path: "/v3/cancel-all-orders", Method: "POST"},
+			{Path: "/v3/cancel-open-order", Method: "POST"},
+			{Path: "/v3/deposit", Method: "POST"},
+			{Path: "/v3/withdraw", Method: "POST"},
+			{Path: "/v3/reserve-withdraw", Method: "POST"},
+			{Path: "/v3/transfer", Method: "POST"},
+			{Path: "/v3/internal-transfer", Method: "POST"},
+			{Path: "/v3/trade", Method: "POST"},
+			{Path: "/v3/route-trade", Method: "POST"},
+			{Path: "/v3/executions", Method: "POST"},
+			{Path: "/v3/cancel-execution", Method: "POST"},
+			{Path: "/v3/activity/:id/override", Method: "POST"},
+			{Path: "/v3/setrates", Method: "POST"},
+		},
+	},
+	{
+		Name: SuperAdminRole,
+		Rules: []permission.Rule{
+			{Path: "/*", Method: "GET"},
+			{Path: "/v3/gateway/*", Method: "(GET)|(POST)|(PUT)|(DELETE)"},
+		},
+	},
 }
 
-//NewPermissioner creates a gin Handle Func to controll permission
-//currently there is only 2 permission for POST/GET requests
-func NewPermissioner(readKeys, writeKeys, confirmKeys, rebalanceKeys []authenticator.KeyPair) (gin.HandlerFunc, error) {
-	const (
-		conf = `
-[request_definition]
-r = sub, obj, act
-
-[policy_definition]
-p = sub, obj, act
-
-[role_definition]
-g = _ , _
-
-[policy_effect]
-e = some(where (p.eft == allow))
-
-[matchers]
-m = g(r.sub, p.sub)  && keyMatch2(r.obj, p.obj) && regexMatch(r.act, p.act)
-`
-	)
-	var pol string
-	for _, key := range readKeys {
-		pol += addKeyReadPolicy(key.AccessKeyID)
-	}
-	for _, key := range writeKeys {
-		pol += addKeyWritePolicy(key.AccessKeyID)
-	}
-	for _, key := range confirmKeys {
-		pol += addKeyConfirmPolicy(key.AccessKeyID)
-	}
-	for _, key := range rebalanceKeys {
-		pol += addKeyRebalancePolicy(key.AccessKeyID)
+// NewStaticPolicy returns the policy of built-in roles and keys configured by command line flags.
+func NewStaticPolicy(readKeys, writeKeys, confirmKeys, rebalanceKeys, superAdminKeys []authenticator.KeyPair) permission.Policy {
+	p := permission.Policy{Roles: append([]permission.Role{}, builtinRoles...)}
+	for _, group := range []struct {
+		role string
+		keys []authenticator.KeyPair
+	}{
+		{ReadRole, readKeys},
+		{WriteRole, writeKeys},
+		{ConfirmRole, confirmKeys},
+		{RebalanceRole, rebalanceKeys},
+		{SuperAdminRole, superAdminKeys},
+	} {
+		for _, key := range group.keys {
+			p.Keys = addKeyRole(p.Keys, key, group.role)
+		}
 	}
+	return p
+}
 
-	sa := scas.NewAdapter(pol)
-	e := casbin.NewEnforcer(casbin.NewModel(conf), sa)
-	if err := e.LoadPolicy(); err != nil {
-		return nil, err
+// addKeyRole binds role to key, the key is added if it is not in keys.
+func addKeyRole(keys []permission.Key, key authenticator.KeyPair, role string) []permission.Key {
+	for i := range keys {
+		if keys[i].AccessKeyID == key.AccessKeyID {
+			keys[i].Roles = append(keys[i].Roles, role)
+			return keys
+		}
 	}
-
-	p := permission.NewPermissioner(e)
-	return p, nil
+	return append(keys, permission.Key{
+		AccessKeyID:     key.AccessKeyID,
+		SecretAccessKey: key.SecretAccessKey,
+		Roles:           []string{role},
+	})
 }
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/gateway/permission"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// WithPolicyAdmin adds admin API managing roles and keys of gateway, only keys of super admin
+// role can use it.
+func WithPolicyAdmin(m *permission.Manager) Option {
+	return func(s *Server) error {
+		g := s.r.Group("/v3/gateway", m.RequireRole(SuperAdminRole))
+		g.GET("/policy", func(c *gin.Context) {
+			httputil.ResponseSuccess(c, httputil.WithData(m.Policy()))
+		})
+		g.POST("/roles", func(c *gin.Context) {
+			var role permission.Role
+			if err := c.ShouldBindJSON(&role); err != nil {
+				httputil.ResponseFailure(c, httputil.WithError(err))
+				return
+			}
+			respond(c, m.SetRole(role))
+		})
+		g.DELETE("/roles/:name", func(c *gin.Context) {
+			respond(c, m.DeleteRole(c.Param("name")))
+		})
+		g.POST("/keys", func(c *gin.Context) {
+			var key permission.Key
+			if err := c.ShouldBindJSON(&key); err != nil {
+				httputil.ResponseFailure(c, httputil.WithError(err))
+				return
+			}
+			respond(c, m.SetKey(key))
+		})
+		g.DELETE("/keys/:id", func(c *gin.Context) {
+			respond(c, m.DeleteKey(c.Param("id")))
+		})
+		g.POST("/reload", func(c *gin.Context) {
+			respond(c, m.Reload())
+		})
+		return nil
+	}
+}
+
+func respond(c *gin.Context, err error) {
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
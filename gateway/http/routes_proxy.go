@@ -21,17 +21,41 @@ func WithCoreEndpoint(coreEndpoint string) Option {
 		g.GET("/authdata-version", coreProxyMW)
 		g.GET("/authdata", coreProxyMW)
 		g.GET("/activities", coreProxyMW)
+		g.GET("/activities/search", coreProxyMW)
+		g.GET("/activities/export", coreProxyMW)
+		g.GET("/activity/:id/timeline", coreProxyMW)
 		g.GET("/immediate-pending-activities", coreProxyMW)
+		g.GET("/reconciliation", coreProxyMW)
+		g.GET("/reconciliation/:exchange", coreProxyMW)
 
 		g.POST("/cancelorder", coreProxyMW)
 		g.POST("/cancel-all-orders", coreProxyMW)
+		g.GET("/open-orders", coreProxyMW)
+		g.POST("/cancel-open-order", coreProxyMW)
 		g.POST("/deposit", coreProxyMW)
 		g.POST("/withdraw", coreProxyMW)
+		g.POST("/reserve-withdraw", coreProxyMW)
+		g.POST("/transfer", coreProxyMW)
+		g.POST("/internal-transfer", coreProxyMW)
 		g.POST("/trade", coreProxyMW)
+		g.POST("/route-trade", coreProxyMW)
+		g.POST("/executions", coreProxyMW)
+		g.GET("/executions", coreProxyMW)
+		g.GET("/executions/:id", coreProxyMW)
+		g.POST("/cancel-execution", coreProxyMW)
+		g.POST("/activity/:id/override", coreProxyMW)
 		g.POST("/setrates", coreProxyMW)
 		g.GET("/tradehistory", coreProxyMW)
+		g.GET("/pnl", coreProxyMW)
+		g.GET("/onchain-trades/stats", coreProxyMW)
+		g.GET("/inventory", coreProxyMW)
+		g.GET("/inventory/snapshots", coreProxyMW)
+		g.GET("/fetcher/jobs", coreProxyMW)
+		g.PUT("/fetcher/jobs", coreProxyMW)
+		g.GET("/leader", coreProxyMW)
 
 		g.GET("/timeserver", coreProxyMW)
+		g.GET("/nodes", coreProxyMW)
 
 		g.GET("/gold-feed", coreProxyMW)
 		g.GET("/btc-feed", coreProxyMW)
@@ -39,6 +63,8 @@ func WithCoreEndpoint(coreEndpoint string) Option {
 
 		g.GET("/addresses", coreProxyMW)
 
+		g.GET("/risk-limit-utilisation", coreProxyMW)
+
 		return nil
 	}
 }
@@ -56,6 +82,7 @@ func WithSettingEndpoint(settingEndpoint string) Option {
 		g.GET("/asset", settingProxyMW)
 		g.GET("/exchange/:id", settingProxyMW)
 		g.GET("/exchange", settingProxyMW)
+		g.POST("/exchange", settingProxyMW)
 		g.GET("trading-pair/:id", settingProxyMW)
 		g.GET("/stable-token-params", settingProxyMW)
 		g.GET("/feed-configurations", settingProxyMW)
@@ -104,6 +131,20 @@ func WithSettingEndpoint(settingEndpoint string) Option {
 		g.DELETE("/setting-change-feed-configuration/:id", settingProxyMW)
 		g.PUT("/update-feed-status/:name", settingProxyMW)
 
+		g.GET("/risk-limits", settingProxyMW)
+		g.POST("/setting-change-risk-limit", settingProxyMW)
+		g.GET("/setting-change-risk-limit", settingProxyMW)
+		g.GET("/setting-change-risk-limit/:id", settingProxyMW)
+		g.PUT("/setting-change-risk-limit/:id", settingProxyMW)
+		g.DELETE("/setting-change-risk-limit/:id", settingProxyMW)
+
+		g.GET("/withdraw-addresses", settingProxyMW)
+		g.POST("/setting-change-withdraw-address", settingProxyMW)
+		g.GET("/setting-change-withdraw-address", settingProxyMW)
+		g.GET("/setting-change-withdraw-address/:id", settingProxyMW)
+		g.PUT("/setting-change-withdraw-address/:id", settingProxyMW)
+		g.DELETE("/setting-change-withdraw-address/:id", settingProxyMW)
+
 		g.GET("/rebalance-status", settingProxyMW)
 		g.POST("/hold-rebalance", settingProxyMW)
 		g.POST("/enable-rebalance", settingProxyMW)
@@ -0,0 +1,343 @@
+package permission
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/KyberNetwork/httpsign-utils/authenticator"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNoStore is returned when policy is changed without a store to persist it.
+	ErrNoStore = errors.New("policy store is not configured")
+	// ErrReadOnly is returned when admin API changes a role or key which is not managed by it.
+	ErrReadOnly = errors.New("role or key is read only")
+	// ErrNotFound is returned when the role or key does not exist.
+	ErrNotFound = errors.New("role or key not found")
+	// ErrRoleInUse is returned when a role bound to a key is deleted.
+	ErrRoleInUse = errors.New("role is bound to a key")
+)
+
+// Manager authenticates and authorizes requests with the policy made of the static roles and
+// keys from configuration and the ones in store. The policy in store is managed by admin API
+// and is reloaded without restarting gateway.
+type Manager struct {
+	static Policy
+	store  Store
+
+	// writeMu serializes changes of the policy in store
+	writeMu sync.Mutex
+
+	mu      sync.RWMutex
+	dynamic Policy
+	policy  Policy
+	auth    gin.HandlerFunc
+	perm    *Permissioner
+
+	hooks []func(Policy)
+	l     *zap.SugaredLogger
+}
+
+// NewManager creates a Manager with static policy and optional store, policy in store is
+// loaded immediately.
+func NewManager(static Policy, store Store) (*Manager, error) {
+	for i := range static.Roles {
+		static.Roles[i].ReadOnly = true
+	}
+	for i := range static.Keys {
+		static.Keys[i].ReadOnly = true
+	}
+	m := &Manager{static: static, store: store, l: zap.S()}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OnReload registers hook called with the effective policy whenever it is reloaded.
+func (m *Manager) OnReload(hook func(Policy)) {
+	m.mu.Lock()
+	m.hooks = append(m.hooks, hook)
+	policy := m.policy
+	m.mu.Unlock()
+	hook(policy)
+}
+
+// Reload reloads policy from store.
+func (m *Manager) Reload() error {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+	dynamic := Policy{}
+	if m.store != nil {
+		var err error
+		if dynamic, err = m.store.Load(); err != nil {
+			return err
+		}
+	}
+	return m.apply(dynamic)
+}
+
+// apply builds authenticator and enforcer of the policy and swaps them in.
+func (m *Manager) apply(dynamic Policy) error {
+	for i := range dynamic.Roles {
+		dynamic.Roles[i].ReadOnly = false
+	}
+	for i := range dynamic.Keys {
+		dynamic.Keys[i].ReadOnly = false
+	}
+	policy, err := m.static.merge(dynamic)
+	if err != nil {
+		return err
+	}
+	var keyPairs []authenticator.KeyPair
+	for _, key := range policy.Keys {
+		keyPairs = append(keyPairs, authenticator.KeyPair{
+			AccessKeyID:     key.AccessKeyID,
+			SecretAccessKey: key.SecretAccessKey,
+		})
+	}
+	auth, err := authenticator.NewAuthenticator(keyPairs...)
+	if err != nil {
+		return err
+	}
+	e, err := NewEnforcer(policy)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.dynamic = dynamic
+	m.policy = policy
+	m.auth = auth.Authenticated()
+	m.perm = &Permissioner{enforcer: e}
+	hooks := m.hooks
+	m.mu.Unlock()
+	for _, hook := range hooks {
+		hook(policy)
+	}
+	m.l.Infow("applied gateway policy", "roles", len(policy.Roles), "keys", len(policy.Keys))
+	return nil
+}
+
+// Watch reloads policy from store every interval until stop is closed, the policy is only
+// applied when it is changed.
+func (m *Manager) Watch(interval time.Duration, stop <-chan struct{}) {
+	if m.store == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		if err := m.reloadChanged(); err != nil {
+			m.l.Errorw("failed to reload gateway policy, keeping the current one", "err", err)
+		}
+	}
+}
+
+// reloadChanged applies policy in store if it is changed.
+func (m *Manager) reloadChanged() error {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+	dynamic, err := m.store.Load()
+	if err != nil {
+		return err
+	}
+	m.mu.RLock()
+	changed := !reflect.DeepEqual(dynamic, m.dynamic)
+	m.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+	return m.apply(dynamic)
+}
+
+// Authenticated returns a gin middleware verifying signature of request with the current keys.
+func (m *Manager) Authenticated() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m.mu.RLock()
+		auth := m.auth
+		m.mu.RUnlock()
+		auth(c)
+	}
+}
+
+// Permission returns a gin middleware checking permission of request with the current policy.
+func (m *Manager) Permission() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m.mu.RLock()
+		p := m.perm
+		m.mu.RUnlock()
+		if !p.checkPermission(c.Request) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"reason": ErrNotPermit.Error()})
+			return
+		}
+	}
+}
+
+// RequireRole returns a gin middleware only allowing keys bound to role, it is used for admin API
+// as wildcard rules of other roles such as "/*, GET" also match admin paths.
+func (m *Manager) RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyID, err := getKeyID(c.Request)
+		m.mu.RLock()
+		ok := err == nil && m.policy.hasRole(keyID, role)
+		m.mu.RUnlock()
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"reason": ErrNotPermit.Error()})
+			return
+		}
+	}
+}
+
+// Policy returns the current policy without secrets.
+func (m *Manager) Policy() Policy {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := Policy{
+		Roles: append([]Role{}, m.policy.Roles...),
+		Keys:  append([]Key{}, m.policy.Keys...),
+	}
+	for i := range result.Keys {
+		result.Keys[i].SecretAccessKey = ""
+	}
+	return result
+}
+
+// update changes policy in store with fn, then saves and applies it if it is valid.
+func (m *Manager) update(fn func(p *Policy) error) error {
+	if m.store == nil {
+		return ErrNoStore
+	}
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+	dynamic, err := m.store.Load()
+	if err != nil {
+		return err
+	}
+	if err = fn(&dynamic); err != nil {
+		return err
+	}
+	if _, err = m.static.merge(dynamic); err != nil {
+		return err
+	}
+	if err = m.store.Save(dynamic); err != nil {
+		return err
+	}
+	return m.apply(dynamic)
+}
+
+func (m *Manager) isStaticRole(name string) bool {
+	for _, role := range m.static.Roles {
+		if role.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) isStaticKey(keyID string) bool {
+	for _, key := range m.static.Keys {
+		if key.AccessKeyID == keyID {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRole creates or replaces a role.
+func (m *Manager) SetRole(role Role) error {
+	if m.isStaticRole(role.Name) {
+		return ErrReadOnly
+	}
+	return m.update(func(p *Policy) error {
+		for i := range p.Roles {
+			if p.Roles[i].Name == role.Name {
+				p.Roles[i] = role
+				return nil
+			}
+		}
+		p.Roles = append(p.Roles, role)
+		return nil
+	})
+}
+
+// DeleteRole deletes a role, it fails if the role is bound to a key.
+func (m *Manager) DeleteRole(name string) error {
+	if m.isStaticRole(name) {
+		return ErrReadOnly
+	}
+	return m.update(func(p *Policy) error {
+		if boundToKey(p.Keys, name) || boundToKey(m.static.Keys, name) {
+			return ErrRoleInUse
+		}
+		for i := range p.Roles {
+			if p.Roles[i].Name == name {
+				p.Roles = append(p.Roles[:i], p.Roles[i+1:]...)
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+}
+
+// boundToKey returns true if role is bound to any of keys.
+func boundToKey(keys []Key, role string) bool {
+	for _, key := range keys {
+		for _, name := range key.Roles {
+			if name == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetKey creates or replaces a key, secret of an existing key is kept if it is not given.
+func (m *Manager) SetKey(key Key) error {
+	if m.isStaticKey(key.AccessKeyID) {
+		return ErrReadOnly
+	}
+	return m.update(func(p *Policy) error {
+		for i := range p.Keys {
+			if p.Keys[i].AccessKeyID == key.AccessKeyID {
+				if key.SecretAccessKey == "" {
+					key.SecretAccessKey = p.Keys[i].SecretAccessKey
+				}
+				p.Keys[i] = key
+				return nil
+			}
+		}
+		if key.SecretAccessKey == "" {
+			return fmt.Errorf("missing secret of access key %s", key.AccessKeyID)
+		}
+		p.Keys = append(p.Keys, key)
+		return nil
+	})
+}
+
+// DeleteKey deletes a key.
+func (m *Manager) DeleteKey(keyID string) error {
+	if m.isStaticKey(keyID) {
+		return ErrReadOnly
+	}
+	return m.update(func(p *Policy) error {
+		for i := range p.Keys {
+			if p.Keys[i].AccessKeyID == keyID {
+				p.Keys = append(p.Keys[:i], p.Keys[i+1:]...)
+				return nil
+			}
+		}
+		return ErrNotFound
+	})
+}
@@ -0,0 +1,127 @@
+package permission
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) (*Manager, *FileStore, func()) {
+	dir, err := ioutil.TempDir("", "gateway-policy")
+	require.NoError(t, err)
+	store := NewFileStore(filepath.Join(dir, "policy.json"))
+	static := Policy{
+		Roles: []Role{{Name: "read", Rules: []Rule{{Path: "/*", Method: "GET"}}}},
+		Keys:  []Key{{AccessKeyID: "reader", SecretAccessKey: "s1", Roles: []string{"read"}}},
+	}
+	m, err := NewManager(static, store)
+	require.NoError(t, err)
+	return m, store, func() { _ = os.RemoveAll(dir) }
+}
+
+func permitted(m *Manager, keyID, method, path string) bool {
+	r := newTestRouter(m.Permission())
+	r.Handle(method, path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(signatureHeader, `keyId="`+keyID+`",algorithm="hmac-sha512",signature="sig"`)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code == http.StatusOK
+}
+
+func TestManager(t *testing.T) {
+	m, store, cleanup := newTestManager(t)
+	defer cleanup()
+
+	assert.Equal(t, ErrReadOnly, m.SetRole(Role{Name: "read"}))
+	assert.Equal(t, ErrReadOnly, m.DeleteKey("reader"))
+	assert.Equal(t, ErrNotFound, m.DeleteRole("write"))
+
+	require.NoError(t, m.SetRole(Role{Name: "write", Rules: []Rule{{Path: "/v3/prices", Method: "POST"}}}))
+	assert.Error(t, m.SetKey(Key{AccessKeyID: "writer", Roles: []string{"write"}}), "secret is required for new key")
+	require.NoError(t, m.SetKey(Key{AccessKeyID: "writer", SecretAccessKey: "s2", Roles: []string{"write"}}))
+	assert.True(t, permitted(m, "writer", http.MethodPost, "/v3/prices"))
+	assert.False(t, permitted(m, "reader", http.MethodPost, "/v3/prices"))
+	assert.Equal(t, ErrRoleInUse, m.DeleteRole("write"), "role bound to a key can not be deleted")
+	assert.True(t, permitted(m, "writer", http.MethodPost, "/v3/prices"))
+
+	// secret is kept when key is updated without it, and is not returned
+	require.NoError(t, m.SetKey(Key{AccessKeyID: "writer", Roles: []string{"write", "read"}}))
+	stored, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "s2", stored.Keys[0].SecretAccessKey)
+	for _, key := range m.Policy().Keys {
+		assert.Empty(t, key.SecretAccessKey)
+		assert.Equal(t, key.AccessKeyID == "reader", key.ReadOnly)
+	}
+	assert.True(t, m.Policy().hasRole("writer", "read"))
+
+	require.NoError(t, m.DeleteKey("writer"))
+	assert.False(t, permitted(m, "writer", http.MethodPost, "/v3/prices"))
+	assert.True(t, permitted(m, "reader", http.MethodGet, "/v3/prices"))
+
+	// the role is deleted once no key is bound to it
+	require.NoError(t, m.DeleteRole("write"))
+	assert.Equal(t, ErrNotFound, m.DeleteRole("write"))
+}
+
+func TestManagerReload(t *testing.T) {
+	m, store, cleanup := newTestManager(t)
+	defer cleanup()
+	var applied []Policy
+	m.OnReload(func(p Policy) { applied = append(applied, p) })
+	require.Len(t, applied, 1)
+
+	require.NoError(t, m.reloadChanged())
+	assert.Len(t, applied, 1, "unchanged policy is not applied")
+
+	// file edited by hand
+	require.NoError(t, store.Save(Policy{
+		Roles: []Role{{Name: "write", Rules: []Rule{{Path: "/v3/prices", Method: "POST"}}}},
+		Keys:  []Key{{AccessKeyID: "writer", SecretAccessKey: "s2", Roles: []string{"write"}}},
+	}))
+	require.NoError(t, m.reloadChanged())
+	assert.Len(t, applied, 2)
+	assert.True(t, permitted(m, "writer", http.MethodPost, "/v3/prices"))
+
+	// invalid policy keeps the current one
+	require.NoError(t, store.Save(Policy{
+		Keys: []Key{{AccessKeyID: "reader", SecretAccessKey: "s3", Roles: []string{"read"}}},
+	}))
+	assert.Error(t, m.reloadChanged())
+	assert.Len(t, applied, 2)
+	assert.True(t, permitted(m, "writer", http.MethodPost, "/v3/prices"))
+}
+
+func TestRoleLimits(t *testing.T) {
+	p := Policy{Keys: []Key{
+		{AccessKeyID: "reader", Roles: []string{"read"}},
+		{AccessKeyID: "operator", Roles: []string{"read", "write"}},
+		{AccessKeyID: "other", Roles: []string{"confirm"}},
+	}}
+	limits := RoleLimits(p, map[string]Limit{
+		"read":  {Rate: 10, Burst: 20},
+		"write": {Rate: 1, Burst: 1},
+	})
+	assert.Equal(t, map[KeyID]Limit{
+		"reader":   {Rate: 10, Burst: 20},
+		"operator": {Rate: 10, Burst: 20},
+	}, limits)
+
+	rl := NewRateLimiter(map[KeyID]Limit{"reader": {Rate: 1, Burst: 1}})
+	ok, _ := rl.allow("reader")
+	require.True(t, ok)
+	ok, _ = rl.allow("reader")
+	require.False(t, ok)
+	// bucket of changed limit is refilled
+	rl.SetLimits(map[KeyID]Limit{"reader": {Rate: 2, Burst: 1}})
+	ok, _ = rl.allow("reader")
+	assert.True(t, ok)
+}
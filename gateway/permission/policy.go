@@ -0,0 +1,153 @@
+package permission
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/casbin/casbin"
+	scas "github.com/qiangmzsx/string-adapter"
+)
+
+// casbinModel grants requests of a key by the rules of roles bound to it.
+const casbinModel = `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[role_definition]
+g = _ , _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub)  && keyMatch2(r.obj, p.obj) && regexMatch(r.act, p.act)
+`
+
+// Rule allows requests to Path with a method matching Method. Path supports keyMatch2 patterns
+// such as /v3/setting-change-target/:id and /*, Method is a regular expression such as
+// (PUT)|(DELETE).
+type Rule struct {
+	Path   string `json:"path" binding:"required"`
+	Method string `json:"method" binding:"required"`
+}
+
+// Role is a named set of rules.
+type Role struct {
+	Name  string `json:"name" binding:"required"`
+	Rules []Rule `json:"rules" binding:"required,dive"`
+	// ReadOnly marks roles which are not managed by admin API.
+	ReadOnly bool `json:"read_only"`
+}
+
+// Key is an access key with the roles bound to it.
+type Key struct {
+	AccessKeyID     string   `json:"access_key_id" binding:"required"`
+	SecretAccessKey string   `json:"secret_access_key,omitempty"`
+	Roles           []string `json:"roles" binding:"required"`
+	// ReadOnly marks keys which are not managed by admin API.
+	ReadOnly bool `json:"read_only"`
+}
+
+// Policy is the roles and keys of gateway.
+type Policy struct {
+	Roles []Role `json:"roles"`
+	Keys  []Key  `json:"keys"`
+}
+
+func validName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, ", \t\r\n#")
+}
+
+// merge returns policy with roles and keys of other appended, roles and keys of other must not
+// override the ones of policy.
+func (p Policy) merge(other Policy) (Policy, error) {
+	result := Policy{
+		Roles: append(append([]Role{}, p.Roles...), other.Roles...),
+		Keys:  append(append([]Key{}, p.Keys...), other.Keys...),
+	}
+	return result, result.validate()
+}
+
+// validate checks that names are unique, rules can be written as casbin policy and keys are
+// bound to existing roles.
+func (p Policy) validate() error {
+	roles := make(map[string]bool)
+	for _, role := range p.Roles {
+		if !validName(role.Name) {
+			return fmt.Errorf("invalid role name %q", role.Name)
+		}
+		if roles[role.Name] {
+			return fmt.Errorf("duplicated role %s", role.Name)
+		}
+		roles[role.Name] = true
+		for _, rule := range role.Rules {
+			if !validName(rule.Path) || rule.Method == "" || strings.ContainsAny(rule.Method, ",\r\n#") {
+				return fmt.Errorf("invalid rule %+v of role %s", rule, role.Name)
+			}
+		}
+	}
+	keys := make(map[string]bool)
+	for _, key := range p.Keys {
+		if !validName(key.AccessKeyID) {
+			return fmt.Errorf("invalid access key %q", key.AccessKeyID)
+		}
+		// casbin matches subject of request to roles too, a key named as a role would get its rules
+		if roles[key.AccessKeyID] {
+			return fmt.Errorf("access key %s is the name of a role", key.AccessKeyID)
+		}
+		if keys[key.AccessKeyID] {
+			return fmt.Errorf("duplicated access key %s", key.AccessKeyID)
+		}
+		keys[key.AccessKeyID] = true
+		if key.SecretAccessKey == "" {
+			return fmt.Errorf("missing secret of access key %s", key.AccessKeyID)
+		}
+		for _, role := range key.Roles {
+			if !roles[role] {
+				return fmt.Errorf("role %s of access key %s does not exist", role, key.AccessKeyID)
+			}
+		}
+	}
+	return nil
+}
+
+// hasRole returns whether the key is bound to the role.
+func (p Policy) hasRole(keyID KeyID, role string) bool {
+	for _, key := range p.Keys {
+		if key.AccessKeyID != string(keyID) {
+			continue
+		}
+		for _, r := range key.Roles {
+			if r == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewEnforcer creates casbin enforcer of the policy.
+func NewEnforcer(p Policy) (*casbin.Enforcer, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	var pol strings.Builder
+	for _, role := range p.Roles {
+		for _, rule := range role.Rules {
+			fmt.Fprintf(&pol, "p, %s, %s, %s\n", role.Name, rule.Path, rule.Method)
+		}
+	}
+	for _, key := range p.Keys {
+		for _, role := range key.Roles {
+			fmt.Fprintf(&pol, "g, %s, %s\n", key.AccessKeyID, role)
+		}
+	}
+	e := casbin.NewEnforcer(casbin.NewModel(casbinModel), scas.NewAdapter(pol.String()))
+	if err := e.LoadPolicy(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,74 @@
+package permission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnforcer(t *testing.T) {
+	p := Policy{
+		Roles: []Role{
+			{Name: "read", Rules: []Rule{{Path: "/*", Method: "GET"}}},
+			{Name: "target", Rules: []Rule{{Path: "/v3/setting-change-target/:id", Method: "(PUT)|(DELETE)"}}},
+		},
+		Keys: []Key{
+			{AccessKeyID: "reader", SecretAccessKey: "s1", Roles: []string{"read"}},
+			{AccessKeyID: "operator", SecretAccessKey: "s2", Roles: []string{"read", "target"}},
+		},
+	}
+	e, err := NewEnforcer(p)
+	require.NoError(t, err)
+
+	assert.True(t, e.Enforce("reader", "/v3/price", "GET"))
+	assert.False(t, e.Enforce("reader", "/v3/setting-change-target/1", "PUT"))
+	assert.True(t, e.Enforce("operator", "/v3/setting-change-target/1", "DELETE"))
+	assert.False(t, e.Enforce("operator", "/v3/setting-change-target/1", "POST"))
+	assert.False(t, e.Enforce("unknown", "/v3/price", "GET"))
+}
+
+func TestPolicyValidate(t *testing.T) {
+	role := Role{Name: "read", Rules: []Rule{{Path: "/*", Method: "GET"}}}
+	var tests = []struct {
+		name   string
+		policy Policy
+	}{
+		{
+			name:   "invalid role name",
+			policy: Policy{Roles: []Role{{Name: "read, write"}}},
+		},
+		{
+			name:   "duplicated role",
+			policy: Policy{Roles: []Role{role, role}},
+		},
+		{
+			name:   "invalid rule",
+			policy: Policy{Roles: []Role{{Name: "read", Rules: []Rule{{Path: "/*", Method: "GET, write"}}}}},
+		},
+		{
+			name: "key named as role",
+			policy: Policy{Roles: []Role{role}, Keys: []Key{
+				{AccessKeyID: "read", SecretAccessKey: "s", Roles: []string{"read"}},
+			}},
+		},
+		{
+			name: "duplicated key",
+			policy: Policy{Roles: []Role{role}, Keys: []Key{
+				{AccessKeyID: "k", SecretAccessKey: "s", Roles: []string{"read"}},
+				{AccessKeyID: "k", SecretAccessKey: "s", Roles: []string{"read"}},
+			}},
+		},
+		{
+			name:   "missing secret",
+			policy: Policy{Roles: []Role{role}, Keys: []Key{{AccessKeyID: "k", Roles: []string{"read"}}}},
+		},
+		{
+			name:   "unknown role",
+			policy: Policy{Roles: []Role{role}, Keys: []Key{{AccessKeyID: "k", SecretAccessKey: "s", Roles: []string{"write"}}}},
+		},
+	}
+	for _, tc := range tests {
+		assert.Error(t, tc.policy.validate(), tc.name)
+	}
+}
@@ -0,0 +1,213 @@
+package permission
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const retryAfterHeader = "Retry-After"
+
+var (
+	// ErrRateLimited is returned when a key sends requests faster than its rate limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrIPNotAllowed is returned when a key is used from an address not in its allowlist.
+	ErrIPNotAllowed = errors.New("request address is not allowed for the key")
+)
+
+// Limit is the token bucket configuration of a key, Rate tokens are added per second up to Burst
+// tokens and every request takes a token.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type tokenBucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// take takes a token from the bucket, it returns the duration to wait for the next token if
+// the bucket is empty.
+func (b *tokenBucket) take(now time.Time) (bool, time.Duration) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := (1 - b.tokens) / b.limit.Rate
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// RateLimiter limits request rate of each key with a token bucket, keys without limit are not
+// limited.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limits  map[KeyID]Limit
+	buckets map[KeyID]*tokenBucket
+	now     func() time.Time
+}
+
+// NewRateLimiter creates a RateLimiter with limits by key.
+func NewRateLimiter(limits map[KeyID]Limit) *RateLimiter {
+	rl := &RateLimiter{
+		buckets: make(map[KeyID]*tokenBucket),
+		now:     time.Now,
+	}
+	rl.SetLimits(limits)
+	return rl
+}
+
+// SetLimits replaces limits by key, burst is at least one request. Buckets of keys which limit
+// is changed are refilled.
+func (rl *RateLimiter) SetLimits(limits map[KeyID]Limit) {
+	normalized := make(map[KeyID]Limit, len(limits))
+	for keyID, limit := range limits {
+		if limit.Burst < 1 {
+			limit.Burst = 1
+		}
+		normalized[keyID] = limit
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	for keyID, b := range rl.buckets {
+		if limit, ok := normalized[keyID]; !ok || limit != b.limit {
+			delete(rl.buckets, keyID)
+		}
+	}
+	rl.limits = normalized
+}
+
+// RoleLimits returns limits of keys in policy from limits of their roles, a key with many roles
+// takes the highest rate.
+func RoleLimits(p Policy, limits map[string]Limit) map[KeyID]Limit {
+	result := make(map[KeyID]Limit)
+	for _, key := range p.Keys {
+		for _, role := range key.Roles {
+			limit, ok := limits[role]
+			if !ok {
+				continue
+			}
+			keyID := KeyID(key.AccessKeyID)
+			if current, ok := result[keyID]; ok && current.Rate >= limit.Rate {
+				continue
+			}
+			result[keyID] = limit
+		}
+	}
+	return result
+}
+
+// allow returns whether a request of key is allowed and the duration to wait otherwise.
+func (rl *RateLimiter) allow(keyID KeyID) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	limit, ok := rl.limits[keyID]
+	if !ok || limit.Rate <= 0 {
+		return true, 0
+	}
+	now := rl.now()
+	b, ok := rl.buckets[keyID]
+	if !ok {
+		b = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		rl.buckets[keyID] = b
+	}
+	return b.take(now)
+}
+
+// Middleware returns a gin middleware responding 429 with Retry-After header in seconds when
+// the key of request exceeds its rate limit.
+func (rl *RateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyID, err := getKeyID(c.Request)
+		if err != nil {
+			return
+		}
+		ok, wait := rl.allow(keyID)
+		if ok {
+			return
+		}
+		retryAfter := int(math.Ceil(wait.Seconds()))
+		c.Header(retryAfterHeader, strconv.Itoa(retryAfter))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"reason":      ErrRateLimited.Error(),
+			"retry_after": wait.Milliseconds(),
+		})
+		zap.S().Warnw("rate limited request", "key_id", keyID, "path", c.Request.URL.Path, "retry_after", wait)
+	}
+}
+
+// IPAllowlist restricts keys to be used from the configured networks, keys without networks can
+// be used from any address.
+type IPAllowlist struct {
+	networks map[KeyID][]*net.IPNet
+}
+
+// NewIPAllowlist creates IPAllowlist from networks in CIDR notation or single IP addresses by key.
+func NewIPAllowlist(allowlist map[KeyID][]string) (*IPAllowlist, error) {
+	networks := make(map[KeyID][]*net.IPNet)
+	for keyID, addresses := range allowlist {
+		for _, address := range addresses {
+			_, network, err := net.ParseCIDR(address)
+			if err != nil {
+				ip := net.ParseIP(address)
+				if ip == nil {
+					return nil, err
+				}
+				bits := 8 * len(ip.To16())
+				if ip4 := ip.To4(); ip4 != nil {
+					ip, bits = ip4, 8*net.IPv4len
+				}
+				network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+			}
+			networks[keyID] = append(networks[keyID], network)
+		}
+	}
+	return &IPAllowlist{networks: networks}, nil
+}
+
+func (a *IPAllowlist) allow(keyID KeyID, ip net.IP) bool {
+	networks, ok := a.networks[keyID]
+	if !ok {
+		return true
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware returns a gin middleware rejecting requests of keys from addresses not in allowlist.
+// The address is the remote address of connection, forwarded headers are not trusted as they
+// can be set by client.
+func (a *IPAllowlist) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyID, err := getKeyID(c.Request)
+		if err != nil {
+			return
+		}
+		host, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		if err != nil {
+			host = c.Request.RemoteAddr
+		}
+		if ip := net.ParseIP(host); ip != nil && a.allow(keyID, ip) {
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"reason": ErrIPNotAllowed.Error()})
+		zap.S().Warnw("rejected request from address", "key_id", keyID, "address", host)
+	}
+}
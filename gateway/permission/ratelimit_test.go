@@ -0,0 +1,95 @@
+package permission
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := NewRateLimiter(map[KeyID]Limit{
+		"read":  {Rate: 2, Burst: 3},
+		"write": {Rate: 0.5},
+	})
+	rl.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		ok, _ := rl.allow("read")
+		assert.True(t, ok)
+	}
+	ok, wait := rl.allow("read")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	now = now.Add(250 * time.Millisecond)
+	ok, wait = rl.allow("read")
+	assert.False(t, ok)
+	assert.Equal(t, 250*time.Millisecond, wait)
+
+	now = now.Add(250 * time.Millisecond)
+	ok, _ = rl.allow("read")
+	assert.True(t, ok)
+
+	// burst is at least one request
+	ok, _ = rl.allow("write")
+	assert.True(t, ok)
+	ok, wait = rl.allow("write")
+	assert.False(t, ok)
+	assert.Equal(t, 2*time.Second, wait)
+
+	// keys without limit are not limited
+	for i := 0; i < 100; i++ {
+		ok, _ = rl.allow("confirm")
+		assert.True(t, ok)
+	}
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	r := newTestRouter(NewRateLimiter(map[KeyID]Limit{"read": {Rate: 0.4, Burst: 1}}).Middleware())
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("read", "sig1", time.Now()))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("read", "sig2", time.Now()))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "3", w.Header().Get(retryAfterHeader))
+	assert.Contains(t, w.Body.String(), ErrRateLimited.Error())
+}
+
+func TestIPAllowlist(t *testing.T) {
+	_, err := NewIPAllowlist(map[KeyID][]string{"read": {"10.0.0"}})
+	assert.Error(t, err)
+
+	a, err := NewIPAllowlist(map[KeyID][]string{
+		"read":  {"10.0.0.0/8", "192.168.1.1"},
+		"write": {"::1"},
+	})
+	require.NoError(t, err)
+	assert.True(t, a.allow("read", net.ParseIP("10.1.2.3")))
+	assert.True(t, a.allow("read", net.ParseIP("192.168.1.1")))
+	assert.False(t, a.allow("read", net.ParseIP("192.168.1.2")))
+	assert.True(t, a.allow("write", net.ParseIP("::1")))
+	assert.False(t, a.allow("write", net.ParseIP("127.0.0.1")))
+	assert.True(t, a.allow("confirm", net.ParseIP("8.8.8.8")))
+
+	r := newTestRouter(a.Middleware())
+	req := signedRequest("read", "sig1", time.Now())
+	req.RemoteAddr = "10.0.0.1:1234"
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req = signedRequest("read", "sig1", time.Now())
+	req.RemoteAddr = "11.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "10.0.0.1")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
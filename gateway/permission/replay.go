@@ -0,0 +1,133 @@
+package permission
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	nonceHeader        = "Nonce"
+	signatureField     = "signature"
+	defaultReplayCache = 100000
+)
+
+var (
+	// ErrCouldNotGetSignature error when could not get signature in header
+	ErrCouldNotGetSignature = errors.New("could not get signature in header")
+	// ErrReplayedRequest is returned when a signed request is submitted more than once.
+	ErrReplayedRequest = errors.New("request has already been submitted")
+	// ErrNonceOutOfWindow is returned when nonce of request is not in the accepted time window.
+	ErrNonceOutOfWindow = errors.New("nonce is not in accepted time window")
+	// ErrReplayCacheFull is returned when there are too many requests in the time window to remember.
+	ErrReplayCacheFull = errors.New("too many requests in replay window")
+)
+
+type seenRequest struct {
+	key    string
+	expiry time.Time
+}
+
+// ReplayGuard rejects signed requests that have been seen in the time window. A request is
+// identified by its key id and signature, the signature covers the nonce so retrying a request
+// with a new nonce is accepted.
+type ReplayGuard struct {
+	mu     sync.Mutex
+	window time.Duration
+	size   int
+	seen   map[string]struct{}
+	// queue is ordered by expiry as every request is kept for the same duration.
+	queue []seenRequest
+	now   func() time.Time
+}
+
+// NewReplayGuard creates a ReplayGuard accepting nonces within window of server time and
+// remembering at most size requests. Requests are rejected instead of forgetting requests which
+// could still be replayed when the cache is full.
+func NewReplayGuard(window time.Duration, size int) *ReplayGuard {
+	if size <= 0 {
+		size = defaultReplayCache
+	}
+	return &ReplayGuard{
+		window: window,
+		size:   size,
+		seen:   make(map[string]struct{}),
+		now:    time.Now,
+	}
+}
+
+// check records the request and returns error if it is not in window or is replayed.
+func (g *ReplayGuard) check(keyID KeyID, nonce, signature string) error {
+	ms, err := strconv.ParseInt(nonce, 10, 64)
+	if err != nil {
+		return ErrNonceOutOfWindow
+	}
+	now := g.now()
+	gap := now.Sub(time.Unix(0, ms*int64(time.Millisecond)))
+	if gap > g.window || gap < -g.window {
+		return ErrNonceOutOfWindow
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for len(g.queue) > 0 && !g.queue[0].expiry.After(now) {
+		delete(g.seen, g.queue[0].key)
+		g.queue = g.queue[1:]
+	}
+	key := string(keyID) + ":" + signature
+	if _, ok := g.seen[key]; ok {
+		return ErrReplayedRequest
+	}
+	if len(g.seen) >= g.size {
+		return ErrReplayCacheFull
+	}
+	// a nonce is accepted from window before to window after it, the request must be
+	// remembered until then
+	g.seen[key] = struct{}{}
+	g.queue = append(g.queue, seenRequest{key: key, expiry: now.Add(2 * g.window)})
+	return nil
+}
+
+// Middleware returns a gin middleware rejecting replayed requests, it must be used after
+// signature of request is verified.
+func (g *ReplayGuard) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyID, signature, err := getSignature(c.Request)
+		if err == nil {
+			err = g.check(keyID, c.Request.Header.Get(nonceHeader), signature)
+		}
+		switch err {
+		case nil:
+			return
+		case ErrReplayCacheFull:
+			c.Header(retryAfterHeader, strconv.Itoa(int(g.window/time.Second)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"reason": err.Error()})
+		default:
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"reason": err.Error()})
+		}
+		zap.S().Warnw("rejected request", "key_id", keyID, "path", c.Request.URL.Path, "err", err)
+	}
+}
+
+// getSignature returns key id and signature of the signed request.
+func getSignature(r *http.Request) (KeyID, string, error) {
+	s := r.Header.Get(authorizationHeader)
+	if len(s) == 0 {
+		s = r.Header.Get(signatureHeader)
+	}
+	keyID, err := extractKeyID(s)
+	if err != nil {
+		return keyID, "", err
+	}
+	for _, match := range kvRegex.FindAllStringSubmatch(s, -1) {
+		if match[1] == signatureField {
+			return keyID, match[2], nil
+		}
+	}
+	return keyID, "", ErrCouldNotGetSignature
+}
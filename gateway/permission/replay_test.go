@@ -0,0 +1,68 @@
+package permission
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(mw gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(mw)
+	r.GET("/v3/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func signedRequest(keyID, signature string, nonce time.Time) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/v3/test", nil)
+	req.Header.Set(signatureHeader, `keyId="`+keyID+`",algorithm="hmac-sha512",signature="`+signature+`"`)
+	req.Header.Set(nonceHeader, strconv.FormatInt(nonce.UnixNano()/int64(time.Millisecond), 10))
+	return req
+}
+
+func TestReplayGuard(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	g := NewReplayGuard(30*time.Second, 2)
+	g.now = func() time.Time { return now }
+
+	require.NoError(t, g.check("read", strconv.FormatInt(now.Unix()*1000, 10), "sig1"))
+	assert.Equal(t, ErrReplayedRequest, g.check("read", strconv.FormatInt(now.Unix()*1000, 10), "sig1"))
+	// same signature of another key is a different request
+	require.NoError(t, g.check("write", strconv.FormatInt(now.Unix()*1000, 10), "sig1"))
+	// cache is full of requests which could still be replayed
+	assert.Equal(t, ErrReplayCacheFull, g.check("read", strconv.FormatInt(now.Unix()*1000, 10), "sig2"))
+
+	assert.Equal(t, ErrNonceOutOfWindow, g.check("read", strconv.FormatInt(now.Add(-31*time.Second).Unix()*1000, 10), "sig3"))
+	assert.Equal(t, ErrNonceOutOfWindow, g.check("read", strconv.FormatInt(now.Add(31*time.Second).Unix()*1000, 10), "sig3"))
+	assert.Equal(t, ErrNonceOutOfWindow, g.check("read", "abc", "sig3"))
+
+	// requests are forgotten after they can no longer be replayed
+	now = now.Add(time.Minute)
+	require.NoError(t, g.check("read", strconv.FormatInt(now.Unix()*1000, 10), "sig1"))
+	require.NoError(t, g.check("read", strconv.FormatInt(now.Unix()*1000, 10), "sig2"))
+}
+
+func TestReplayGuardMiddleware(t *testing.T) {
+	r := newTestRouter(NewReplayGuard(30*time.Second, 10).Middleware())
+	now := time.Now()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("read", "sig1", now))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("read", "sig1", now))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), ErrReplayedRequest.Error())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, signedRequest("read", "sig2", now.Add(-time.Minute)))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,60 @@
+package permission
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Store persists the roles and keys managed by admin API.
+type Store interface {
+	Load() (Policy, error)
+	Save(Policy) error
+}
+
+// FileStore stores policy in a JSON file, the file can also be edited by hand as it is reloaded
+// periodically.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore creates a FileStore of the file at path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load reads policy from the file, an empty policy is returned if the file does not exist.
+func (s *FileStore) Load() (Policy, error) {
+	var p Policy
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return p, err
+	}
+	err = json.Unmarshal(data, &p)
+	return p, err
+}
+
+// Save writes policy to a temporary file then renames it, so the file is never partially written.
+func (s *FileStore) Save(p Policy) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// activityCSVHeader is the columns of exported activities.
+var activityCSVHeader = []string{
+	"id", "action", "exchange", "state", "exchange_status", "mining_status",
+	"asset", "amount", "tx", "order_id", "timestamp",
+}
+
+func activityCSVRow(activity common.ActivityRecord) []string {
+	return []string{
+		activity.ID.String(),
+		activity.Action,
+		activity.Destination,
+		activity.State(),
+		activity.ExchangeStatus,
+		activity.MiningStatus,
+		strconv.FormatUint(activity.Params.Asset, 10),
+		strconv.FormatFloat(activity.Params.Amount, 'f', -1, 64),
+		activity.Result.Tx,
+		activity.Result.ID,
+		string(activity.Timestamp),
+	}
+}
+
+// bindActivityQuery binds the activity query of the request and resolves symbols of its asset
+// on exchanges, so trades of the asset are matched.
+func (s *Server) bindActivityQuery(c *gin.Context) (common.ActivityQuery, error) {
+	var query common.ActivityQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		return query, err
+	}
+	if query.Asset == 0 {
+		return query, nil
+	}
+	asset, err := s.settingStorage.GetAsset(query.Asset)
+	if err != nil {
+		return query, err
+	}
+	query.AssetSymbols = make(map[string]string)
+	for _, ae := range asset.Exchanges {
+		query.AssetSymbols[common.ExchangeID(ae.ExchangeID).String()] = ae.Symbol
+	}
+	return query, nil
+}
+
+// SearchActivities returns a page of activities matching filters of the query
+func (s *Server) SearchActivities(c *gin.Context) {
+	query, err := s.bindActivityQuery(c)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	page, err := s.app.SearchActivities(query)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(page))
+}
+
+// activityExportErrorTrailer is the trailer set to the error which truncates an activity export.
+const activityExportErrorTrailer = "X-Export-Error"
+
+// ExportActivities writes activities matching filters of the query, created between fromTime and
+// toTime, as CSV. Each page is written and flushed as it is read, so a storage error after the first
+// page truncates the file and is set to the X-Export-Error trailer.
+func (s *Server) ExportActivities(c *gin.Context) {
+	query, err := s.bindActivityQuery(c)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if query.FromTime == 0 || query.ToTime == 0 {
+		httputil.ResponseFailure(c, httputil.WithReason("fromTime and toTime are required"))
+		return
+	}
+	if query.ToTime-query.FromTime > common.MaxActivityExportRange {
+		httputil.ResponseFailure(c, httputil.WithReason(fmt.Sprintf("range of fromTime and toTime must not be longer than %d ms",
+			common.MaxActivityExportRange)))
+		return
+	}
+	query.Cursor, query.Limit = "", common.MaxActivityQueryLimit
+	page, err := s.app.SearchActivities(query)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="activities.csv"`)
+	c.Header("Trailer", activityExportErrorTrailer)
+	w := csv.NewWriter(c.Writer)
+	if err = w.Write(activityCSVHeader); err != nil {
+		s.l.Warnw("failed to write activities csv", "err", err)
+		return
+	}
+	for {
+		for _, activity := range page.Activities {
+			if err = w.Write(activityCSVRow(activity)); err != nil {
+				s.l.Warnw("failed to write activities csv", "err", err)
+				return
+			}
+		}
+		w.Flush()
+		if err = w.Error(); err != nil {
+			s.l.Warnw("failed to write activities csv", "err", err)
+			return
+		}
+		c.Writer.Flush()
+		if page.NextCursor == "" {
+			return
+		}
+		query.Cursor = page.NextCursor
+		if page, err = s.app.SearchActivities(query); err != nil {
+			s.l.Warnw("activities csv is truncated", "err", err)
+			c.Writer.Header().Set(activityExportErrorTrailer, err.Error())
+			return
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package http
 
 import (
+	"strings"
+
 	ethereum "github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 
 	"github.com/KyberNetwork/reserve-data/common"
+	huobiblockchain "github.com/KyberNetwork/reserve-data/exchange/huobi/blockchain"
 	"github.com/KyberNetwork/reserve-data/http/httputil"
 )
 
@@ -24,7 +27,11 @@ func (s *Server) GetAddresses(c *gin.Context) {
 	)
 	addresses[pricingOPAddressName] = s.blockchain.GetPricingOPAddress()
 	addresses[depositOPAddressName] = s.blockchain.GetDepositOPAddress()
-	addresses[intermediateOPAddressName] = s.blockchain.GetIntermediatorOPAddress()
+	// the intermediator of the built-in huobi account is intermediate_operator, the ones of the other
+	// accounts are suffixed with their exchange ID
+	for name, address := range s.blockchain.GetIntermediatorOPAddresses() {
+		addresses[intermediateOPAddressName+strings.TrimPrefix(name, huobiblockchain.HuobiOP)] = address
+	}
 	addresses[wrapper] = s.blockchain.GetWrapperAddress()
 	addresses[network] = s.blockchain.GetProxyAddress()
 	addresses[reserveAddress] = s.blockchain.GetReserveAddress()
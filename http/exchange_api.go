@@ -2,6 +2,7 @@ package http
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 
@@ -47,3 +48,84 @@ func (s *Server) CancelAllOrders(c *gin.Context) {
 
 	httputil.ResponseSuccess(c, httputil.WithData(response))
 }
+
+type getOpenOrdersRequest struct {
+	Exchange string `form:"exchange"`
+}
+
+// GetOpenOrders returns orders open on exchanges, orders of all exchanges are returned if
+// exchange is not specified.
+func (s *Server) GetOpenOrders(c *gin.Context) {
+	var query getOpenOrdersRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+
+	var exchanges []common.Exchange
+	if query.Exchange != "" {
+		exchange, err := common.GetExchange(query.Exchange)
+		if err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+		exchanges = append(exchanges, exchange)
+	} else {
+		for _, exchange := range common.SupportedExchanges {
+			if _, ok := exchange.(common.OpenOrderExchange); ok {
+				exchanges = append(exchanges, exchange)
+			}
+		}
+		sort.Slice(exchanges, func(i, j int) bool {
+			return exchanges[i].ID() < exchanges[j].ID()
+		})
+	}
+
+	response := []common.OpenOrder{}
+	for _, exchange := range exchanges {
+		orders, err := s.core.GetOpenOrders(exchange)
+		if err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+		response = append(response, orders...)
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(response))
+}
+
+type cancelOpenOrderRequest struct {
+	Exchange string `json:"exchange" binding:"required"`
+	OrderID  string `json:"order_id" binding:"required"`
+}
+
+// CancelOpenOrder cancels an order open on exchange by its exchange order id, it can be used
+// to cancel orphaned orders which are not tracked by any activity.
+func (s *Server) CancelOpenOrder(c *gin.Context) {
+	var request cancelOpenOrderRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	exchange, err := common.GetExchange(request.Exchange)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	orders, err := s.core.GetOpenOrders(exchange)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	for _, order := range orders {
+		if order.ID != request.OrderID {
+			continue
+		}
+		if err := s.core.CancelOpenOrder(exchange, order); err != nil {
+			httputil.ResponseFailure(c, httputil.WithError(err))
+			return
+		}
+		httputil.ResponseSuccess(c)
+		return
+	}
+	httputil.ResponseFailure(c, httputil.WithReason(fmt.Sprintf("order %s is not open on %s", request.OrderID, exchange.ID().String())))
+}
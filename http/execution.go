@@ -0,0 +1,99 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+type startExecutionRequest struct {
+	Pair       uint64               `json:"pair" binding:"required"`
+	Side       string               `json:"side" binding:"required"`
+	Amount     float64              `json:"amount" binding:"required"`
+	LimitPrice float64              `json:"limit_price" binding:"required"`
+	Algorithm  string               `json:"algorithm" binding:"required"`
+	Duration   common.HumanDuration `json:"duration"`
+	SliceSize  float64              `json:"slice_size"`
+}
+
+// StartExecution starts working a parent order as TWAP or iceberg child orders
+func (s *Server) StartExecution(c *gin.Context) {
+	var request startExecutionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	pair, err := s.settingStorage.GetTradingPair(request.Pair)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	exchange, ok := common.SupportedExchanges[common.ExchangeID(pair.ExchangeID)]
+	if !ok {
+		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", pair.ExchangeID)))
+		return
+	}
+	s.l.Infow("StartExecution", "pair", request.Pair, "side", request.Side, "amount", request.Amount,
+		"limit_price", request.LimitPrice, "algorithm", request.Algorithm)
+	id, err := s.core.StartExecution(exchange, pair, common.ParentOrder{
+		Side:       request.Side,
+		Amount:     request.Amount,
+		LimitPrice: request.LimitPrice,
+		Algorithm:  request.Algorithm,
+		Duration:   request.Duration,
+		SliceSize:  request.SliceSize,
+	})
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
+
+// GetExecutions returns progress of all executions
+func (s *Server) GetExecutions(c *gin.Context) {
+	httputil.ResponseSuccess(c, httputil.WithData(s.core.GetExecutions()))
+}
+
+// GetExecution returns progress of an execution
+func (s *Server) GetExecution(c *gin.Context) {
+	id, err := common.StringToActivityID(c.Param("id"))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	execution, err := s.core.GetExecution(id)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(execution))
+}
+
+type cancelExecutionRequest struct {
+	ID string `json:"id" binding:"required"`
+}
+
+// CancelExecution stops working a parent order and cancels its open child order
+func (s *Server) CancelExecution(c *gin.Context) {
+	var request cancelExecutionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	id, err := common.StringToActivityID(request.ID)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("CancelExecution", "id", id.String())
+	if err := s.core.CancelExecution(id); err != nil {
+		httputil.ResponseFailure(c, httputil.WithReason(fmt.Sprintf("failed to cancel execution: %v", err)))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
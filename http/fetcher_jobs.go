@@ -0,0 +1,39 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// GetFetcherJobs returns schedules and run statistics of fetcher jobs.
+func (s *Server) GetFetcherJobs(c *gin.Context) {
+	jobs, err := s.app.GetFetcherJobs()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(jobs))
+}
+
+type updateFetcherJobRequest struct {
+	Job      string `json:"job" binding:"required"`
+	Exchange string `json:"exchange"`
+	common.FetcherJobConfig
+}
+
+// UpdateFetcherJob changes the schedule of a fetcher job, the change is kept until restart.
+func (s *Server) UpdateFetcherJob(c *gin.Context) {
+	var request updateFetcherJobRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("UpdateFetcherJob", "job", request.Job, "exchange", request.Exchange, "config", request.FetcherJobConfig)
+	if err := s.app.UpdateFetcherJob(request.Job, request.Exchange, request.FetcherJobConfig); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c)
+}
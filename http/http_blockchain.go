@@ -2,6 +2,8 @@ package http
 
 import (
 	ethereum "github.com/ethereum/go-ethereum/common"
+
+	"github.com/KyberNetwork/reserve-data/common"
 )
 
 // Blockchain is used in http server as the caller to blockchain for information.
@@ -11,9 +13,11 @@ type Blockchain interface {
 	CheckTokenIndices(ethereum.Address) error
 	GetPricingOPAddress() ethereum.Address
 	GetDepositOPAddress() ethereum.Address
-	GetIntermediatorOPAddress() ethereum.Address
+	GetIntermediatorOPAddresses() map[string]ethereum.Address
 	GetWrapperAddress() ethereum.Address
 	GetProxyAddress() ethereum.Address
 	GetReserveAddress() ethereum.Address
 	ListedTokens() []ethereum.Address
+	// NodeStates returns health of Ethereum nodes.
+	NodeStates() []common.NodeState
 }
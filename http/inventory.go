@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// GetInventory returns inventory of assets valued at the latest auth data and compared with
+// their targets.
+func (s *Server) GetInventory(c *gin.Context) {
+	report, err := s.app.GetInventory(getTimePoint(c, true, s.l))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(report))
+}
+
+// GetInventorySnapshots returns inventory reports stored in a time range.
+func (s *Server) GetInventorySnapshots(c *gin.Context) {
+	fromTime, toTime, ok := s.ValidateTimeInput(c)
+	if !ok {
+		return
+	}
+	reports, err := s.app.GetInventorySnapshots(fromTime, toTime)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(reports))
+}
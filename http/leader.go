@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// Elector tells whether the instance is the leader of core instances sharing a database.
+type Elector interface {
+	IsLeader() bool
+	// CheckLeader verifies the instance still holds the leadership.
+	CheckLeader(ctx context.Context) (bool, error)
+	Status(ctx context.Context) (common.LeaderStatus, error)
+}
+
+// SetElector sets the elector of the instance, followers reject requests changing state.
+// Without elector the instance is always the leader.
+func (s *Server) SetElector(elector Elector) {
+	s.elector = elector
+}
+
+// leaderOnly rejects requests other than GET on followers, as only the leader calls exchanges and
+// the chain. The leadership is verified on the database for each request, so a leader which has
+// lost its lock does not run the request before it notices.
+func (s *Server) leaderOnly(c *gin.Context) {
+	if s.elector == nil || c.Request.Method == http.MethodGet {
+		c.Next()
+		return
+	}
+	leader, err := s.elector.CheckLeader(c.Request.Context())
+	switch {
+	case err != nil:
+		httputil.ResponseFailure(c, httputil.WithReason(fmt.Sprintf("failed to verify the leadership: %s", err)))
+	case !leader:
+		httputil.ResponseFailure(c, httputil.WithReason("this instance is not the leader, send the request to the leader"))
+	default:
+		c.Next()
+		return
+	}
+	c.Abort()
+}
+
+// GetLeader returns the leadership of the instance and the id of the current leader.
+func (s *Server) GetLeader(c *gin.Context) {
+	if s.elector == nil {
+		httputil.ResponseFailure(c, httputil.WithError(errors.New("leader election is not set up")))
+		return
+	}
+	status, err := s.elector.Status(c.Request.Context())
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(status))
+}
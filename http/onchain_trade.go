@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// GetOnChainTradeStats returns volume, fill rates compared with set rates and inventory flow of
+// trades with the reserve contract in a time range.
+func (s *Server) GetOnChainTradeStats(c *gin.Context) {
+	fromTime, toTime, ok := s.ValidateTimeInput(c)
+	if !ok {
+		return
+	}
+	stats, err := s.app.GetOnChainTradeStats(fromTime, toTime)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(stats))
+}
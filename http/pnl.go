@@ -0,0 +1,33 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+type pnlQuery struct {
+	From    uint64 `form:"from" binding:"required"`
+	To      uint64 `form:"to"`
+	GroupBy string `form:"group_by"`
+}
+
+// GetPnL returns realised PnL, volume and fees of trades in a time range, to is now if it is
+// not given.
+func (s *Server) GetPnL(c *gin.Context) {
+	var query pnlQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if query.To == 0 {
+		query.To = common.NowInMillis()
+	}
+	report, err := s.app.GetPnL(query.From, query.To, query.GroupBy)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(report))
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+// GetReconciliationReports returns the latest transfer reconciliation report of each exchange.
+func (s *Server) GetReconciliationReports(c *gin.Context) {
+	httputil.ResponseSuccess(c, httputil.WithData(s.core.GetReconciliationReports()))
+}
+
+// Reconcile matches deposits and withdrawals on an exchange in a time range with activities.
+func (s *Server) Reconcile(c *gin.Context) {
+	exchange, err := common.GetExchange(c.Param("exchange"))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	fromTime, toTime, ok := s.ValidateTimeInput(c)
+	if !ok {
+		return
+	}
+	report, err := s.core.Reconcile(exchange, fromTime, toTime)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(report))
+}
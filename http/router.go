@@ -0,0 +1,109 @@
+package http
+
+import (
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/common"
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+)
+
+type routeTradeRequest struct {
+	Base   uint64  `json:"base" binding:"required"`
+	Quote  uint64  `json:"quote" binding:"required"`
+	Side   string  `json:"side" binding:"required"`
+	Amount float64 `json:"amount" binding:"required"`
+	DryRun bool    `json:"dry_run"`
+}
+
+// RouteTrade splits a trade across all exchanges listing the trading pair by their latest
+// order books, taker fees and available balances.
+func (s *Server) RouteTrade(c *gin.Context) {
+	var request routeTradeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	venues, err := s.routeVenues(request.Base, request.Quote, request.Side)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if len(venues) == 0 {
+		httputil.ResponseFailure(c, httputil.WithReason("no exchange with valid order book lists the trading pair"))
+		return
+	}
+	s.l.Infow("RouteTrade", "base", request.Base, "quote", request.Quote, "side", request.Side,
+		"amount", request.Amount, "dry_run", request.DryRun)
+	plan, err := s.core.RouteTrade(venues, request.Side, request.Amount, request.DryRun)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(plan))
+}
+
+// routeVenues collects enabled exchanges listing base/quote with their latest valid order book
+// and available balance of the asset the order spends.
+func (s *Server) routeVenues(base, quote uint64, side string) ([]common.RouteVenue, error) {
+	spent := quote
+	if side == "sell" {
+		spent = base
+	}
+	timepoint := common.NowInMillis()
+	prices, err := s.app.GetAllPrices(timepoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest order books")
+	}
+	authData, err := s.app.GetAuthData(timepoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest balances")
+	}
+	available := make(map[common.ExchangeID]float64)
+	for _, balance := range authData.Balances {
+		if balance.AssetID != spent {
+			continue
+		}
+		for _, exchangeBalance := range balance.Exchanges {
+			available[common.ExchangeID(exchangeBalance.ExchangeID)] = exchangeBalance.Available
+		}
+	}
+
+	exchanges, err := s.settingStorage.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	var venues []common.RouteVenue
+	for _, ex := range exchanges {
+		exchange, ok := common.SupportedExchanges[common.ExchangeID(ex.ID)]
+		if ex.Disable || !ok {
+			continue
+		}
+		pairs, err := s.settingStorage.GetTradingPairs(ex.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range pairs {
+			if pair.Base != base || pair.Quote != quote {
+				continue
+			}
+			book, ok := prices.Data[pair.ID][exchange.ID()]
+			if !ok || !book.Valid {
+				continue
+			}
+			venues = append(venues, common.RouteVenue{
+				Exchange:  exchange,
+				Pair:      pair,
+				Book:      book,
+				TakerFee:  ex.TradingFeeTaker,
+				Available: available[exchange.ID()],
+			})
+		}
+	}
+	sort.Slice(venues, func(i, j int) bool {
+		return venues[i].Exchange.ID() < venues[j].Exchange.ID()
+	})
+	return venues, nil
+}
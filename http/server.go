@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/big"
@@ -35,8 +36,10 @@ type Server struct {
 	core           reserve.Core
 	host           string
 	r              *gin.Engine
+	srv            *http.Server
 	blockchain     Blockchain
 	settingStorage storage.Interface
+	elector        Elector
 	l              *zap.SugaredLogger
 }
 
@@ -351,6 +354,125 @@ func (s *Server) Deposit(c *gin.Context) {
 	httputil.ResponseSuccess(c, httputil.WithField("id", id))
 }
 
+// TransferRequest type
+type TransferRequest struct {
+	FromExchange uint64   `json:"from_exchange"`
+	ToExchange   uint64   `json:"to_exchange"`
+	Asset        uint64   `json:"asset"`
+	Amount       *big.Int `json:"amount"`
+}
+
+// Transfer asset from an exchange to another exchange
+func (s *Server) Transfer(c *gin.Context) {
+	var request TransferRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if request.Amount == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("amount is required"))
+		return
+	}
+
+	from, ok := common.SupportedExchanges[common.ExchangeID(request.FromExchange)]
+	if !ok {
+		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", request.FromExchange)))
+		return
+	}
+	to, ok := common.SupportedExchanges[common.ExchangeID(request.ToExchange)]
+	if !ok {
+		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", request.ToExchange)))
+		return
+	}
+
+	asset, err := s.settingStorage.GetAsset(request.Asset)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("Transfer", "amount", request.Amount.Text(10), "asset_id", asset.ID,
+		"asset_symbol", asset.Symbol, "from", from.ID().String(), "to", to.ID().String())
+	id, err := s.core.Transfer(from, to, asset, request.Amount)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
+
+// InternalTransfer transfers asset instantly between two accounts of the same exchange
+func (s *Server) InternalTransfer(c *gin.Context) {
+	var request TransferRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if request.Amount == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("amount is required"))
+		return
+	}
+
+	from, ok := common.SupportedExchanges[common.ExchangeID(request.FromExchange)]
+	if !ok {
+		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", request.FromExchange)))
+		return
+	}
+	to, ok := common.SupportedExchanges[common.ExchangeID(request.ToExchange)]
+	if !ok {
+		httputil.ResponseFailure(c, httputil.WithError(errors.Errorf("exchange %v is not supported", request.ToExchange)))
+		return
+	}
+
+	asset, err := s.settingStorage.GetAsset(request.Asset)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("InternalTransfer", "amount", request.Amount.Text(10), "asset_id", asset.ID,
+		"asset_symbol", asset.Symbol, "from", from.ID().String(), "to", to.ID().String())
+	id, err := s.core.InternalTransfer(from, to, asset, request.Amount)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
+
+// ReserveWithdrawRequest type
+type ReserveWithdrawRequest struct {
+	Asset   uint64           `json:"asset"`
+	Amount  *big.Int         `json:"amount"`
+	Address ethereum.Address `json:"address"`
+}
+
+// ReserveWithdraw withdraw asset from reserve to a whitelisted address
+func (s *Server) ReserveWithdraw(c *gin.Context) {
+	var request ReserveWithdrawRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if request.Amount == nil {
+		httputil.ResponseFailure(c, httputil.WithReason("amount is required"))
+		return
+	}
+
+	asset, err := s.settingStorage.GetAsset(request.Asset)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+
+	s.l.Infow("Reserve withdraw", "amount", request.Amount.Text(10), "asset_id", asset.ID,
+		"asset_symbol", asset.Symbol, "address", request.Address.Hex())
+	id, err := s.core.ReserveWithdraw(asset, request.Amount, request.Address)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
+
 type getActivitiesRequest struct {
 	FromTime uint64 `form:"fromTime" binding:"required"`
 	ToTime   uint64 `form:"toTime" binding:"required"`
@@ -378,6 +500,52 @@ func (s *Server) GetActivities(c *gin.Context) {
 	}
 }
 
+// GetActivityTimeline return status transitions of an activity
+func (s *Server) GetActivityTimeline(c *gin.Context) {
+	id, err := common.StringToActivityID(c.Param("id"))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	data, err := s.app.GetActivityTimeline(id)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(data))
+}
+
+// OverrideActivity resolves a stuck activity with the status given by an operator
+func (s *Server) OverrideActivity(c *gin.Context) {
+	id, err := common.StringToActivityID(c.Param("id"))
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	var override common.ActivityOverride
+	if err := c.ShouldBindJSON(&override); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	s.l.Infow("override activity", "id", id.String(), "status", override.Status, "reason", override.Reason)
+	activity, err := s.core.OverrideActivity(id, override)
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(activity))
+}
+
+// GetRiskLimitUtilisation return risk limits and their current utilisation
+func (s *Server) GetRiskLimitUtilisation(c *gin.Context) {
+	data, err := s.core.GetRiskLimitUtilisation()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(data))
+}
+
 // StopFetcher stop fetcher from fetch data
 func (s *Server) StopFetcher(c *gin.Context) {
 	err := s.app.Stop()
@@ -417,6 +585,11 @@ func (s *Server) GetTimeServer(c *gin.Context) {
 	httputil.ResponseSuccess(c, httputil.WithData(common.GetTimestamp()))
 }
 
+// GetNodes returns health of Ethereum nodes of the node pool
+func (s *Server) GetNodes(c *gin.Context) {
+	httputil.ResponseSuccess(c, httputil.WithData(s.blockchain.NodeStates()))
+}
+
 // ValidateTimeInput check if the params fromTime, toTime is valid or not
 func (s *Server) ValidateTimeInput(c *gin.Context) (uint64, uint64, bool) {
 	fromTime, ok := strconv.ParseUint(c.Query("fromTime"), 10, 64)
@@ -471,7 +644,7 @@ func (s *Server) checkTokenIndice(c *gin.Context) {
 
 func (s *Server) register() {
 	if s.core != nil && s.app != nil {
-		g := s.r.Group("/v3")
+		g := s.r.Group("/v3", s.leaderOnly)
 		g.GET("/prices-version", s.AllPricesVersion)
 		g.GET("/prices", s.AllPrices)
 		g.GET("/prices/:base/:quote", s.Price)
@@ -481,17 +654,41 @@ func (s *Server) register() {
 		g.GET("/authdata-version", s.AuthDataVersion)
 		g.GET("/authdata", s.AuthData)
 		g.GET("/activities", s.GetActivities)
+		g.GET("/activities/search", s.SearchActivities)
+		g.GET("/activities/export", s.ExportActivities)
+		g.GET("/activity/:id/timeline", s.GetActivityTimeline)
+		g.POST("/activity/:id/override", s.OverrideActivity)
 		g.GET("/immediate-pending-activities", s.ImmediatePendingActivities)
+		g.GET("/reconciliation", s.GetReconciliationReports)
+		g.GET("/reconciliation/:exchange", s.Reconcile)
 
 		g.POST("/cancelorder", s.CancelOrder)
 		g.POST("/cancel-all-orders", s.CancelAllOrders)
+		g.GET("/open-orders", s.GetOpenOrders)
+		g.POST("/cancel-open-order", s.CancelOpenOrder)
 		g.POST("/deposit", s.Deposit)
 		g.POST("/withdraw", s.Withdraw)
+		g.POST("/reserve-withdraw", s.ReserveWithdraw)
+		g.POST("/transfer", s.Transfer)
+		g.POST("/internal-transfer", s.InternalTransfer)
 		g.POST("/trade", s.Trade)
+		g.POST("/route-trade", s.RouteTrade)
+		g.POST("/executions", s.StartExecution)
+		g.GET("/executions", s.GetExecutions)
+		g.GET("/executions/:id", s.GetExecution)
+		g.POST("/cancel-execution", s.CancelExecution)
 		g.POST("/setrates", s.SetRate)
 		g.GET("/tradehistory", s.GetTradeHistory)
+		g.GET("/pnl", s.GetPnL)
+		g.GET("/onchain-trades/stats", s.GetOnChainTradeStats)
+		g.GET("/inventory", s.GetInventory)
+		g.GET("/inventory/snapshots", s.GetInventorySnapshots)
+		g.GET("/fetcher/jobs", s.GetFetcherJobs)
+		g.PUT("/fetcher/jobs", s.UpdateFetcherJob)
+		g.GET("/leader", s.GetLeader)
 
 		g.GET("/timeserver", s.GetTimeServer)
+		g.GET("/nodes", s.GetNodes)
 
 		g.GET("/gold-feed", s.GetGoldData)
 		g.GET("/btc-feed", s.GetBTCData)
@@ -499,19 +696,26 @@ func (s *Server) register() {
 
 		g.GET("/addresses", s.GetAddresses)
 
+		g.GET("/risk-limit-utilisation", s.GetRiskLimitUtilisation)
+
 		g.PUT("/update-token-indice", s.updateTokenIndice)
 		g.GET("/check-token-indice", s.checkTokenIndice)
 	}
 }
 
-// Run the server
+// Run the server until Shutdown is called
 func (s *Server) Run() {
 	s.register()
-	if err := s.r.Run(s.host); err != nil {
+	if err := s.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Panic(err)
 	}
 }
 
+// Shutdown stops accepting requests and waits for the requests in progress until ctx is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
+
 // EnableProfiler enable profiler
 func (s *Server) EnableProfiler() {
 	pprof.Register(s.r)
@@ -546,6 +750,7 @@ func NewHTTPServer(
 		core:           core,
 		host:           host,
 		r:              r,
+		srv:            &http.Server{Addr: host, Handler: r},
 		blockchain:     bc,
 		settingStorage: settingStorage,
 		l:              zap.S(),
@@ -1,8 +1,11 @@
 package reserve
 
 import (
+	"context"
 	"math/big"
 
+	ethereum "github.com/ethereum/go-ethereum/common"
+
 	"github.com/KyberNetwork/reserve-data/common"
 	commonv3 "github.com/KyberNetwork/reserve-data/reservesetting/common"
 )
@@ -24,15 +27,36 @@ type Data interface {
 
 	GetRecords(fromTime, toTime uint64) ([]common.ActivityRecord, error)
 	GetPendingActivities() ([]common.ActivityRecord, error)
+	// GetActivityTimeline returns the status transitions of an activity from the oldest one.
+	GetActivityTimeline(id common.ActivityID) ([]common.ActivityTransition, error)
+	// SearchActivities returns a page of activities matching the query.
+	SearchActivities(query common.ActivityQuery) (common.ActivityPage, error)
 
 	GetGoldData(timepoint uint64) (common.GoldData, error)
 	GetBTCData(timepoint uint64) (common.BTCData, error)
 	GetUSDData(timepoint uint64) (common.USDData, error)
 
 	GetTradeHistory(fromTime, toTime uint64) (common.AllTradeHistory, error)
+	// GetPnL returns realised PnL, volume and fees of trades made in [fromTime, toTime], grouped
+	// by asset or by exchange and asset.
+	GetPnL(fromTime, toTime uint64, groupBy string) (common.PnLReport, error)
+	// GetOnChainTradeStats returns volume, fill rates compared with set rates and inventory flow
+	// of trades with the reserve contract made in [fromTime, toTime].
+	GetOnChainTradeStats(fromTime, toTime uint64) (common.OnChainTradeStats, error)
+	// GetInventory returns inventory of assets valued at the latest auth data before timepoint
+	// and compared with their targets.
+	GetInventory(timepoint uint64) (common.InventoryReport, error)
+	// GetInventorySnapshots returns inventory reports stored in [fromTime, toTime].
+	GetInventorySnapshots(fromTime, toTime uint64) ([]common.InventoryReport, error)
+
+	// GetFetcherJobs returns schedules and run statistics of fetcher jobs.
+	GetFetcherJobs() ([]common.FetcherJobStatus, error)
+	// UpdateFetcherJob changes the schedule of the job of the exchange, exchange is empty for
+	// jobs which are not bound to an exchange.
+	UpdateFetcherJob(job, exchange string, config common.FetcherJobConfig) error
 
-	Run() error
-	RunStorageController() error
+	Run(ctx context.Context) error
+	RunStorageController(ctx context.Context) error
 	Stop() error
 }
 
@@ -47,19 +71,76 @@ type Core interface {
 		rate float64,
 		amount float64) (id common.ActivityID, done float64, remaining float64, finished bool, err error)
 
+	// RouteTrade splits a trade across venues by their order books, taker fees and balances,
+	// with dryRun only the routing plan is returned.
+	RouteTrade(
+		venues []common.RouteVenue,
+		tradeType string,
+		amount float64,
+		dryRun bool) (common.RoutePlan, error)
+
 	Deposit(
 		exchange common.Exchange,
 		asset commonv3.Asset,
 		amount *big.Int,
 		timestamp uint64) (common.ActivityID, error)
 
+	// StartExecution works a parent order as TWAP or iceberg child orders.
+	StartExecution(
+		exchange common.Exchange,
+		pair commonv3.TradingPairSymbols,
+		order common.ParentOrder) (common.ActivityID, error)
+	// GetExecutions returns progress of all executions.
+	GetExecutions() []common.Execution
+	// GetExecution returns progress of an execution.
+	GetExecution(id common.ActivityID) (common.Execution, error)
+	// CancelExecution stops working a parent order and cancels its open child order.
+	CancelExecution(id common.ActivityID) error
+
 	Withdraw(
 		exchange common.Exchange,
 		token commonv3.Asset,
 		amount *big.Int) (common.ActivityID, error)
 
+	// Transfer withdraws token from an exchange directly to another exchange.
+	Transfer(
+		from common.Exchange,
+		to common.Exchange,
+		asset commonv3.Asset,
+		amount *big.Int) (common.ActivityID, error)
+
+	// InternalTransfer transfers token instantly between two accounts of the same exchange.
+	InternalTransfer(
+		from common.Exchange,
+		to common.Exchange,
+		asset commonv3.Asset,
+		amount *big.Int) (common.ActivityID, error)
+
+	// ReserveWithdraw withdraws token from reserve contract to a whitelisted address.
+	ReserveWithdraw(
+		asset commonv3.Asset,
+		amount *big.Int,
+		address ethereum.Address) (common.ActivityID, error)
+
 	CancelOrder(id common.ActivityID, exchange common.Exchange) error
 
+	// GetOpenOrders returns orders open on exchange joined with the trade activities placed them.
+	GetOpenOrders(exchange common.Exchange) ([]common.OpenOrder, error)
+
+	// CancelOpenOrder cancels an order open on exchange, including orphaned orders.
+	CancelOpenOrder(exchange common.Exchange, order common.OpenOrder) error
+
 	// blockchain related action
 	SetRates(tokens []commonv3.Asset, buys, sells []*big.Int, block *big.Int, afpMid []*big.Int, msgs []string) (common.ActivityID, error)
+
+	// GetRiskLimitUtilisation returns configured risk limits and their current utilisation.
+	GetRiskLimitUtilisation() (common.RiskLimitUtilisation, error)
+
+	// OverrideActivity resolves a stuck activity by an operator.
+	OverrideActivity(id common.ActivityID, override common.ActivityOverride) (common.ActivityRecord, error)
+
+	// Reconcile matches deposits and withdrawals on the exchange in the time range with activities.
+	Reconcile(exchange common.Exchange, fromTime, toTime uint64) (common.ReconciliationReport, error)
+	// GetReconciliationReports returns the latest reconciliation report of each exchange.
+	GetReconciliationReports() []common.ReconciliationReport
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/KyberNetwork/reserve-data/exchange/huobi"
 	libapp "github.com/KyberNetwork/reserve-data/lib/app"
 	"github.com/KyberNetwork/reserve-data/lib/httputil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
 	settinghttp "github.com/KyberNetwork/reserve-data/reservesetting/http"
 	"github.com/KyberNetwork/reserve-data/reservesetting/storage/postgres"
 )
@@ -77,28 +78,6 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	enableExchanges, err := configuration.NewExchangesFromContext(c)
-	if err != nil {
-		return fmt.Errorf("failed to get enabled exchanges: %s", err)
-	}
-
-	// QUESTION: should we keep using flag for this config or move it to config file?
-	bi := configuration.NewBinanceInterfaceFromContext(c)
-	// dummy signer as live infos does not need to sign
-	binanceSigner := binance.NewSigner("", "")
-	httpClient := &http.Client{Timeout: time.Second * 30}
-	binanceEndpoint := binance.NewBinanceEndpoint(binanceSigner, bi, dpl, httpClient)
-	hi := configuration.NewhuobiInterfaceFromContext(c)
-
-	// dummy signer as live infos does not need to sign
-	huobiSigner := huobi.NewSigner("", "")
-	huobiEndpoint := huobi.NewHuobiEndpoint(huobiSigner, hi, httpClient)
-
-	liveExchanges, err := getLiveExchanges(enableExchanges, binanceEndpoint, huobiEndpoint)
-	if err != nil {
-		return fmt.Errorf("failed to initiate live exchanges: %s", err)
-	}
-
 	coreEndpoint := c.String(coreEndpointFlag)
 	if !checkCoreEndpoint(coreEndpoint) {
 		sugar.Error("core endpoint is not provided, if you create new asset, you cannot update token indice, please provide.")
@@ -108,6 +87,19 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if err = configuration.RegisterExchanges(sr); err != nil {
+		return err
+	}
+
+	enabledAccounts, err := configuration.EnabledExchangeAccounts(c, sr)
+	if err != nil {
+		return fmt.Errorf("failed to get enabled exchanges: %s", err)
+	}
+
+	// QUESTION: should we keep using flag for this config or move it to config file?
+	bi := configuration.NewBinanceInterfaceFromContext(c)
+	hi := configuration.NewhuobiInterfaceFromContext(c)
+	liveExchanges := getLiveExchanges(enabledAccounts, dpl, bi, hi)
 
 	sentryDSN := libapp.SentryDSNFromFlag(c)
 	server := settinghttp.NewServer(sr, host, liveExchanges, sentryDSN, coreEndpoint)
@@ -118,21 +110,36 @@ func run(c *cli.Context) error {
 	return nil
 }
 
-func getLiveExchanges(enabledExchanges []v1common.ExchangeID, bi exchange.BinanceInterface, hi exchange.HuobiInterface) (map[v1common.ExchangeID]v1common.LiveExchange, error) {
+// getLiveExchanges creates live infos of the enabled exchange accounts by their venues, bi and hi
+// are used by accounts without an endpoint.
+func getLiveExchanges(accounts []common.Exchange, dpl deployment.Deployment,
+	bi binance.Interface, hi huobi.Interface) map[v1common.ExchangeID]v1common.LiveExchange {
 	var (
 		liveExchanges = make(map[v1common.ExchangeID]v1common.LiveExchange)
+		httpClient    = &http.Client{Timeout: time.Second * 30}
 	)
-	for _, exchangeID := range enabledExchanges {
-		switch exchangeID {
-		case v1common.Binance, v1common.Binance2:
-			binanceLive := exchange.NewBinanceLive(bi)
-			liveExchanges[exchangeID] = binanceLive
-		case v1common.Huobi:
-			huobiLive := exchange.NewHuobiLive(hi)
-			liveExchanges[exchangeID] = huobiLive
+	for _, account := range accounts {
+		exchangeID := v1common.ExchangeID(account.ID)
+		switch account.Venue {
+		case common.VenueBinance:
+			interf := bi
+			if account.Endpoint != "" {
+				interf = binance.NewRealInterface(account.Endpoint)
+			}
+			// dummy signer as live infos does not need to sign
+			binanceEndpoint := binance.NewBinanceEndpoint(exchangeID, binance.NewSigner("", ""), interf, dpl, httpClient)
+			liveExchanges[exchangeID] = exchange.NewBinanceLive(binanceEndpoint)
+		case common.VenueHuobi:
+			interf := hi
+			if account.Endpoint != "" {
+				interf = huobi.NewRealInterface(account.Endpoint)
+			}
+			// dummy signer as live infos does not need to sign
+			huobiEndpoint := huobi.NewHuobiEndpoint(exchangeID, huobi.NewSigner("", ""), interf, httpClient)
+			liveExchanges[exchangeID] = exchange.NewHuobiLive(huobiEndpoint)
 		}
 	}
-	return liveExchanges, nil
+	return liveExchanges
 }
 
 func checkCoreEndpoint(endpoint string) bool {
@@ -52,4 +52,10 @@ var (
 	ErrAssetAddressIsNotIndexInContract = errors.New("asset address is not index in address please check again")
 	// ErrBlockchainHaveNotInitiated is return if blockchain have not initiated yet
 	ErrBlockchainHaveNotInitiated = errors.New("blockchain instance have not initiated")
+	// ErrInvalidRiskLimit is returned when a risk limit value is invalid
+	ErrInvalidRiskLimit = errors.New("invalid risk limit")
+	// ErrWithdrawAddressExists is returned when the address is already whitelisted for the asset
+	ErrWithdrawAddressExists = errors.New("withdraw address already exists")
+	// ErrExchangeExists is returned when creating an exchange with a duplicated name
+	ErrExchangeExists = errors.New("exchange already exists")
 )
@@ -14,6 +14,32 @@ type Exchange struct {
 	TradingFeeMaker float64 `json:"trading_fee_maker"`
 	TradingFeeTaker float64 `json:"trading_fee_taker"`
 	Disable         bool    `json:"disable"`
+	// MaxOpenOrders is the maximum number of orders core is allowed to keep open
+	// on the exchange at the same time, 0 means no limit.
+	MaxOpenOrders uint64 `json:"max_open_orders"`
+	// Venue is the exchange API the account uses: binance, huobi or coinbase.
+	Venue string `json:"venue"`
+	// CredentialsRef is the name of the API key of the account in exchange_credentials of core
+	// config, built-in accounts use their own config keys when it is empty.
+	CredentialsRef string `json:"credentials_ref"`
+	// Endpoint is the API url of the account, the default url of the venue is used when it is empty.
+	Endpoint string `json:"endpoint"`
+}
+
+// Venues of exchange accounts.
+const (
+	VenueBinance  = "binance"
+	VenueHuobi    = "huobi"
+	VenueCoinbase = "coinbase"
+)
+
+// ValidVenue returns true if accounts of the venue are supported.
+func ValidVenue(venue string) bool {
+	switch venue {
+	case VenueBinance, VenueHuobi, VenueCoinbase:
+		return true
+	}
+	return false
 }
 
 // SetRate ..
@@ -255,6 +281,7 @@ type UpdateExchangeEntry struct {
 	TradingFeeMaker *float64 `json:"trading_fee_maker"`
 	TradingFeeTaker *float64 `json:"trading_fee_taker"`
 	Disable         *bool    `json:"disable"`
+	MaxOpenOrders   *uint64  `json:"max_open_orders"`
 }
 
 // CreateTradingPairEntry represents an trading pair in central exchange.
@@ -294,6 +321,8 @@ const (
 	ChangeCatalogUpdateExchange                          // update_exchange
 	ChangeCatalogMain                                    // main
 	ChangeCatalogFeedConfiguration                       // set_feed_configuration
+	ChangeCatalogRiskLimit                               // set_risk_limit
+	ChangeCatalogWithdrawAddress                         // set_withdraw_address
 )
 
 // ChangeType represent type of change type entry in list change
@@ -323,6 +352,12 @@ const (
 	ChangeTypeUpdateStableTokenParams // update_stable_token_params
 	// ChangeTypeSetFeedConfiguration is used when set feed confuguration
 	ChangeTypeSetFeedConfiguration // set_feed_configuration
+	// ChangeTypeSetRiskLimit is used when set risk limit of an asset on an exchange
+	ChangeTypeSetRiskLimit // set_risk_limit
+	// ChangeTypeCreateWithdrawAddress is used when add an address to withdraw address whitelist
+	ChangeTypeCreateWithdrawAddress // create_withdraw_address
+	// ChangeTypeDeleteWithdrawAddress is used when remove an address from withdraw address whitelist
+	ChangeTypeDeleteWithdrawAddress // delete_withdraw_address
 )
 
 // SettingChangeType interface just make sure that only some of selected type can be put into SettingChange list
@@ -378,3 +413,59 @@ type FeedConfiguration struct {
 	BaseVolatilitySpread float64 `json:"base_volatility_spread" db:"base_volatility_spread"`
 	NormalSpread         float64 `json:"normal_spread" db:"normal_spread"`
 }
+
+// RiskLimit is the trading and transfer limits of an asset on an exchange, core refuses
+// any trade, deposit or withdraw that exceeds them. A zero value means no limit.
+type RiskLimit struct {
+	AssetID    uint64 `json:"asset_id" db:"asset_id"`
+	ExchangeID uint64 `json:"exchange_id" db:"exchange_id"`
+	// MaxOrderNotional is the maximum notional (rate * amount, in quote asset) of an order
+	// which has the asset as base.
+	MaxOrderNotional float64 `json:"max_order_notional" db:"max_order_notional"`
+	// MaxDailyTradeVolume is the maximum amount of the asset traded as base in the last 24 hours.
+	MaxDailyTradeVolume    float64 `json:"max_daily_trade_volume" db:"max_daily_trade_volume"`
+	MaxWithdrawAmount      float64 `json:"max_withdraw_amount" db:"max_withdraw_amount"`
+	MaxDailyWithdrawAmount float64 `json:"max_daily_withdraw_amount" db:"max_daily_withdraw_amount"`
+	MaxDepositAmount       float64 `json:"max_deposit_amount" db:"max_deposit_amount"`
+	MaxDailyDepositAmount  float64 `json:"max_daily_deposit_amount" db:"max_daily_deposit_amount"`
+}
+
+// SetRiskLimitEntry data to set risk limit of an asset on an exchange
+type SetRiskLimitEntry struct {
+	settingChangeMarker
+	AssetID                uint64   `json:"asset_id" binding:"required"`
+	ExchangeID             uint64   `json:"exchange_id" binding:"required"`
+	MaxOrderNotional       *float64 `json:"max_order_notional"`
+	MaxDailyTradeVolume    *float64 `json:"max_daily_trade_volume"`
+	MaxWithdrawAmount      *float64 `json:"max_withdraw_amount"`
+	MaxDailyWithdrawAmount *float64 `json:"max_daily_withdraw_amount"`
+	MaxDepositAmount       *float64 `json:"max_deposit_amount"`
+	MaxDailyDepositAmount  *float64 `json:"max_daily_deposit_amount"`
+}
+
+// WithdrawAddress is a whitelisted destination that reserve funds of an asset can be withdrawn to.
+// Zero value of amount limits means there is no limit.
+type WithdrawAddress struct {
+	ID             uint64           `json:"id"`
+	Address        ethereum.Address `json:"address"`
+	Description    string           `json:"description"`
+	AssetID        uint64           `json:"asset_id"`
+	MaxAmount      float64          `json:"max_amount"`
+	MaxDailyAmount float64          `json:"max_daily_amount"`
+}
+
+// CreateWithdrawAddressEntry is used to add an address to withdraw address whitelist.
+type CreateWithdrawAddressEntry struct {
+	settingChangeMarker
+	Address        ethereum.Address `json:"address" binding:"required"`
+	Description    string           `json:"description"`
+	AssetID        uint64           `json:"asset_id" binding:"required"`
+	MaxAmount      float64          `json:"max_amount"`
+	MaxDailyAmount float64          `json:"max_daily_amount"`
+}
+
+// DeleteWithdrawAddressEntry is used to remove an address from withdraw address whitelist.
+type DeleteWithdrawAddressEntry struct {
+	settingChangeMarker
+	ID uint64 `json:"id" binding:"required"`
+}
@@ -67,6 +67,12 @@ func SettingChangeFromType(t ChangeType) (SettingChangeType, error) {
 		i = &UpdateStableTokenParamsEntry{}
 	case ChangeTypeSetFeedConfiguration:
 		i = &SetFeedConfigurationEntry{}
+	case ChangeTypeSetRiskLimit:
+		i = &SetRiskLimitEntry{}
+	case ChangeTypeCreateWithdrawAddress:
+		i = &CreateWithdrawAddressEntry{}
+	case ChangeTypeDeleteWithdrawAddress:
+		i = &DeleteWithdrawAddressEntry{}
 	}
 	return i, nil
 }
@@ -141,6 +141,21 @@ func (c *apiClient) getExchanges() (exchangesResponse, error) {
 	return status, err
 }
 
+func (c *apiClient) createExchange(entry createExchangeEntry) (postResponse, error) {
+	req, err := createRequest(http.MethodPost, "/v3/exchange", entry)
+	if err != nil {
+		return postResponse{}, err
+	}
+	resp := httptest.NewRecorder()
+	c.s.r.ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		return postResponse{}, fmt.Errorf("server return %d - %s", resp.Code, resp.Body.String())
+	}
+	var result postResponse
+	err = readResponse(resp.Body, &result)
+	return result, err
+}
+
 // ReadResponse retry to parse response into object
 func readResponse(data io.Reader, dataField interface{}) error {
 	return json.NewDecoder(data).Decode(dataField)
@@ -1,6 +1,9 @@
 package http
 
 import (
+	"fmt"
+	"regexp"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/KyberNetwork/reserve-data/http/httputil"
@@ -66,3 +69,42 @@ func (s *Server) updateExchangeStatus(c *gin.Context) {
 	}
 	httputil.ResponseSuccess(c)
 }
+
+// exchangeNamePattern matches names of exchange accounts, they are used in API paths and config keys.
+var exchangeNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type createExchangeEntry struct {
+	Name           string `json:"name" binding:"required"`
+	Venue          string `json:"venue" binding:"required"`
+	CredentialsRef string `json:"credentials_ref"`
+	Endpoint       string `json:"endpoint"`
+}
+
+// createExchange creates a disabled exchange account, it is enabled by an update exchange setting
+// change once its trading fees are set. Core instantiates the account on restart.
+func (s *Server) createExchange(c *gin.Context) {
+	var input createExchangeEntry
+	if err := c.ShouldBindJSON(&input); err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	if !exchangeNamePattern.MatchString(input.Name) {
+		httputil.ResponseFailure(c, httputil.WithReason("name must only contain lower case letters, digits and underscores"))
+		return
+	}
+	if !common.ValidVenue(input.Venue) {
+		httputil.ResponseFailure(c, httputil.WithReason(fmt.Sprintf("unsupported venue %s", input.Venue)))
+		return
+	}
+	id, err := s.storage.CreateExchange(common.Exchange{
+		Name:           input.Name,
+		Venue:          input.Venue,
+		CredentialsRef: input.CredentialsRef,
+		Endpoint:       input.Endpoint,
+	})
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithField("id", id))
+}
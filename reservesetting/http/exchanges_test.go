@@ -82,3 +82,35 @@ func TestUpdateExchangeStatus(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, ex.Exchange.Disable, true)
 }
+
+func TestCreateExchange(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+	s, err := postgres.NewStorage(db)
+	require.NoError(t, err)
+	server := NewServer(s, "", nil, "", "")
+	c := apiClient{s: server}
+
+	result, err := c.createExchange(createExchangeEntry{Name: "binance_3", Venue: common.VenueBinance, CredentialsRef: "binance_3"})
+	require.NoError(t, err)
+	require.True(t, result.Success, result.Reason)
+
+	ex, err := c.getExchange(result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "binance_3", ex.Exchange.Name)
+	assert.Equal(t, common.VenueBinance, ex.Exchange.Venue)
+	assert.Equal(t, "binance_3", ex.Exchange.CredentialsRef)
+	assert.True(t, ex.Exchange.Disable)
+
+	for _, entry := range []createExchangeEntry{
+		{Name: "binance_3", Venue: common.VenueBinance},
+		{Name: "Binance 4", Venue: common.VenueBinance},
+		{Name: "kraken", Venue: "kraken"},
+	} {
+		result, err = c.createExchange(entry)
+		require.NoError(t, err)
+		assert.False(t, result.Success, entry.Name)
+	}
+}
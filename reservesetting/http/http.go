@@ -52,9 +52,12 @@ func NewServer(storage storage.Interface, host string, supportedExchanges map[v1
 	g.GET("/asset", server.getAssets)
 	g.GET("/exchange/:id", server.getExchange)
 	g.GET("/exchange", server.getExchanges)
+	g.POST("/exchange", server.createExchange)
 	g.GET("/trading-pair/:id", server.getTradingPair)
 	g.GET("/stable-token-params", server.getStableTokenParams)
 	g.GET("/feed-configurations", server.getFeedConfigurations)
+	g.GET("/risk-limits", server.getRiskLimits)
+	g.GET("/withdraw-addresses", server.getWithdrawAddresses)
 
 	// because we don't allow to create asset directly, it must go through pending operation
 	// so all 'create' operation mean to operate on pending object.
@@ -103,6 +106,18 @@ func NewServer(storage storage.Interface, host string, supportedExchanges map[v1
 	g.DELETE("/setting-change-feed-configuration/:id", server.rejectSettingChange)
 	g.PUT("/update-feed-status/:name", server.updateFeedStatus)
 
+	g.POST("/setting-change-risk-limit", server.createSettingChangeWithType(common.ChangeCatalogRiskLimit))
+	g.GET("/setting-change-risk-limit", server.getSettingChangeWithType(common.ChangeCatalogRiskLimit))
+	g.GET("/setting-change-risk-limit/:id", server.getSettingChange)
+	g.PUT("/setting-change-risk-limit/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-risk-limit/:id", server.rejectSettingChange)
+
+	g.POST("/setting-change-withdraw-address", server.createSettingChangeWithType(common.ChangeCatalogWithdrawAddress))
+	g.GET("/setting-change-withdraw-address", server.getSettingChangeWithType(common.ChangeCatalogWithdrawAddress))
+	g.GET("/setting-change-withdraw-address/:id", server.getSettingChange)
+	g.PUT("/setting-change-withdraw-address/:id", server.confirmSettingChange)
+	g.DELETE("/setting-change-withdraw-address/:id", server.rejectSettingChange)
+
 	g.GET("/price-factor", server.getPriceFactor)
 	g.POST("/price-factor", server.setPriceFactor)
 
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func (s *Server) getRiskLimits(c *gin.Context) {
+	riskLimits, err := s.storage.GetRiskLimits()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(riskLimits))
+}
+
+func (s *Server) checkSetRiskLimitParams(setRiskLimitEntry common.SetRiskLimitEntry) error {
+	asset, err := s.storage.GetAsset(setRiskLimitEntry.AssetID)
+	if err != nil {
+		return errors.Wrap(err, "asset not found")
+	}
+	if _, ok := getAssetExchangeByExchangeID(asset, setRiskLimitEntry.ExchangeID); !ok {
+		return errors.Wrapf(common.ErrAssetExchangeMissing, "asset id: %v exchange id: %v",
+			setRiskLimitEntry.AssetID, setRiskLimitEntry.ExchangeID)
+	}
+	for name, limit := range map[string]*float64{
+		"max_order_notional":        setRiskLimitEntry.MaxOrderNotional,
+		"max_daily_trade_volume":    setRiskLimitEntry.MaxDailyTradeVolume,
+		"max_withdraw_amount":       setRiskLimitEntry.MaxWithdrawAmount,
+		"max_daily_withdraw_amount": setRiskLimitEntry.MaxDailyWithdrawAmount,
+		"max_deposit_amount":        setRiskLimitEntry.MaxDepositAmount,
+		"max_daily_deposit_amount":  setRiskLimitEntry.MaxDailyDepositAmount,
+	} {
+		if limit != nil && *limit < 0 {
+			return errors.Wrapf(common.ErrInvalidRiskLimit, "%s must not be negative", name)
+		}
+	}
+	return nil
+}
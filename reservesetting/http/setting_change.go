@@ -48,6 +48,12 @@ func (s *Server) validateChangeEntry(e common.SettingChangeType, changeType comm
 		return nil
 	case common.ChangeTypeSetFeedConfiguration:
 		err = s.checkSetFeedConfigurationParams(*e.(*common.SetFeedConfigurationEntry))
+	case common.ChangeTypeSetRiskLimit:
+		err = s.checkSetRiskLimitParams(*e.(*common.SetRiskLimitEntry))
+	case common.ChangeTypeCreateWithdrawAddress:
+		err = s.checkCreateWithdrawAddressParams(*e.(*common.CreateWithdrawAddressEntry))
+	case common.ChangeTypeDeleteWithdrawAddress:
+		err = s.checkDeleteWithdrawAddressParams(*e.(*common.DeleteWithdrawAddressEntry))
 	default:
 		return errors.Errorf("unknown type of setting change: %v", reflect.TypeOf(e))
 	}
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/KyberNetwork/reserve-data/http/httputil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func (s *Server) getWithdrawAddresses(c *gin.Context) {
+	withdrawAddresses, err := s.storage.GetWithdrawAddresses()
+	if err != nil {
+		httputil.ResponseFailure(c, httputil.WithError(err))
+		return
+	}
+	httputil.ResponseSuccess(c, httputil.WithData(withdrawAddresses))
+}
+
+func (s *Server) checkCreateWithdrawAddressParams(entry common.CreateWithdrawAddressEntry) error {
+	if common.IsZeroAddress(entry.Address) {
+		return common.ErrAddressMissing
+	}
+	asset, err := s.storage.GetAsset(entry.AssetID)
+	if err != nil {
+		return errors.Wrap(err, "asset not found")
+	}
+	if !asset.Transferable {
+		return errors.Errorf("asset %s is not transferable", asset.Symbol)
+	}
+	if entry.MaxAmount < 0 || entry.MaxDailyAmount < 0 {
+		return errors.Wrap(common.ErrInvalidRiskLimit, "max_amount and max_daily_amount must not be negative")
+	}
+	_, err = s.storage.GetWithdrawAddress(entry.Address, entry.AssetID)
+	switch err {
+	case nil:
+		return errors.Wrapf(common.ErrWithdrawAddressExists, "address: %s asset id: %d", entry.Address.Hex(), entry.AssetID)
+	case common.ErrNotFound:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (s *Server) checkDeleteWithdrawAddressParams(entry common.DeleteWithdrawAddressEntry) error {
+	withdrawAddresses, err := s.storage.GetWithdrawAddresses()
+	if err != nil {
+		return err
+	}
+	for _, withdrawAddress := range withdrawAddresses {
+		if withdrawAddress.ID == entry.ID {
+			return nil
+		}
+	}
+	return errors.Wrapf(common.ErrNotFound, "withdraw address id: %d", entry.ID)
+}
@@ -23,6 +23,7 @@ type Interface interface {
 	CreatePriceFactor(v3.PriceFactorAtTime) (uint64, error)
 	GetPriceFactors(uint64, uint64) ([]v3.PriceFactorAtTime, error)
 
+	CreateExchange(exchange v3.Exchange) (uint64, error)
 	UpdateExchange(id uint64, updateOpts UpdateExchangeOpts) error
 	UpdateFeedStatus(name string, enabled bool) error
 }
@@ -49,6 +50,16 @@ type SettingReader interface {
 	// GetFeedConfigurations return all feed configuration
 	GetFeedConfigurations() ([]v3.FeedConfiguration, error)
 	GetFeedConfiguration(name string) (v3.FeedConfiguration, error)
+	// GetRiskLimits return all configured risk limits
+	GetRiskLimits() ([]v3.RiskLimit, error)
+	// GetRiskLimit return risk limit of an asset on an exchange, ErrNotFound is returned if
+	// there is no limit configured.
+	GetRiskLimit(assetID, exchangeID uint64) (v3.RiskLimit, error)
+	// GetWithdrawAddresses return all whitelisted withdraw addresses
+	GetWithdrawAddresses() ([]v3.WithdrawAddress, error)
+	// GetWithdrawAddress return whitelisted withdraw address of an asset, ErrNotFound is returned
+	// if the address is not whitelisted.
+	GetWithdrawAddress(address ethereum.Address, assetID uint64) (v3.WithdrawAddress, error)
 }
 
 type ControlInfoInterface interface {
@@ -18,6 +18,10 @@ type exchangeDB struct {
 	TradingFeeMaker sql.NullFloat64 `db:"trading_fee_maker"`
 	TradingFeeTaker sql.NullFloat64 `db:"trading_fee_taker"`
 	Disable         bool            `db:"disable"`
+	MaxOpenOrders   uint64          `db:"max_open_orders"`
+	Venue           string          `db:"venue"`
+	CredentialsRef  string          `db:"credentials_ref"`
+	Endpoint        string          `db:"endpoint"`
 }
 
 func (s *Storage) GetExchanges() ([]common.Exchange, error) {
@@ -32,9 +36,13 @@ func (s *Storage) GetExchanges() ([]common.Exchange, error) {
 
 	for _, qResult := range qResults {
 		result := common.Exchange{
-			ID:      uint64(qResult.ID),
-			Name:    qResult.Name,
-			Disable: qResult.Disable,
+			ID:             uint64(qResult.ID),
+			Name:           qResult.Name,
+			Disable:        qResult.Disable,
+			MaxOpenOrders:  qResult.MaxOpenOrders,
+			Venue:          qResult.Venue,
+			CredentialsRef: qResult.CredentialsRef,
+			Endpoint:       qResult.Endpoint,
 		}
 
 		if qResult.TradingFeeMaker.Valid {
@@ -62,9 +70,13 @@ func (s *Storage) GetExchange(id uint64) (common.Exchange, error) {
 		return common.Exchange{}, err
 	}
 	result = common.Exchange{
-		ID:      uint64(qResult.ID),
-		Name:    qResult.Name,
-		Disable: qResult.Disable,
+		ID:             uint64(qResult.ID),
+		Name:           qResult.Name,
+		Disable:        qResult.Disable,
+		MaxOpenOrders:  qResult.MaxOpenOrders,
+		Venue:          qResult.Venue,
+		CredentialsRef: qResult.CredentialsRef,
+		Endpoint:       qResult.Endpoint,
 	}
 	if qResult.TradingFeeMaker.Valid {
 		result.TradingFeeMaker = qResult.TradingFeeMaker.Float64
@@ -88,9 +100,13 @@ func (s *Storage) GetExchangeByName(name string) (common.Exchange, error) {
 		}
 	}
 	result = common.Exchange{
-		ID:      uint64(qResult.ID),
-		Name:    qResult.Name,
-		Disable: qResult.Disable,
+		ID:             uint64(qResult.ID),
+		Name:           qResult.Name,
+		Disable:        qResult.Disable,
+		MaxOpenOrders:  qResult.MaxOpenOrders,
+		Venue:          qResult.Venue,
+		CredentialsRef: qResult.CredentialsRef,
+		Endpoint:       qResult.Endpoint,
 	}
 	if qResult.TradingFeeMaker.Valid {
 		result.TradingFeeMaker = qResult.TradingFeeMaker.Float64
@@ -101,6 +117,25 @@ func (s *Storage) GetExchangeByName(name string) (common.Exchange, error) {
 	return result, nil
 }
 
+// CreateExchange creates a disabled exchange account and returns its id, the account is enabled
+// after its trading fees are set.
+func (s *Storage) CreateExchange(exchange common.Exchange) (uint64, error) {
+	const query = `INSERT INTO "exchanges" (id, name, venue, credentials_ref, endpoint)
+SELECT COALESCE(MAX(id), 0) + 1, $1, $2, $3, $4
+FROM "exchanges"
+RETURNING id;`
+	var id uint64
+	s.l.Infow("creating exchange", "name", exchange.Name, "venue", exchange.Venue)
+	err := s.db.Get(&id, query, exchange.Name, exchange.Venue, exchange.CredentialsRef, exchange.Endpoint)
+	if err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == errCodeUniqueViolation {
+			return 0, common.ErrExchangeExists
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
 func (s *Storage) UpdateExchange(id uint64, updateOpts storage.UpdateExchangeOpts) error {
 	return s.updateExchange(nil, id, updateOpts)
 }
@@ -117,6 +152,9 @@ func (s *Storage) updateExchange(tx *sqlx.Tx, id uint64, updateOpts storage.Upda
 	if updateOpts.Disable != nil {
 		updateMsgs = append(updateMsgs, fmt.Sprintf("disable=%t", *updateOpts.Disable))
 	}
+	if updateOpts.MaxOpenOrders != nil {
+		updateMsgs = append(updateMsgs, fmt.Sprintf("max_open_orders=%d", *updateOpts.MaxOpenOrders))
+	}
 
 	s.l.Infow("updating exchange", "id", id, "fields", strings.Join(updateMsgs, " "))
 	sts := s.stmts.updateExchange
@@ -130,11 +168,13 @@ func (s *Storage) updateExchange(tx *sqlx.Tx, id uint64, updateOpts storage.Upda
 			TradingFeeMaker *float64 `db:"trading_fee_maker"`
 			TradingFeeTaker *float64 `db:"trading_fee_taker"`
 			Disable         *bool    `db:"disable"`
+			MaxOpenOrders   *uint64  `db:"max_open_orders"`
 		}{
 			ID:              id,
 			TradingFeeMaker: updateOpts.TradingFeeMaker,
 			TradingFeeTaker: updateOpts.TradingFeeTaker,
 			Disable:         updateOpts.Disable,
+			MaxOpenOrders:   updateOpts.MaxOpenOrders,
 		},
 	)
 	if err != nil {
@@ -24,7 +24,7 @@ func TestStorage_UpdateExchange(t *testing.T) {
 	// expect that exchange are initialized
 	exchanges, err := s.GetExchanges()
 	require.NoError(t, err)
-	assert.Len(t, exchanges, len(common.ValidExchangeNames))
+	assert.Len(t, exchanges, len(common.ValidExchangeNames()))
 
 	for _, exchange := range exchanges {
 		assert.Zero(t, exchange.TradingFeeMaker)
@@ -103,3 +103,37 @@ func TestStorage_GetUpdateByName(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, exchangeByID, exchangeByName)
 }
+
+func TestStorage_CreateExchange(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	binance, err := s.GetExchange(uint64(common.Binance2))
+	require.NoError(t, err)
+	assert.Equal(t, commonv3.VenueBinance, binance.Venue)
+
+	id, err := s.CreateExchange(commonv3.Exchange{
+		Name:           "binance_3",
+		Venue:          commonv3.VenueBinance,
+		CredentialsRef: "binance_3",
+		Endpoint:       "https://api.binance.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(common.Coinbase)+1, id)
+
+	exchange, err := s.GetExchangeByName("binance_3")
+	require.NoError(t, err)
+	assert.Equal(t, id, exchange.ID)
+	assert.Equal(t, commonv3.VenueBinance, exchange.Venue)
+	assert.Equal(t, "binance_3", exchange.CredentialsRef)
+	assert.Equal(t, "https://api.binance.com", exchange.Endpoint)
+	assert.True(t, exchange.Disable)
+
+	_, err = s.CreateExchange(commonv3.Exchange{Name: "binance_3", Venue: commonv3.VenueBinance})
+	assert.Equal(t, commonv3.ErrExchangeExists, err)
+}
@@ -18,21 +18,35 @@ type Storage struct {
 	stmts *preparedStmts
 }
 
+// builtinExchangeVenues are venues of the built-in exchange accounts, they are seeded with the
+// ids of their ExchangeID constants, accounts created later get the next ids.
+var builtinExchangeVenues = map[common.ExchangeID]string{
+	common.Binance:  v3.VenueBinance,
+	common.Huobi:    v3.VenueHuobi,
+	common.Binance2: v3.VenueBinance,
+	common.Coinbase: v3.VenueCoinbase,
+}
+
 func (s *Storage) initExchanges() error {
-	const query = `INSERT INTO "exchanges" (id, name)
+	// venues of built-in accounts created before venues are filled in
+	const query = `INSERT INTO "exchanges" (id, name, venue)
 VALUES (unnest($1::INT[]),
-        unnest($2::TEXT[])) ON CONFLICT(name) DO NOTHING;`
+        unnest($2::TEXT[]),
+        unnest($3::TEXT[]))
+ON CONFLICT(name) DO UPDATE SET venue = excluded.venue WHERE "exchanges".venue = '';`
 
 	var (
-		idParams   []int
-		nameParams []string
+		idParams    []int
+		nameParams  []string
+		venueParams []string
 	)
-	for name, ex := range common.ValidExchangeNames {
-		nameParams = append(nameParams, name)
+	for ex, venue := range builtinExchangeVenues {
+		nameParams = append(nameParams, ex.String())
 		idParams = append(idParams, int(ex))
+		venueParams = append(venueParams, venue)
 	}
 
-	_, err := s.db.Exec(query, pq.Array(idParams), pq.Array(nameParams))
+	_, err := s.db.Exec(query, pq.Array(idParams), pq.Array(nameParams), pq.Array(venueParams))
 	if err != nil {
 		return err
 	}
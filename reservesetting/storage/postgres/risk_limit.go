@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type setRiskLimitParams struct {
+	AssetID                uint64   `db:"asset_id"`
+	ExchangeID             uint64   `db:"exchange_id"`
+	MaxOrderNotional       *float64 `db:"max_order_notional"`
+	MaxDailyTradeVolume    *float64 `db:"max_daily_trade_volume"`
+	MaxWithdrawAmount      *float64 `db:"max_withdraw_amount"`
+	MaxDailyWithdrawAmount *float64 `db:"max_daily_withdraw_amount"`
+	MaxDepositAmount       *float64 `db:"max_deposit_amount"`
+	MaxDailyDepositAmount  *float64 `db:"max_daily_deposit_amount"`
+}
+
+func (s *Storage) setRiskLimit(tx *sqlx.Tx, riskLimit common.SetRiskLimitEntry) error {
+	var sts = s.stmts.setRiskLimit
+	if tx != nil {
+		sts = tx.NamedStmt(s.stmts.setRiskLimit)
+	}
+	var id uint64
+	err := sts.Get(&id, setRiskLimitParams{
+		AssetID:                riskLimit.AssetID,
+		ExchangeID:             riskLimit.ExchangeID,
+		MaxOrderNotional:       riskLimit.MaxOrderNotional,
+		MaxDailyTradeVolume:    riskLimit.MaxDailyTradeVolume,
+		MaxWithdrawAmount:      riskLimit.MaxWithdrawAmount,
+		MaxDailyWithdrawAmount: riskLimit.MaxDailyWithdrawAmount,
+		MaxDepositAmount:       riskLimit.MaxDepositAmount,
+		MaxDailyDepositAmount:  riskLimit.MaxDailyDepositAmount,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set risk limit, err=%s", err)
+	}
+	s.l.Infow("set risk limit", "id", id, "asset_id", riskLimit.AssetID, "exchange_id", riskLimit.ExchangeID)
+	return nil
+}
+
+// GetRiskLimits return all configured risk limits
+func (s *Storage) GetRiskLimits() ([]common.RiskLimit, error) {
+	var result []common.RiskLimit
+	if err := s.stmts.getRiskLimits.Select(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetRiskLimit return risk limit of an asset on an exchange
+func (s *Storage) GetRiskLimit(assetID, exchangeID uint64) (common.RiskLimit, error) {
+	var result common.RiskLimit
+	if err := s.stmts.getRiskLimit.Get(&result, assetID, exchangeID); err != nil {
+		if err == sql.ErrNoRows {
+			return result, common.ErrNotFound
+		}
+		return result, err
+	}
+	return result, nil
+}
@@ -212,6 +212,24 @@ func (s *Storage) applyChange(tx *sqlx.Tx, i int, entry common.SettingChangeEntr
 			s.l.Infow("set feed configuration", "index", i, "err", err)
 			return err
 		}
+	case *common.SetRiskLimitEntry:
+		err = s.setRiskLimit(tx, *e)
+		if err != nil {
+			s.l.Infow("set risk limit", "index", i, "err", err)
+			return err
+		}
+	case *common.CreateWithdrawAddressEntry:
+		_, err = s.createWithdrawAddress(tx, *e)
+		if err != nil {
+			s.l.Infow("create withdraw address", "index", i, "err", err)
+			return err
+		}
+	case *common.DeleteWithdrawAddressEntry:
+		err = s.deleteWithdrawAddress(tx, e.ID)
+		if err != nil {
+			s.l.Infow("delete withdraw address", "index", i, "err", err)
+			return err
+		}
 	default:
 		return fmt.Errorf("unexpected change object %+v", e)
 	}
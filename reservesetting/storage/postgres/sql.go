@@ -10,6 +10,12 @@ import (
 var migrateScripts = []string{
 	`ALTER TYPE "setting_change_cat" ADD VALUE IF NOT EXISTS 'update_exchange';`,
 	`ALTER TYPE "setting_change_cat" ADD VALUE IF NOT EXISTS 'set_feed_configuration';`,
+	`ALTER TYPE "setting_change_cat" ADD VALUE IF NOT EXISTS 'set_risk_limit';`,
+	`ALTER TYPE "setting_change_cat" ADD VALUE IF NOT EXISTS 'set_withdraw_address';`,
+	`ALTER TABLE "exchanges" ADD COLUMN IF NOT EXISTS max_open_orders INT NOT NULL DEFAULT 0;`,
+	`ALTER TABLE "exchanges" ADD COLUMN IF NOT EXISTS venue TEXT NOT NULL DEFAULT '';`,
+	`ALTER TABLE "exchanges" ADD COLUMN IF NOT EXISTS credentials_ref TEXT NOT NULL DEFAULT '';`,
+	`ALTER TABLE "exchanges" ADD COLUMN IF NOT EXISTS endpoint TEXT NOT NULL DEFAULT '';`,
 }
 
 type preparedStmts struct {
@@ -61,6 +67,15 @@ type preparedStmts struct {
 	setFeedConfiguration  *sqlx.NamedStmt
 	getFeedConfiguration  *sqlx.Stmt
 	getFeedConfigurations *sqlx.Stmt
+
+	setRiskLimit  *sqlx.NamedStmt
+	getRiskLimit  *sqlx.Stmt
+	getRiskLimits *sqlx.Stmt
+
+	newWithdrawAddress    *sqlx.NamedStmt
+	deleteWithdrawAddress *sqlx.Stmt
+	getWithdrawAddress    *sqlx.Stmt
+	getWithdrawAddresses  *sqlx.Stmt
 }
 
 func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
@@ -165,6 +180,16 @@ func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
 		return nil, err
 	}
 
+	setRiskLimitStmt, getRiskLimitStmt, getRiskLimitsStmt, err := riskLimitStatements(db)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawAddressStmts, err := newWithdrawAddressStmts(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return &preparedStmts{
 		getExchanges:        getExchanges,
 		getExchange:         getExchange,
@@ -214,6 +239,15 @@ func newPreparedStmts(db *sqlx.DB) (*preparedStmts, error) {
 		setFeedConfiguration:  setFeedConfigurationStmt,
 		getFeedConfiguration:  getFeedConfigurationStmt,
 		getFeedConfigurations: getFeedConfigurationsStmt,
+
+		setRiskLimit:  setRiskLimitStmt,
+		getRiskLimit:  getRiskLimitStmt,
+		getRiskLimits: getRiskLimitsStmt,
+
+		newWithdrawAddress:    withdrawAddressStmts.newStmt,
+		deleteWithdrawAddress: withdrawAddressStmts.deleteStmt,
+		getWithdrawAddress:    withdrawAddressStmts.getStmt,
+		getWithdrawAddresses:  withdrawAddressStmts.getAllStmt,
 	}, nil
 }
 
@@ -605,7 +639,8 @@ func exchangeStatements(db *sqlx.DB) (*sqlx.Stmt, *sqlx.Stmt, *sqlx.Stmt, *sqlx.
 	const updateExchangeQuery = `UPDATE "exchanges"
 	SET trading_fee_maker = COALESCE(:trading_fee_maker, trading_fee_maker),
 	    trading_fee_taker = COALESCE(:trading_fee_taker, trading_fee_taker),
-	    disable           = COALESCE(:disable, disable)
+	    disable           = COALESCE(:disable, disable),
+	    max_open_orders   = COALESCE(:max_open_orders, max_open_orders)
 	WHERE id = :id RETURNING id
 	`
 	updateExchange, err := db.PrepareNamed(updateExchangeQuery)
@@ -734,3 +769,76 @@ func feedConfigurationStatements(db *sqlx.DB) (*sqlx.NamedStmt, *sqlx.Stmt, *sql
 	}
 	return setFeedConfigurationStmt, getFeedConfigurationStmt, getFeedConfigurationsStmt, nil
 }
+
+func riskLimitStatements(db *sqlx.DB) (*sqlx.NamedStmt, *sqlx.Stmt, *sqlx.Stmt, error) {
+	const setRiskLimitQuery = `INSERT INTO "risk_limits" (asset_id, exchange_id, max_order_notional, max_daily_trade_volume,
+	                        max_withdraw_amount, max_daily_withdraw_amount, max_deposit_amount, max_daily_deposit_amount)
+	VALUES (:asset_id, :exchange_id,
+	        COALESCE(:max_order_notional, 0),
+	        COALESCE(:max_daily_trade_volume, 0),
+	        COALESCE(:max_withdraw_amount, 0),
+	        COALESCE(:max_daily_withdraw_amount, 0),
+	        COALESCE(:max_deposit_amount, 0),
+	        COALESCE(:max_daily_deposit_amount, 0))
+	ON CONFLICT (asset_id, exchange_id) DO UPDATE
+	    SET max_order_notional        = COALESCE(:max_order_notional, risk_limits.max_order_notional),
+	        max_daily_trade_volume    = COALESCE(:max_daily_trade_volume, risk_limits.max_daily_trade_volume),
+	        max_withdraw_amount       = COALESCE(:max_withdraw_amount, risk_limits.max_withdraw_amount),
+	        max_daily_withdraw_amount = COALESCE(:max_daily_withdraw_amount, risk_limits.max_daily_withdraw_amount),
+	        max_deposit_amount        = COALESCE(:max_deposit_amount, risk_limits.max_deposit_amount),
+	        max_daily_deposit_amount  = COALESCE(:max_daily_deposit_amount, risk_limits.max_daily_deposit_amount)
+	RETURNING id;
+	`
+	setRiskLimitStmt, err := db.PrepareNamed(setRiskLimitQuery)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to prepare set risk limit")
+	}
+	const getRiskLimitsQuery = `SELECT asset_id, exchange_id, max_order_notional, max_daily_trade_volume,
+	       max_withdraw_amount, max_daily_withdraw_amount, max_deposit_amount, max_daily_deposit_amount
+	FROM "risk_limits"`
+	getRiskLimitsStmt, err := db.Preparex(getRiskLimitsQuery)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to prepare get risk limits")
+	}
+	getRiskLimitStmt, err := db.Preparex(getRiskLimitsQuery + ` WHERE asset_id = $1 AND exchange_id = $2;`)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to prepare get risk limit")
+	}
+	return setRiskLimitStmt, getRiskLimitStmt, getRiskLimitsStmt, nil
+}
+
+type withdrawAddressStmts struct {
+	newStmt    *sqlx.NamedStmt
+	deleteStmt *sqlx.Stmt
+	getStmt    *sqlx.Stmt
+	getAllStmt *sqlx.Stmt
+}
+
+func newWithdrawAddressStmts(db *sqlx.DB) (*withdrawAddressStmts, error) {
+	const newQuery = `INSERT INTO "withdraw_addresses" (address, description, asset_id, max_amount, max_daily_amount)
+	VALUES (:address, :description, :asset_id, :max_amount, :max_daily_amount) RETURNING id;`
+	newStmt, err := db.PrepareNamed(newQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare new withdraw address")
+	}
+	const deleteQuery = `DELETE FROM "withdraw_addresses" WHERE id = $1 RETURNING id;`
+	deleteStmt, err := db.Preparex(deleteQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare delete withdraw address")
+	}
+	const getAllQuery = `SELECT id, address, description, asset_id, max_amount, max_daily_amount FROM "withdraw_addresses"`
+	getAllStmt, err := db.Preparex(getAllQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare get withdraw addresses")
+	}
+	getStmt, err := db.Preparex(getAllQuery + ` WHERE address = $1 AND asset_id = $2;`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare get withdraw address")
+	}
+	return &withdrawAddressStmts{
+		newStmt:    newStmt,
+		deleteStmt: deleteStmt,
+		getStmt:    getStmt,
+		getAllStmt: getAllStmt,
+	}, nil
+}
@@ -530,4 +530,29 @@ CREATE TABLE IF NOT EXISTS "feed_configurations"
     base_volatility_spread FLOAT   DEFAULT 0,
     normal_spread          FLOAT   DEFAULT 0
 );
+
+CREATE TABLE IF NOT EXISTS "risk_limits"
+(
+    id                        SERIAL PRIMARY KEY,
+    asset_id                  INT REFERENCES assets (id) ON DELETE CASCADE    NOT NULL,
+    exchange_id               INT REFERENCES exchanges (id) ON DELETE CASCADE NOT NULL,
+    max_order_notional        FLOAT NOT NULL DEFAULT 0 CHECK (max_order_notional >= 0),
+    max_daily_trade_volume    FLOAT NOT NULL DEFAULT 0 CHECK (max_daily_trade_volume >= 0),
+    max_withdraw_amount       FLOAT NOT NULL DEFAULT 0 CHECK (max_withdraw_amount >= 0),
+    max_daily_withdraw_amount FLOAT NOT NULL DEFAULT 0 CHECK (max_daily_withdraw_amount >= 0),
+    max_deposit_amount        FLOAT NOT NULL DEFAULT 0 CHECK (max_deposit_amount >= 0),
+    max_daily_deposit_amount  FLOAT NOT NULL DEFAULT 0 CHECK (max_daily_deposit_amount >= 0),
+    UNIQUE (asset_id, exchange_id)
+);
+
+CREATE TABLE IF NOT EXISTS "withdraw_addresses"
+(
+    id               SERIAL PRIMARY KEY,
+    address          TEXT                                         NOT NULL,
+    description      TEXT                                         NOT NULL DEFAULT '',
+    asset_id         INT REFERENCES assets (id) ON DELETE CASCADE NOT NULL,
+    max_amount       FLOAT NOT NULL DEFAULT 0 CHECK (max_amount >= 0),
+    max_daily_amount FLOAT NOT NULL DEFAULT 0 CHECK (max_daily_amount >= 0),
+    UNIQUE (address, asset_id)
+);
 `
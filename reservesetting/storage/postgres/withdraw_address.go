@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+type withdrawAddressDB struct {
+	ID             uint64  `db:"id"`
+	Address        string  `db:"address"`
+	Description    string  `db:"description"`
+	AssetID        uint64  `db:"asset_id"`
+	MaxAmount      float64 `db:"max_amount"`
+	MaxDailyAmount float64 `db:"max_daily_amount"`
+}
+
+func (wdb withdrawAddressDB) ToCommon() common.WithdrawAddress {
+	return common.WithdrawAddress{
+		ID:             wdb.ID,
+		Address:        ethereum.HexToAddress(wdb.Address),
+		Description:    wdb.Description,
+		AssetID:        wdb.AssetID,
+		MaxAmount:      wdb.MaxAmount,
+		MaxDailyAmount: wdb.MaxDailyAmount,
+	}
+}
+
+func (s *Storage) createWithdrawAddress(tx *sqlx.Tx, entry common.CreateWithdrawAddressEntry) (uint64, error) {
+	var id uint64
+	err := tx.NamedStmt(s.stmts.newWithdrawAddress).Get(&id, withdrawAddressDB{
+		Address:        entry.Address.Hex(),
+		Description:    entry.Description,
+		AssetID:        entry.AssetID,
+		MaxAmount:      entry.MaxAmount,
+		MaxDailyAmount: entry.MaxDailyAmount,
+	})
+	if err != nil {
+		pErr, ok := err.(*pq.Error)
+		if !ok {
+			return 0, fmt.Errorf("unknown returned err=%s", err.Error())
+		}
+		s.l.Infow("failed to create withdraw address", "err", pErr.Message)
+		switch pErr.Code {
+		case errForeignKeyViolation:
+			return 0, common.ErrAssetNotExists
+		case errCodeUniqueViolation:
+			return 0, common.ErrWithdrawAddressExists
+		}
+		return 0, fmt.Errorf("failed to create withdraw address, err=%s", pErr.Message)
+	}
+	s.l.Infow("withdraw address created", "id", id, "address", entry.Address.Hex(), "asset_id", entry.AssetID)
+	return id, nil
+}
+
+func (s *Storage) deleteWithdrawAddress(tx *sqlx.Tx, id uint64) error {
+	var returnedID uint64
+	err := tx.Stmtx(s.stmts.deleteWithdrawAddress).Get(&returnedID, id)
+	switch err {
+	case nil:
+		s.l.Infow("withdraw address deleted", "id", id)
+		return nil
+	case sql.ErrNoRows:
+		return common.ErrNotFound
+	default:
+		return err
+	}
+}
+
+// GetWithdrawAddresses return all whitelisted withdraw addresses
+func (s *Storage) GetWithdrawAddresses() ([]common.WithdrawAddress, error) {
+	var records []withdrawAddressDB
+	if err := s.stmts.getWithdrawAddresses.Select(&records); err != nil {
+		return nil, err
+	}
+	result := make([]common.WithdrawAddress, 0, len(records))
+	for _, record := range records {
+		result = append(result, record.ToCommon())
+	}
+	return result, nil
+}
+
+// GetWithdrawAddress return whitelisted withdraw address of an asset
+func (s *Storage) GetWithdrawAddress(address ethereum.Address, assetID uint64) (common.WithdrawAddress, error) {
+	var record withdrawAddressDB
+	if err := s.stmts.getWithdrawAddress.Get(&record, address.Hex(), assetID); err != nil {
+		if err == sql.ErrNoRows {
+			return common.WithdrawAddress{}, common.ErrNotFound
+		}
+		return common.WithdrawAddress{}, err
+	}
+	return record.ToCommon(), nil
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KyberNetwork/reserve-data/common/testutil"
+	"github.com/KyberNetwork/reserve-data/reservesetting/common"
+)
+
+func TestWithdrawAddress(t *testing.T) {
+	db, tearDown := testutil.MustNewDevelopmentDB()
+	defer func() {
+		assert.NoError(t, tearDown())
+	}()
+
+	s, err := NewStorage(db)
+	require.NoError(t, err)
+
+	coldWallet := ethereum.HexToAddress("0x1111111111111111111111111111111111111111")
+	id, err := s.CreateSettingChange(common.ChangeCatalogWithdrawAddress, common.SettingChange{
+		ChangeList: []common.SettingChangeEntry{
+			{
+				Type: common.ChangeTypeCreateWithdrawAddress,
+				Data: common.CreateWithdrawAddressEntry{
+					Address:        coldWallet,
+					Description:    "cold wallet",
+					AssetID:        1,
+					MaxAmount:      10,
+					MaxDailyAmount: 20,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.ConfirmSettingChange(id, true))
+
+	withdrawAddress, err := s.GetWithdrawAddress(coldWallet, 1)
+	require.NoError(t, err)
+	assert.Equal(t, coldWallet, withdrawAddress.Address)
+	assert.Equal(t, "cold wallet", withdrawAddress.Description)
+	assert.Equal(t, 10.0, withdrawAddress.MaxAmount)
+	assert.Equal(t, 20.0, withdrawAddress.MaxDailyAmount)
+
+	_, err = s.GetWithdrawAddress(coldWallet, 2)
+	assert.Equal(t, common.ErrNotFound, err)
+
+	id, err = s.CreateSettingChange(common.ChangeCatalogWithdrawAddress, common.SettingChange{
+		ChangeList: []common.SettingChangeEntry{
+			{
+				Type: common.ChangeTypeDeleteWithdrawAddress,
+				Data: common.DeleteWithdrawAddressEntry{ID: withdrawAddress.ID},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.ConfirmSettingChange(id, true))
+
+	withdrawAddresses, err := s.GetWithdrawAddresses()
+	require.NoError(t, err)
+	assert.Len(t, withdrawAddresses, 0)
+}
@@ -35,7 +35,7 @@ func (tw *TheWorld) getGeminiInfo(endpoint string) common.GeminiData {
 // GetBTCInfo return btc info
 func (tw *TheWorld) GetBTCInfo() (common.BTCData, error) {
 	return common.BTCData{
-		Coinbase: tw.getCoinbaseInfo(tw.endpoint.CoinbaseBTCEndpoint()),
-		Gemini:   tw.getGeminiInfo(tw.endpoint.GeminiBTCEndpoint()),
+		Coinbase: tw.getCoinbaseInfo(tw.getEndpoint().CoinbaseBTCEndpoint()),
+		Gemini:   tw.getGeminiInfo(tw.getEndpoint().GeminiBTCEndpoint()),
 	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -15,10 +16,24 @@ import (
 
 // TheWorld is the concrete implementation of fetcher.TheWorld interface.
 type TheWorld struct {
+	mu       sync.RWMutex
 	endpoint Endpoint
 	l        *zap.SugaredLogger
 }
 
+func (tw *TheWorld) getEndpoint() Endpoint {
+	tw.mu.RLock()
+	defer tw.mu.RUnlock()
+	return tw.endpoint
+}
+
+// SetEndpoints replaces the endpoints of feeds, fetches in progress keep the old endpoints.
+func (tw *TheWorld) SetEndpoints(worldEndpoints common.WorldEndpoints) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.endpoint = RealEndpoint{Endpoints: worldEndpoints}
+}
+
 func (tw *TheWorld) getPublic(url string, dst interface{}) error {
 	var (
 		client = &http.Client{Timeout: 30 * time.Second}
@@ -64,7 +79,7 @@ func (tw *TheWorld) getPublic(url string, dst interface{}) error {
 }
 
 func (tw *TheWorld) getOneForgeGoldUSDInfo() common.OneForgeGoldData {
-	url := tw.endpoint.OneForgeGoldUSDDataEndpoint()
+	url := tw.getEndpoint().OneForgeGoldUSDDataEndpoint()
 	result := common.OneForgeGoldData{}
 	err := tw.getPublic(url, &result)
 	if err != nil {
@@ -75,7 +90,7 @@ func (tw *TheWorld) getOneForgeGoldUSDInfo() common.OneForgeGoldData {
 }
 
 func (tw *TheWorld) getOneForgeGoldETHInfo() common.OneForgeGoldData {
-	url := tw.endpoint.OneForgeGoldETHDataEndpoint()
+	url := tw.getEndpoint().OneForgeGoldETHDataEndpoint()
 	result := common.OneForgeGoldData{}
 	err := tw.getPublic(url, &result)
 	if err != nil {
@@ -86,7 +101,7 @@ func (tw *TheWorld) getOneForgeGoldETHInfo() common.OneForgeGoldData {
 }
 
 func (tw *TheWorld) getDGXGoldInfo() common.DGXGoldData {
-	url := tw.endpoint.GoldDataEndpoint()
+	url := tw.getEndpoint().GoldDataEndpoint()
 	result := common.DGXGoldData{
 		Valid: true,
 	}
@@ -99,7 +114,7 @@ func (tw *TheWorld) getDGXGoldInfo() common.DGXGoldData {
 }
 
 func (tw *TheWorld) getGDAXGoldInfo() common.GDAXGoldData {
-	url := tw.endpoint.GDAXDataEndpoint()
+	url := tw.getEndpoint().GDAXDataEndpoint()
 	result := common.GDAXGoldData{
 		Valid: true,
 	}
@@ -112,7 +127,7 @@ func (tw *TheWorld) getGDAXGoldInfo() common.GDAXGoldData {
 }
 
 func (tw *TheWorld) getKrakenGoldInfo() common.KrakenGoldData {
-	url := tw.endpoint.KrakenDataEndpoint()
+	url := tw.getEndpoint().KrakenDataEndpoint()
 	result := common.KrakenGoldData{
 		Valid: true,
 	}
@@ -126,7 +141,7 @@ func (tw *TheWorld) getKrakenGoldInfo() common.KrakenGoldData {
 
 func (tw *TheWorld) getGeminiGoldInfo() common.GeminiGoldData {
 
-	url := tw.endpoint.GeminiDataEndpoint()
+	url := tw.getEndpoint().GeminiDataEndpoint()
 	result := common.GeminiGoldData{
 		Valid: true,
 	}